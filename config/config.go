@@ -1,37 +1,130 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"time"
 
+	"github.com/dipsylala/veracode-tui/veracode"
 	"gopkg.in/yaml.v3"
 )
 
+// Environment variables that can supply or override credentials
+const (
+	EnvAPIKeyID     = "VERACODE_API_KEY_ID"
+	EnvAPIKeySecret = "VERACODE_API_KEY_SECRET"
+	EnvRegion       = "VERACODE_REGION"
+	EnvAPIToken     = "VERACODE_API_TOKEN"
+)
+
 // VeracodeConfig represents the structure of veracode.yml
 type VeracodeConfig struct {
-	API struct {
-		KeyID     string `yaml:"key-id"`
-		KeySecret string `yaml:"key-secret"`
-	} `yaml:"api"`
-	OAuth struct {
-		Enabled bool   `yaml:"enabled"`
-		Region  string `yaml:"region"`
-	} `yaml:"oauth"`
-	Packager map[string]interface{} `yaml:"packager"`
-}
-
-// LoadConfig reads and parses the Veracode configuration file
-func LoadConfig() (*VeracodeConfig, error) {
+	API      APIConfig                `yaml:"api"`
+	OAuth    OAuthConfig              `yaml:"oauth"`
+	Packager map[string]interface{}   `yaml:"packager"`
+	Profiles map[string]ProfileConfig `yaml:"profiles"`
+	TUI      TUIConfig                `yaml:"tui"`
+
+	// ActiveProfile is the name of the profile the credentials were taken from, empty for the default
+	ActiveProfile string `yaml:"-"`
+}
+
+// APIConfig holds the HMAC API credentials
+type APIConfig struct {
+	KeyID     string `yaml:"key-id"`
+	KeySecret string `yaml:"key-secret"`
+}
+
+// OAuthConfig holds the OAuth settings. When enabled, Token is sent as a bearer token
+// instead of signing requests with the HMAC API credentials.
+type OAuthConfig struct {
+	Enabled bool   `yaml:"enabled"`
+	Region  string `yaml:"region"`
+	Token   string `yaml:"token"`
+}
+
+// TUIConfig holds display settings for the TUI. Zero values mean the built-in defaults.
+type TUIConfig struct {
+	PageSize      int           `yaml:"page-size"`
+	Prefetch      bool          `yaml:"prefetch"`
+	WatchInterval time.Duration `yaml:"watch-interval"` // e.g. 30s
+
+	// Filters the findings view opens with: STATIC, DYNAMIC or SCA, and All, Violations or Non-Violations
+	DefaultScanType     string `yaml:"default-scan-type"`
+	DefaultPolicyFilter string `yaml:"default-policy-filter"`
+}
+
+// ProfileConfig is a named set of credentials under the profiles section of veracode.yml
+type ProfileConfig struct {
+	API   APIConfig   `yaml:"api"`
+	OAuth OAuthConfig `yaml:"oauth"`
+}
+
+// DefaultConfigPath returns the default location of veracode.yml
+func DefaultConfigPath() (string, error) {
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user home directory: %w", err)
+		return "", fmt.Errorf("failed to get user home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".veracode", "veracode.yml"), nil
+}
+
+// LoadConfig loads the configuration from ~/.veracode/veracode.yml, with any credential
+// environment variable that is set overriding the corresponding value from the file. When both
+// VERACODE_API_KEY_ID and VERACODE_API_KEY_SECRET are set they are used, with the file's other
+// settings, even if the file sets up OAuth, and the file need not exist.
+func LoadConfig() (*VeracodeConfig, error) {
+	return LoadConfigProfile("", "")
+}
+
+// LoadConfigProfile loads API credentials from the config file at path (the default
+// location when empty) using the named profile. When profileName is empty the top-level
+// credentials are used. Environment variables are only honoured, as for LoadConfig,
+// when neither a path nor a profile is given.
+//
+// The API key secret is normalized with veracode.NormalizeSecret, so a malformed secret is
+// reported here rather than on the first request.
+func LoadConfigProfile(path, profileName string) (*VeracodeConfig, error) {
+	config, err := loadConfigProfile(path, profileName)
+	if err != nil {
+		return nil, err
+	}
+	if err := config.normalizeSecret(); err != nil {
+		return nil, err
 	}
+	return config, nil
+}
+
+func loadConfigProfile(path, profileName string) (*VeracodeConfig, error) {
+	useEnv := path == "" && profileName == ""
 
-	configPath := filepath.Join(homeDir, ".veracode", "veracode.yml")
+	configPath := path
+	if configPath == "" {
+		defaultPath, err := DefaultConfigPath()
+		if err != nil {
+			if useEnv {
+				if config, envErr := loadConfigFromEnv(); envErr == nil {
+					return config, nil
+				}
+			}
+			return nil, err
+		}
+		configPath = defaultPath
+	}
 
 	data, err := os.ReadFile(configPath)
 	if err != nil {
+		// Credentials from the environment are enough on their own when there is no file
+		if useEnv {
+			if config, envErr := loadConfigFromEnv(); envErr == nil {
+				return config, nil
+			}
+			return nil, fmt.Errorf("%s and %s are not set, and failed to read config file %s: %w",
+				EnvAPIKeyID, EnvAPIKeySecret, configPath, err)
+		}
 		return nil, fmt.Errorf("failed to read config file %s: %w", configPath, err)
 	}
 
@@ -40,14 +133,165 @@ func LoadConfig() (*VeracodeConfig, error) {
 		return nil, fmt.Errorf("failed to parse config file: %w", err)
 	}
 
+	if profileName != "" {
+		profile, ok := config.Profiles[profileName]
+		if !ok {
+			return nil, fmt.Errorf("profile %q not found in config file %s", profileName, configPath)
+		}
+		config.API = profile.API
+		config.OAuth = profile.OAuth
+		config.ActiveProfile = profileName
+
+		if !config.hasCredentials() {
+			return nil, fmt.Errorf("API key-id and key-secret (or an oauth token) are required for profile %q in config file %s", profileName, configPath)
+		}
+		return &config, nil
+	}
+
+	if !useEnv {
+		if !config.hasCredentials() {
+			return nil, fmt.Errorf("API key-id and key-secret (or an oauth token) are required in config file %s", configPath)
+		}
+		return &config, nil
+	}
+
+	// Both HMAC credentials from the environment take precedence over OAuth set up in the file
+	applyEnvOverrides(&config)
+	if os.Getenv(EnvAPIKeyID) != "" && os.Getenv(EnvAPIKeySecret) != "" {
+		config.OAuth.Enabled = false
+	}
+
 	// Validate required fields
+	if !config.hasCredentials() {
+		return nil, fmt.Errorf("API key-id and key-secret are required (checked environment variables %s/%s and config file %s)",
+			EnvAPIKeyID, EnvAPIKeySecret, configPath)
+	}
+
+	return &config, nil
+}
+
+// IsNotFound reports whether err from LoadConfig or LoadConfigProfile was caused by the
+// config file not existing, as for a first-time user who has not created one yet
+func IsNotFound(err error) bool {
+	return errors.Is(err, fs.ErrNotExist)
+}
+
+// WriteCredentials creates a config file at path (the default location when empty) holding the
+// HMAC API credentials and region. The file is readable only by its owner, and an existing file
+// is never overwritten.
+func WriteCredentials(path, keyID, keySecret, region string) error {
+	if path == "" {
+		defaultPath, err := DefaultConfigPath()
+		if err != nil {
+			return err
+		}
+		path = defaultPath
+	}
+
+	secret, err := veracode.NormalizeSecret(keySecret)
+	if err != nil {
+		return fmt.Errorf("invalid API key secret: %w", err)
+	}
+
+	// Only the credential sections are written, matching the layout of the documented example
+	var file struct {
+		API   APIConfig `yaml:"api"`
+		OAuth struct {
+			Enabled bool   `yaml:"enabled"`
+			Region  string `yaml:"region"`
+		} `yaml:"oauth"`
+	}
+	file.API = APIConfig{KeyID: keyID, KeySecret: secret}
+	file.OAuth.Region = region
+
+	data, err := yaml.Marshal(&file)
+	if err != nil {
+		return fmt.Errorf("failed to encode config file: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to create config file %s: %w", path, err)
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write config file %s: %w", path, err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// LoadConfigFromEnv builds the configuration solely from environment variables
+func LoadConfigFromEnv() (*VeracodeConfig, error) {
+	config, err := loadConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	if err := config.normalizeSecret(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func loadConfigFromEnv() (*VeracodeConfig, error) {
+	var config VeracodeConfig
+	applyEnvOverrides(&config)
+
 	if config.API.KeyID == "" || config.API.KeySecret == "" {
-		return nil, fmt.Errorf("API key-id and key-secret are required in config file")
+		return nil, fmt.Errorf("environment variables %s and %s are required", EnvAPIKeyID, EnvAPIKeySecret)
 	}
 
 	return &config, nil
 }
 
+// applyEnvOverrides replaces config values with any credential environment variables that are set
+func applyEnvOverrides(config *VeracodeConfig) {
+	if keyID := os.Getenv(EnvAPIKeyID); keyID != "" {
+		config.API.KeyID = keyID
+	}
+	if keySecret := os.Getenv(EnvAPIKeySecret); keySecret != "" {
+		config.API.KeySecret = keySecret
+	}
+	if region := os.Getenv(EnvRegion); region != "" {
+		config.OAuth.Region = region
+	}
+	if token := os.Getenv(EnvAPIToken); token != "" {
+		config.OAuth.Token = token
+	}
+}
+
+// hasCredentials reports whether the credentials needed for the selected auth mode are present
+func (c *VeracodeConfig) hasCredentials() bool {
+	if c.OAuth.Enabled {
+		return c.OAuth.Token != ""
+	}
+	return c.API.KeyID != "" && c.API.KeySecret != ""
+}
+
+// normalizeSecret validates and normalizes the HMAC API key secret. It is skipped for OAuth,
+// which does not use the secret.
+func (c *VeracodeConfig) normalizeSecret() error {
+	if c.UsesOAuth() {
+		return nil
+	}
+	secret, err := veracode.NormalizeSecret(c.API.KeySecret)
+	if err != nil {
+		return fmt.Errorf("invalid API key secret: %w", err)
+	}
+	c.API.KeySecret = secret
+	return nil
+}
+
+// UsesOAuth reports whether requests should authenticate with the OAuth bearer token
+func (c *VeracodeConfig) UsesOAuth() bool {
+	return c.OAuth.Enabled
+}
+
 func (c *VeracodeConfig) GetAPICredentials() (keyID, keySecret string) {
 	return c.API.KeyID, c.API.KeySecret
 }
@@ -0,0 +1,328 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoadConfigFromEnv_Success(t *testing.T) {
+	t.Setenv(EnvAPIKeyID, "env-id")
+	t.Setenv(EnvAPIKeySecret, envSecret)
+	t.Setenv(EnvRegion, "eu")
+
+	cfg, err := LoadConfigFromEnv()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	keyID, keySecret := cfg.GetAPICredentials()
+	if keyID != "env-id" || keySecret != envSecret {
+		t.Errorf("Expected env credentials, got %s/%s", keyID, keySecret)
+	}
+	if cfg.OAuth.Region != "eu" {
+		t.Errorf("Expected region 'eu', got %s", cfg.OAuth.Region)
+	}
+}
+
+func TestLoadConfigFromEnv_Missing(t *testing.T) {
+	t.Setenv(EnvAPIKeyID, "env-id")
+	t.Setenv(EnvAPIKeySecret, "")
+
+	if _, err := LoadConfigFromEnv(); err == nil {
+		t.Fatal("Expected error when secret is missing, got nil")
+	}
+}
+
+func TestLoadConfig_PrefersEnv(t *testing.T) {
+	home := writeConfigFile(t, "api:\n  key-id: file-id\n  key-secret: "+fileSecret+"\n")
+	t.Setenv("HOME", home)
+	t.Setenv(EnvAPIKeyID, "env-id")
+	t.Setenv(EnvAPIKeySecret, envSecret)
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if keyID, _ := cfg.GetAPICredentials(); keyID != "env-id" {
+		t.Errorf("Expected env key id to be preferred, got %s", keyID)
+	}
+}
+
+func TestLoadConfig_EnvCredentialsKeepFileSettings(t *testing.T) {
+	home := writeConfigFile(t, "api:\n  key-id: file-id\n  key-secret: "+fileSecret+"\noauth:\n  enabled: true\n  region: eu\n  token: file-token\ntui:\n  page-size: 50\n  prefetch: true\n")
+	t.Setenv("HOME", home)
+	t.Setenv(EnvAPIKeyID, "env-id")
+	t.Setenv(EnvAPIKeySecret, envSecret)
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	keyID, keySecret := cfg.GetAPICredentials()
+	if keyID != "env-id" || keySecret != envSecret {
+		t.Errorf("Expected env credentials, got %s/%s", keyID, keySecret)
+	}
+	if cfg.UsesOAuth() {
+		t.Error("Expected the env HMAC credentials to take precedence over OAuth from the file")
+	}
+	if cfg.OAuth.Region != "eu" {
+		t.Errorf("Expected the region from the file, got %q", cfg.OAuth.Region)
+	}
+	if cfg.TUI.PageSize != 50 || !cfg.TUI.Prefetch {
+		t.Errorf("Expected the tui settings from the file, got %+v", cfg.TUI)
+	}
+}
+
+func TestLoadConfig_EnvCredentialsWithoutFile(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv(EnvAPIKeyID, "env-id")
+	t.Setenv(EnvAPIKeySecret, envSecret)
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("Expected the env credentials without a config file, got %v", err)
+	}
+	if keyID, _ := cfg.GetAPICredentials(); keyID != "env-id" {
+		t.Errorf("Expected env key id, got %s", keyID)
+	}
+}
+
+func TestLoadConfig_EnvOverridesFileValues(t *testing.T) {
+	home := writeConfigFile(t, "api:\n  key-id: file-id\n  key-secret: "+fileSecret+"\n")
+	t.Setenv("HOME", home)
+	t.Setenv(EnvAPIKeyID, "env-id")
+	t.Setenv(EnvAPIKeySecret, "")
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	keyID, keySecret := cfg.GetAPICredentials()
+	if keyID != "env-id" || keySecret != fileSecret {
+		t.Errorf("Expected env-id and the file secret, got %s/%s", keyID, keySecret)
+	}
+}
+
+func TestLoadConfig_MissingEverywhere(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv(EnvAPIKeyID, "")
+	t.Setenv(EnvAPIKeySecret, "")
+
+	_, err := LoadConfig()
+	if err == nil {
+		t.Fatal("Expected error when no credentials are available, got nil")
+	}
+	if !strings.Contains(err.Error(), EnvAPIKeyID) || !strings.Contains(err.Error(), "veracode.yml") {
+		t.Errorf("Expected error to mention both env and file sources, got %v", err)
+	}
+}
+
+// Valid 128 character hex API key secrets
+var (
+	envSecret     = strings.Repeat("e1", 64)
+	fileSecret    = strings.Repeat("f1", 64)
+	defaultSecret = strings.Repeat("d1", 64)
+	otherSecret   = strings.Repeat("a1", 64)
+)
+
+var profilesConfig = `api:
+  key-id: default-id
+  key-secret: ` + defaultSecret + `
+profiles:
+  other-org:
+    api:
+      key-id: other-id
+      key-secret: ` + otherSecret + `
+    oauth:
+      region: eu
+  incomplete:
+    api:
+      key-id: only-id
+`
+
+func TestLoadConfigProfile_NamedProfile(t *testing.T) {
+	home := writeConfigFile(t, profilesConfig)
+	path := filepath.Join(home, ".veracode", "veracode.yml")
+
+	cfg, err := LoadConfigProfile(path, "other-org")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	keyID, keySecret := cfg.GetAPICredentials()
+	if keyID != "other-id" || keySecret != otherSecret {
+		t.Errorf("Expected profile credentials, got %s/%s", keyID, keySecret)
+	}
+	if cfg.OAuth.Region != "eu" {
+		t.Errorf("Expected profile region 'eu', got %s", cfg.OAuth.Region)
+	}
+	if cfg.ActiveProfile != "other-org" {
+		t.Errorf("Expected active profile 'other-org', got %s", cfg.ActiveProfile)
+	}
+}
+
+func TestLoadConfigProfile_DefaultProfile(t *testing.T) {
+	home := writeConfigFile(t, profilesConfig)
+	path := filepath.Join(home, ".veracode", "veracode.yml")
+	t.Setenv(EnvAPIKeyID, "env-id")
+	t.Setenv(EnvAPIKeySecret, envSecret)
+
+	cfg, err := LoadConfigProfile(path, "")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if keyID, _ := cfg.GetAPICredentials(); keyID != "default-id" {
+		t.Errorf("Expected top-level credentials from explicit config file, got %s", keyID)
+	}
+}
+
+func TestLoadConfigProfile_Errors(t *testing.T) {
+	home := writeConfigFile(t, profilesConfig)
+	path := filepath.Join(home, ".veracode", "veracode.yml")
+
+	if _, err := LoadConfigProfile(path, "missing"); err == nil {
+		t.Error("Expected error for unknown profile, got nil")
+	}
+	if _, err := LoadConfigProfile(path, "incomplete"); err == nil {
+		t.Error("Expected error for profile without a secret, got nil")
+	}
+	if _, err := LoadConfigProfile(filepath.Join(home, "nope.yml"), ""); err == nil {
+		t.Error("Expected error for missing config file, got nil")
+	}
+}
+
+// writeConfigFile creates a temporary home directory containing .veracode/veracode.yml
+func writeConfigFile(t *testing.T, content string) string {
+	t.Helper()
+
+	home := t.TempDir()
+	dir := filepath.Join(home, ".veracode")
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		t.Fatalf("Failed to create config dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "veracode.yml"), []byte(content), 0o600); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+	return home
+}
+
+func TestLoadConfigProfile_OAuthToken(t *testing.T) {
+	home := writeConfigFile(t, "oauth:\n  enabled: true\n  region: eu\n  token: file-token\n")
+	path := filepath.Join(home, ".veracode", "veracode.yml")
+
+	cfg, err := LoadConfigProfile(path, "")
+	if err != nil {
+		t.Fatalf("Expected OAuth config without API keys to load, got %v", err)
+	}
+	if !cfg.UsesOAuth() {
+		t.Error("Expected OAuth mode to be enabled")
+	}
+	if cfg.OAuth.Token != "file-token" {
+		t.Errorf("Expected token from file, got %s", cfg.OAuth.Token)
+	}
+
+	missing := writeConfigFile(t, "oauth:\n  enabled: true\n")
+	if _, err := LoadConfigProfile(filepath.Join(missing, ".veracode", "veracode.yml"), ""); err == nil {
+		t.Error("Expected error when OAuth is enabled without a token")
+	}
+}
+
+func TestLoadConfigProfile_TUISettings(t *testing.T) {
+	home := writeConfigFile(t, "api:\n  key-id: id\n  key-secret: "+fileSecret+"\ntui:\n  page-size: 50\n  prefetch: true\n  watch-interval: 45s\n  default-scan-type: DYNAMIC\n  default-policy-filter: Violations\n")
+	path := filepath.Join(home, ".veracode", "veracode.yml")
+
+	cfg, err := LoadConfigProfile(path, "")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if cfg.TUI.PageSize != 50 {
+		t.Errorf("Expected page size 50, got %d", cfg.TUI.PageSize)
+	}
+	if !cfg.TUI.Prefetch {
+		t.Error("Expected prefetch to be enabled")
+	}
+	if cfg.TUI.WatchInterval != 45*time.Second {
+		t.Errorf("Expected watch interval 45s, got %v", cfg.TUI.WatchInterval)
+	}
+	if cfg.TUI.DefaultScanType != "DYNAMIC" || cfg.TUI.DefaultPolicyFilter != "Violations" {
+		t.Errorf("Expected default findings filters, got %q/%q", cfg.TUI.DefaultScanType, cfg.TUI.DefaultPolicyFilter)
+	}
+}
+
+func TestLoadConfigProfile_NormalizesSecret(t *testing.T) {
+	home := writeConfigFile(t, "api:\n  key-id: id\n  key-secret: \" "+strings.ToUpper(fileSecret)+" \"\n")
+	path := filepath.Join(home, ".veracode", "veracode.yml")
+
+	cfg, err := LoadConfigProfile(path, "")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, keySecret := cfg.GetAPICredentials(); keySecret != fileSecret {
+		t.Errorf("Expected normalized secret, got %q", keySecret)
+	}
+
+	invalid := writeConfigFile(t, "api:\n  key-id: id\n  key-secret: not-a-hex-secret\n")
+	_, err = LoadConfigProfile(filepath.Join(invalid, ".veracode", "veracode.yml"), "")
+	if err == nil || !strings.Contains(err.Error(), "API secret must be a hex string") {
+		t.Errorf("Expected a clear hex error, got %v", err)
+	}
+}
+
+func TestWriteCredentials(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".veracode", "veracode.yml")
+
+	if err := WriteCredentials(path, "new-id", strings.ToUpper(fileSecret), "eu"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Expected config file to exist, got %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 && runtime.GOOS != "windows" {
+		t.Errorf("Expected permissions 0600, got %o", perm)
+	}
+
+	cfg, err := LoadConfigProfile(path, "")
+	if err != nil {
+		t.Fatalf("Expected written file to load, got %v", err)
+	}
+	keyID, keySecret := cfg.GetAPICredentials()
+	if keyID != "new-id" || keySecret != fileSecret {
+		t.Errorf("Expected new-id and the normalized secret, got %s/%s", keyID, keySecret)
+	}
+	if cfg.OAuth.Region != "eu" || cfg.UsesOAuth() {
+		t.Errorf("Expected region eu without OAuth, got %+v", cfg.OAuth)
+	}
+
+	if err := WriteCredentials(path, "other-id", fileSecret, ""); err == nil {
+		t.Error("Expected error when the config file already exists, got nil")
+	}
+}
+
+func TestWriteCredentials_InvalidSecret(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "veracode.yml")
+
+	if err := WriteCredentials(path, "new-id", "not-a-secret", ""); err == nil {
+		t.Fatal("Expected error for an invalid secret, got nil")
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("Expected no file to be written, got %v", err)
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	_, err := LoadConfigProfile(filepath.Join(t.TempDir(), "missing.yml"), "")
+	if !IsNotFound(err) {
+		t.Errorf("Expected a not-found error, got %v", err)
+	}
+
+	path := filepath.Join(writeConfigFile(t, "api:\n  key-id: only-id\n"), ".veracode", "veracode.yml")
+	if _, err := LoadConfigProfile(path, ""); err == nil || IsNotFound(err) {
+		t.Errorf("Expected an error other than not-found, got %v", err)
+	}
+}
@@ -0,0 +1,107 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Draft is a mitigation comment and action prepared for a finding but not yet submitted
+type Draft struct {
+	Action  string    `yaml:"action"`
+	Comment string    `yaml:"comment"`
+	Saved   time.Time `yaml:"saved"`
+}
+
+// DraftStore keeps mitigation drafts on disk, one YAML file per finding at
+// <dir>/<application GUID>/<issue ID>.yml
+type DraftStore struct {
+	dir string
+}
+
+// NewDraftStore creates a store keeping drafts under dir
+func NewDraftStore(dir string) *DraftStore {
+	return &DraftStore{dir: dir}
+}
+
+// DefaultDraftsDir returns the default location of mitigation drafts, ~/.veracode/drafts
+func DefaultDraftsDir() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get user home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".veracode", "drafts"), nil
+}
+
+// draftPath returns the file holding the draft for a finding. The application GUID becomes a
+// directory name, so anything that could leave the drafts directory is rejected.
+func (s *DraftStore) draftPath(appGUID string, issueID int64) (string, error) {
+	if appGUID == "" || appGUID == "." || appGUID == ".." || filepath.Base(appGUID) != appGUID {
+		return "", fmt.Errorf("invalid application GUID %q", appGUID)
+	}
+	if issueID <= 0 {
+		return "", fmt.Errorf("invalid issue ID %d", issueID)
+	}
+	return filepath.Join(s.dir, appGUID, strconv.FormatInt(issueID, 10)+".yml"), nil
+}
+
+// SaveDraft saves the draft for a finding, replacing any earlier one. The file is readable only
+// by its owner, as comments may describe unfixed vulnerabilities.
+func (s *DraftStore) SaveDraft(appGUID string, issueID int64, d Draft) error {
+	path, err := s.draftPath(appGUID, issueID)
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(&d)
+	if err != nil {
+		return fmt.Errorf("failed to encode draft: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create drafts directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write draft %s: %w", path, err)
+	}
+	return nil
+}
+
+// LoadDraft returns the draft saved for a finding, reporting false when there is none
+func (s *DraftStore) LoadDraft(appGUID string, issueID int64) (Draft, bool, error) {
+	path, err := s.draftPath(appGUID, issueID)
+	if err != nil {
+		return Draft{}, false, err
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return Draft{}, false, nil
+	}
+	if err != nil {
+		return Draft{}, false, fmt.Errorf("failed to read draft %s: %w", path, err)
+	}
+
+	var d Draft
+	if err := yaml.Unmarshal(data, &d); err != nil {
+		return Draft{}, false, fmt.Errorf("failed to parse draft %s: %w", path, err)
+	}
+	return d, true, nil
+}
+
+// DeleteDraft removes the draft saved for a finding. Deleting a missing draft is not an error.
+func (s *DraftStore) DeleteDraft(appGUID string, issueID int64) error {
+	path, err := s.draftPath(appGUID, issueID)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete draft %s: %w", path, err)
+	}
+	return nil
+}
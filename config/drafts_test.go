@@ -0,0 +1,87 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestDraftStore_RoundTrip(t *testing.T) {
+	store := NewDraftStore(t.TempDir())
+	saved := Draft{
+		Action:  "APPDESIGN",
+		Comment: "Input is validated by the gateway.\nSee ticket SEC-42.",
+		Saved:   time.Date(2026, 10, 1, 9, 30, 0, 0, time.UTC),
+	}
+
+	if err := store.SaveDraft("app-guid", 101, saved); err != nil {
+		t.Fatalf("Expected no error saving, got %v", err)
+	}
+
+	loaded, ok, err := store.LoadDraft("app-guid", 101)
+	if err != nil || !ok {
+		t.Fatalf("Expected the draft to load, got ok=%v err=%v", ok, err)
+	}
+	if loaded.Action != saved.Action || loaded.Comment != saved.Comment || !loaded.Saved.Equal(saved.Saved) {
+		t.Errorf("Expected %+v, got %+v", saved, loaded)
+	}
+
+	// Drafts are kept per finding
+	if _, ok, err := store.LoadDraft("app-guid", 102); ok || err != nil {
+		t.Errorf("Expected no draft for another finding, got ok=%v err=%v", ok, err)
+	}
+}
+
+func TestDraftStore_SaveReplacesAndDeleteRemoves(t *testing.T) {
+	store := NewDraftStore(t.TempDir())
+	if err := store.SaveDraft("app-guid", 7, Draft{Comment: "first"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.SaveDraft("app-guid", 7, Draft{Comment: "second"}); err != nil {
+		t.Fatal(err)
+	}
+	if loaded, _, _ := store.LoadDraft("app-guid", 7); loaded.Comment != "second" {
+		t.Errorf("Expected the later draft, got %q", loaded.Comment)
+	}
+
+	if err := store.DeleteDraft("app-guid", 7); err != nil {
+		t.Fatalf("Expected no error deleting, got %v", err)
+	}
+	if _, ok, _ := store.LoadDraft("app-guid", 7); ok {
+		t.Error("Expected the draft to be gone after deleting")
+	}
+	if err := store.DeleteDraft("app-guid", 7); err != nil {
+		t.Errorf("Expected deleting a missing draft to succeed, got %v", err)
+	}
+}
+
+func TestDraftStore_FilePermissions(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("File permissions are not enforced on Windows")
+	}
+	dir := t.TempDir()
+	if err := NewDraftStore(dir).SaveDraft("app-guid", 1, Draft{Comment: "c"}); err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(filepath.Join(dir, "app-guid", "1.yml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("Expected 0600 permissions, got %o", perm)
+	}
+}
+
+func TestDraftStore_RejectsUnsafeKeys(t *testing.T) {
+	store := NewDraftStore(t.TempDir())
+	for _, guid := range []string{"", ".", "..", "../outside", "a/b"} {
+		if err := store.SaveDraft(guid, 1, Draft{}); err == nil {
+			t.Errorf("Expected an error for application GUID %q, got nil", guid)
+		}
+	}
+	if err := store.SaveDraft("app-guid", 0, Draft{}); err == nil {
+		t.Error("Expected an error for issue ID 0, got nil")
+	}
+}
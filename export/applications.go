@@ -0,0 +1,55 @@
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/dipsylala/veracode-tui/services/applications"
+)
+
+// applicationsCSVHeader is the header row written by ExportApplicationsCSV
+var applicationsCSVHeader = []string{"Name", "GUID", "Business Unit", "Criticality", "Policy Status", "Last Scan Date", "Last Modified"}
+
+// ExportApplicationsCSV writes an inventory of apps to w as CSV, one row per application.
+// Missing values, such as an application without a profile or scan, are written as empty cells.
+func ExportApplicationsCSV(w io.Writer, apps []applications.Application) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(applicationsCSVHeader); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	for i := range apps {
+		if err := writer.Write(applicationCSVRecord(&apps[i])); err != nil {
+			return fmt.Errorf("failed to write application %s: %w", apps[i].GUID, err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("failed to write applications: %w", err)
+	}
+	return nil
+}
+
+// applicationCSVRecord builds the CSV row for one application
+func applicationCSVRecord(app *applications.Application) []string {
+	return []string{
+		app.Name(),
+		app.GUID,
+		app.BusinessUnitName(),
+		app.BusinessCriticality(),
+		app.PolicyComplianceStatus(),
+		formatCSVDate(app.LastCompletedScanDate),
+		formatCSVDate(app.Modified),
+	}
+}
+
+// formatCSVDate formats an optional time as yyyy-MM-dd, or "" when it is nil
+func formatCSVDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format("2006-01-02")
+}
@@ -0,0 +1,59 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/dipsylala/veracode-tui/services/applications"
+)
+
+func TestExportApplicationsCSV(t *testing.T) {
+	modified := time.Date(2025, 3, 14, 9, 30, 0, 0, time.UTC)
+	scanned := time.Date(2025, 3, 10, 18, 0, 0, 0, time.UTC)
+	apps := []applications.Application{
+		{
+			GUID:                  "full-guid",
+			Modified:              &modified,
+			LastCompletedScanDate: &scanned,
+			Profile: &applications.ApplicationProfile{
+				Name:                "Payments, API",
+				BusinessCriticality: "VERY_HIGH",
+				BusinessUnit:        &applications.BusinessUnit{Name: "Finance"},
+				Policies:            []applications.AppPolicy{{PolicyComplianceStatus: "PASSED"}},
+			},
+		},
+		{GUID: "bare-guid"},
+	}
+
+	var buf bytes.Buffer
+	if err := ExportApplicationsCSV(&buf, apps); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("Failed to parse exported CSV: %v", err)
+	}
+
+	expected := [][]string{
+		{"Name", "GUID", "Business Unit", "Criticality", "Policy Status", "Last Scan Date", "Last Modified"},
+		{"Payments, API", "full-guid", "Finance", "VERY_HIGH", "PASSED", "2025-03-10", "2025-03-14"},
+		{"", "bare-guid", "", "", "", "", ""},
+	}
+	if !reflect.DeepEqual(records, expected) {
+		t.Errorf("Unexpected CSV records:\n got %v\nwant %v", records, expected)
+	}
+}
+
+func TestExportApplicationsCSV_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	if err := ExportApplicationsCSV(&buf, nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if buf.String() != "Name,GUID,Business Unit,Criticality,Policy Status,Last Scan Date,Last Modified\n" {
+		t.Errorf("Expected only the header row, got %q", buf.String())
+	}
+}
@@ -0,0 +1,4 @@
+// Package export provides serialisation of Veracode data to files.
+// It writes application findings and inventories in formats suitable
+// for feeding other tooling or for diffing scan results over time.
+package export
@@ -0,0 +1,47 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/dipsylala/veracode-tui/services/applications"
+	"github.com/dipsylala/veracode-tui/services/findings"
+)
+
+// FindingsDocument is the top-level structure written by ExportFindingsJSON
+type FindingsDocument struct {
+	ApplicationGUID string             `json:"application_guid"`
+	ApplicationName string             `json:"application_name"`
+	ExportedAt      time.Time          `json:"exported_at"`
+	Findings        []findings.Finding `json:"findings"`
+}
+
+// ExportFindingsJSON writes the application's findings to w as an indented JSON document.
+// The original finding_details payload of each finding is preserved as returned by the API.
+func ExportFindingsJSON(w io.Writer, app *applications.Application, findingsList []findings.Finding) error {
+	if app == nil {
+		return fmt.Errorf("application is required")
+	}
+
+	// Always emit an array, even when there are no findings
+	if findingsList == nil {
+		findingsList = []findings.Finding{}
+	}
+
+	doc := FindingsDocument{
+		ApplicationGUID: app.GUID,
+		ApplicationName: app.Name(),
+		ExportedAt:      time.Now().UTC(),
+		Findings:        findingsList,
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return fmt.Errorf("failed to encode findings: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/dipsylala/veracode-tui/services/applications"
+	"github.com/dipsylala/veracode-tui/services/findings"
+)
+
+func TestExportFindingsJSON_Success(t *testing.T) {
+	app := &applications.Application{
+		GUID:    "app-guid",
+		Profile: &applications.ApplicationProfile{Name: "Test App"},
+	}
+	findingsList := []findings.Finding{
+		{
+			IssueID:  123,
+			ScanType: findings.ScanTypeStatic,
+			FindingDetails: map[string]interface{}{
+				"severity":         float64(4),
+				"file_path":        "src/Main.java",
+				"file_line_number": float64(42),
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := ExportFindingsJSON(&buf, app, findingsList); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !strings.Contains(buf.String(), "\n  \"application_guid\"") {
+		t.Errorf("Expected indented output, got %s", buf.String())
+	}
+
+	var doc FindingsDocument
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("Failed to parse exported document: %v", err)
+	}
+
+	if doc.ApplicationGUID != "app-guid" {
+		t.Errorf("Expected application GUID 'app-guid', got %s", doc.ApplicationGUID)
+	}
+	if doc.ApplicationName != "Test App" {
+		t.Errorf("Expected application name 'Test App', got %s", doc.ApplicationName)
+	}
+	if doc.ExportedAt.IsZero() {
+		t.Error("Expected export timestamp to be set")
+	}
+	if len(doc.Findings) != 1 {
+		t.Fatalf("Expected 1 finding, got %d", len(doc.Findings))
+	}
+
+	details, ok := doc.Findings[0].FindingDetails.(map[string]interface{})
+	if !ok {
+		t.Fatalf("Expected finding details to be preserved, got %T", doc.Findings[0].FindingDetails)
+	}
+	if details["file_path"] != "src/Main.java" {
+		t.Errorf("Expected file_path to be preserved, got %v", details["file_path"])
+	}
+}
+
+func TestExportFindingsJSON_NoFindings(t *testing.T) {
+	app := &applications.Application{GUID: "app-guid"}
+
+	var buf bytes.Buffer
+	if err := ExportFindingsJSON(&buf, app, nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !strings.Contains(buf.String(), `"findings": []`) {
+		t.Errorf("Expected empty findings array, got %s", buf.String())
+	}
+}
+
+func TestExportFindingsJSON_MissingApplication(t *testing.T) {
+	var buf bytes.Buffer
+	if err := ExportFindingsJSON(&buf, nil, nil); err == nil {
+		t.Fatal("Expected error for missing application, got nil")
+	}
+}
@@ -3,6 +3,7 @@ module github.com/dipsylala/veracode-tui
 go 1.24.0
 
 require (
+	github.com/atotto/clipboard v0.1.4
 	github.com/gdamore/tcell/v2 v2.13.2
 	github.com/rivo/tview v0.42.0
 	gopkg.in/yaml.v3 v3.0.1
@@ -1,8 +1,10 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
+	"net/url"
 	"os"
 
 	"github.com/dipsylala/veracode-tui/config"
@@ -14,16 +16,42 @@ import (
 	"github.com/dipsylala/veracode-tui/veracode"
 )
 
+// apiClient is what the services and the TUI need from the API client: the live Veracode client,
+// or a FixtureClient replaying saved responses
+type apiClient interface {
+	DoRequestWithQueryParams(method, urlPath string, params url.Values) ([]byte, error)
+	DoRequestWithBody(method, urlPath string, body []byte, params url.Values) ([]byte, error)
+	HealthCheck() error
+	ClearCache()
+}
+
 // Version is the application version, can be set at build time with -ldflags "-X main.Version=x.y.z"
 var Version = "dev"
 
 func main() {
 	healthcheck := flag.Bool("healthcheck", false, "Perform a healthcheck and exit (does not open TUI)")
+	check := flag.Bool("check", false, "Validate the credentials, print the API user and organization, and exit (does not open TUI)")
 	version := flag.Bool("version", false, "Display version information")
 	help := flag.Bool("help", false, "Display usage information")
 	noColor := flag.Bool("no-color", false, "Disable colors (monochrome mode)")
-	theme := flag.String("theme", "default", "Color theme to use (default, bw, hotdog, matrix)")
+	theme := flag.String("theme", "default", "Color theme to use (default, bw, hotdog, matrix, solarized, high-contrast) or the path to a YAML/JSON theme file")
 	debugLog := flag.String("debug-log", "", "Enable debug logging of REST requests/responses to the specified file")
+	logLevel := flag.String("log-level", "debug", "Most verbose events written to the --debug-log file: error, warn, info or debug")
+	debugRaw := flag.Bool("debug-raw", false, "Write credentials to the debug log without redaction")
+	configPath := flag.String("config", "", "Path to the Veracode credentials file (default: ~/.veracode/veracode.yml)")
+	profile := flag.String("profile", "", "Named credentials profile from the profiles section of the config file")
+	cacheTTL := flag.Duration("cache-ttl", 0, "Cache GET responses for the given duration (default 0, caching disabled)")
+	timeout := flag.Duration("timeout", veracode.DefaultTimeout, "Time limit for each API request (0 disables the timeout)")
+	pageSize := flag.Int("page-size", 0, "Number of applications per page, 10-500 (default 100, or tui.page-size from the config file)")
+	prefetch := flag.Bool("prefetch", false, "Fetch details of the visible applications in the background (or tui.prefetch in the config file)")
+	watchInterval := flag.Duration("watch-interval", 0, "How often to poll a watched application's scan status (default 30s, or tui.watch-interval from the config file)")
+	rateLimit := flag.Int("rate-limit", 0, "Maximum API requests per second (0 means unlimited)")
+	retries := flag.Int("retries", veracode.DefaultRetryAttempts, "Attempts for a GET request that fails with a network error or a 429/502/503/504 status (1 disables retries)")
+	appGUID := flag.String("app", "", "Open the application with this GUID on launch")
+	findingID := flag.Int64("finding", 0, "With --app, open the finding with this issue ID in the policy scan on launch")
+	fixtures := flag.String("fixtures", "", "Serve API responses from JSON fixtures saved under this directory instead of calling the API")
+	record := flag.String("record", "", "Save each successful API response as a fixture under this directory, for replay with --fixtures")
+	clockOffset := flag.Duration("clock-offset", 0, "Add this to the local time when signing requests, to compensate for a skewed system clock (e.g. 90s or -2m)")
 	flag.Parse()
 
 	if *help {
@@ -32,17 +60,41 @@ func main() {
 		fmt.Println("Usage:")
 		fmt.Println("  veracode-tui                       Start the interactive TUI")
 		fmt.Println("  veracode-tui --healthcheck         Test API connectivity and credentials")
+		fmt.Println("  veracode-tui --check               Validate credentials and print the API user and organization")
 		fmt.Println("  veracode-tui --version             Show version information")
 		fmt.Println("  veracode-tui --no-color            Disable colors (monochrome mode)")
-		fmt.Println("  veracode-tui --theme <name>        Set color theme: default, bw, hotdog, matrix (default: default)")
+		fmt.Println("  veracode-tui --theme <name|path>   Set color theme: default, bw, hotdog, matrix, solarized, high-contrast, or a theme file (default: default)")
 		fmt.Println("  veracode-tui --help                Show this help message")
 		fmt.Println("  veracode-tui --debug-log <file>    Log all REST requests/responses to file")
+		fmt.Println("  veracode-tui --log-level <level>   Log only up to error, warn, info or debug (default: debug)")
+		fmt.Println("  veracode-tui --debug-raw           Do not redact credentials in the debug log")
+		fmt.Println("  veracode-tui --config <file>       Read credentials from the given file")
+		fmt.Println("  veracode-tui --profile <name>      Use a named profile from the config file")
+		fmt.Println("  veracode-tui --rate-limit <n>      Limit API requests to n per second (default: unlimited)")
+		fmt.Println("  veracode-tui --retries <n>         Attempts for a GET request that fails temporarily (default: 3, 1 disables)")
+		fmt.Println("  veracode-tui --clock-offset <dur>  Compensate for a skewed system clock when signing requests, e.g. 90s")
+		fmt.Println("  veracode-tui --timeout <dur>       Time limit for each API request, e.g. 90s (default: 30s, 0 disables)")
+		fmt.Println("  veracode-tui --page-size <n>       Applications per page, 10-500 (default: 100)")
+		fmt.Println("  veracode-tui --prefetch            Load visible application details in the background")
+		fmt.Println("  veracode-tui --watch-interval <dur>")
+		fmt.Println("                                     How often to poll scan status when watching, min 5s (default: 30s)")
+		fmt.Println("  veracode-tui --app <guid>          Open an application's detail on launch")
+		fmt.Println("  veracode-tui --app <guid> --finding <id>")
+		fmt.Println("                                     Open a finding's detail in the policy scan on launch")
+		fmt.Println("  veracode-tui --fixtures <dir>      Run offline against saved JSON responses, e.g. fixtures/demo")
+		fmt.Println("  veracode-tui --record <dir>        Save API responses as fixtures for --fixtures, with secrets redacted")
+		fmt.Println("  veracode-tui --cache-ttl <dur>     Cache API responses for the given duration, e.g. 30s, 5m (default: 0, disabled)")
 		fmt.Println()
 		fmt.Println("Configuration:")
 		fmt.Println("  Reads credentials from ~/.veracode/veracode.yml")
 		fmt.Println()
 		fmt.Println("Environment Variables:")
 		fmt.Println("  NO_COLOR                           When set, disables colors (overrides --no-color)")
+		fmt.Println("  VERACODE_API_KEY_ID                API key ID (overrides the config file)")
+		fmt.Println("  VERACODE_API_KEY_SECRET            API key secret (overrides the config file)")
+		fmt.Println("  VERACODE_REGION                    API region (overrides the config file)")
+		fmt.Println("  VERACODE_API_TOKEN                 OAuth bearer token, used when oauth is enabled")
+		fmt.Println("  HTTP_PROXY, HTTPS_PROXY, NO_PROXY  Proxy settings for API requests")
 		fmt.Println()
 		os.Exit(0)
 	}
@@ -53,22 +105,82 @@ func main() {
 		os.Exit(0)
 	}
 
-	cfg, err := config.LoadConfig()
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error loading configuration: %v\n", err)
-		fmt.Fprintf(os.Stderr, "Please ensure ~/.veracode/veracode.yml exists with valid API credentials\n")
-		os.Exit(1)
+	var selectedTheme *ui.Theme
+	if os.Getenv("NO_COLOR") != "" || *noColor {
+		selectedTheme = ui.MonochromeTheme()
+	} else {
+		switch *theme {
+		case "bw":
+			selectedTheme = ui.MonochromeTheme()
+		case "hotdog":
+			selectedTheme = ui.HotdogTheme()
+		case "matrix":
+			selectedTheme = ui.MatrixTheme()
+		case "solarized":
+			selectedTheme = ui.SolarizedDarkTheme()
+		case "high-contrast":
+			selectedTheme = ui.HighContrastTheme()
+		case "default", "":
+			selectedTheme = ui.DefaultTheme()
+		default:
+			var err error
+			if selectedTheme, err = ui.LoadThemeFromFile(*theme); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: %v, using the default theme\n", err)
+				selectedTheme = ui.DefaultTheme()
+			}
+		}
 	}
 
-	keyID, keySecret := cfg.GetAPICredentials()
+	if *fixtures != "" && *record != "" {
+		fmt.Fprintf(os.Stderr, "Error: --record cannot be combined with --fixtures\n")
+		os.Exit(1)
+	}
 
-	client := veracode.NewClient(keyID, keySecret)
+	var cfg *config.VeracodeConfig
+	var client apiClient
+	var live *veracode.Client // nil when replaying fixtures
+	if *fixtures != "" {
+		// Replaying needs no credentials, but a config file still supplies the TUI settings
+		var err error
+		if cfg, err = config.LoadConfigProfile(*configPath, *profile); err != nil {
+			cfg = &config.VeracodeConfig{}
+		}
+		client = veracode.NewFixtureClient(*fixtures)
+		fmt.Printf("Replaying API responses from %s\n", *fixtures)
+	} else {
+		cfg = loadConfigOrRunSetup(selectedTheme, *configPath, *profile, !*healthcheck && !*check)
 
-	if *debugLog != "" {
-		if err := client.EnableDebugLog(*debugLog); err != nil {
-			fmt.Fprintf(os.Stderr, "Warning: Failed to enable debug logging: %v\n", err)
+		if cfg.UsesOAuth() {
+			live = veracode.NewClientWithToken(cfg.OAuth.Token, veracode.ParseRegion(cfg.OAuth.Region))
 		} else {
-			fmt.Printf("Debug logging enabled: %s\n", *debugLog)
+			keyID, keySecret := cfg.GetAPICredentials()
+			live = veracode.NewClientWithRegion(keyID, keySecret, veracode.ParseRegion(cfg.OAuth.Region))
+		}
+		live.EnableCache(*cacheTTL)
+		live.SetRateLimit(*rateLimit, *rateLimit)
+		live.SetRetries(*retries, veracode.DefaultRetryBackoff)
+		live.SetTimeout(*timeout)
+		live.SetClockOffset(*clockOffset)
+		live.SetUserAgent(veracode.UserAgentForVersion(Version))
+
+		if *debugLog != "" {
+			level, err := veracode.ParseLogLevel(*logLevel)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: %v, logging at debug level\n", err)
+				level = veracode.LogLevelDebug
+			}
+			live.SetDebugRedaction(!*debugRaw)
+			if err := live.EnableDebugLog(*debugLog); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: Failed to enable debug logging: %v\n", err)
+			} else {
+				live.SetLogLevel(level)
+				fmt.Printf("Logging at %s level to %s\n", level, *debugLog)
+			}
+		}
+		client = live
+		if *record != "" {
+			client = veracode.NewRecordingClient(live, *record)
+			fmt.Printf("Recording API responses to %s\n", *record)
 		}
 	}
 
@@ -76,6 +188,9 @@ func main() {
 		fmt.Println("🏥 Performing Veracode API healthcheck...")
 		if err := client.HealthCheck(); err != nil {
 			fmt.Fprintf(os.Stderr, "❌ Healthcheck failed: %v\n", err)
+			if veracode.IsClockSkew(err) {
+				fmt.Fprintf(os.Stderr, "The request timestamp was rejected - check your system clock, or set --clock-offset\n")
+			}
 			os.Exit(1)
 		}
 		fmt.Println("✅ Healthcheck successful - API is operational and credentials are valid")
@@ -85,27 +200,77 @@ func main() {
 	appService := applications.NewService(client)
 	findingsService := findings.NewService(client)
 	identityService := identity.NewService(client)
-	annotationsService := annotations.NewService(client)
 
-	var selectedTheme *ui.Theme
-	if os.Getenv("NO_COLOR") != "" || *noColor {
-		selectedTheme = ui.MonochromeTheme()
-	} else {
-		switch *theme {
-		case "bw":
-			selectedTheme = ui.MonochromeTheme()
-		case "hotdog":
-			selectedTheme = ui.HotdogTheme()
-		case "matrix":
-			selectedTheme = ui.MatrixTheme()
-		default:
-			selectedTheme = ui.DefaultTheme()
+	if *check {
+		principal, err := identityService.Validate(context.Background())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Credential check failed: %v\n", err)
+			os.Exit(1)
+		}
+		user := principal.Email
+		if user == "" {
+			user = principal.Username
 		}
+		fmt.Printf("Credentials are valid: %s (%s)\n", user, principal.OrganizationName)
+		os.Exit(0)
 	}
+	annotationsService := annotations.NewService(client)
+	annotationsService.SetDeduplicateInFlight(true)
 
 	tui := ui.NewUI(appService, findingsService, identityService, annotationsService, selectedTheme)
+	if *pageSize != 0 {
+		tui.SetPageSize(*pageSize)
+	} else {
+		tui.SetPageSize(cfg.TUI.PageSize)
+	}
+	tui.SetPrefetchDetails(*prefetch || cfg.TUI.Prefetch)
+	if err := tui.SetFindingsDefaults(cfg.TUI.DefaultScanType, cfg.TUI.DefaultPolicyFilter); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v, using the built-in default\n", err)
+	}
+	if *watchInterval != 0 {
+		tui.SetWatchInterval(*watchInterval)
+	} else {
+		tui.SetWatchInterval(cfg.TUI.WatchInterval)
+	}
+	tui.SetCacheClearer(client.ClearCache)
+	if live != nil {
+		live.SetRetryNotify(tui.NotifyRetry)
+		tui.SetLogger(live)
+		live.SetContext(tui.Context())
+	}
+	if draftsDir, err := config.DefaultDraftsDir(); err == nil {
+		tui.SetDraftStore(config.NewDraftStore(draftsDir))
+	} else {
+		fmt.Fprintf(os.Stderr, "Warning: %v, mitigation drafts cannot be saved\n", err)
+	}
+	tui.SetInitialTarget(*appGUID, *findingID)
 	if err := tui.Run(); err != nil {
 		fmt.Fprintf(os.Stderr, "Error running TUI: %v\n", err)
 		os.Exit(1)
 	}
 }
+
+// loadConfigOrRunSetup loads the credentials profile, exiting on failure. When offerSetup is set,
+// first-time users without a config file are offered an interactive setup instead of an error.
+func loadConfigOrRunSetup(theme *ui.Theme, configPath, profile string, offerSetup bool) *config.VeracodeConfig {
+	cfg, err := config.LoadConfigProfile(configPath, profile)
+	if config.IsNotFound(err) && profile == "" && offerSetup {
+		saved, wizardErr := ui.RunSetupWizard(theme, configPath)
+		if wizardErr != nil {
+			fmt.Fprintf(os.Stderr, "Error running setup: %v\n", wizardErr)
+			os.Exit(1)
+		}
+		if !saved {
+			fmt.Println("Setup skipped - no credentials were saved")
+			os.Exit(0)
+		}
+		cfg, err = config.LoadConfigProfile(configPath, profile)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading configuration: %v\n", err)
+		fmt.Fprintf(os.Stderr, "Please set VERACODE_API_KEY_ID and VERACODE_API_KEY_SECRET, or ensure ~/.veracode/veracode.yml exists with valid API credentials\n")
+		os.Exit(1)
+	}
+
+	return cfg
+}
@@ -0,0 +1,91 @@
+package annotations
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"testing"
+)
+
+func TestBuildIssueList(t *testing.T) {
+	tests := []struct {
+		ids  []int64
+		want string
+	}{
+		{nil, ""},
+		{[]int64{123}, "123"},
+		{[]int64{123, 456, 789}, "123,456,789"},
+		{[]int64{456, 123, 456}, "456,123"},
+	}
+
+	for _, tt := range tests {
+		if got := BuildIssueList(tt.ids); got != tt.want {
+			t.Errorf("BuildIssueList(%v) = %q, want %q", tt.ids, got, tt.want)
+		}
+	}
+}
+
+func TestCreateBatchAnnotation_Success(t *testing.T) {
+	calls := 0
+	client := &MockHTTPClient{
+		DoRequestWithBodyFunc: func(method, urlPath string, body []byte, params url.Values) ([]byte, error) {
+			calls++
+			var data AnnotationData
+			if err := json.Unmarshal(body, &data); err != nil {
+				t.Fatalf("Failed to decode request body: %v", err)
+			}
+			if data.IssueList != "1,2,3" {
+				t.Errorf("Expected joined issue list, got %q", data.IssueList)
+			}
+			return []byte(`{}`), nil
+		},
+	}
+	service := NewService(client)
+
+	result, err := service.CreateBatchAnnotation("app-guid", []int64{1, 2, 3}, "Reviewed", string(ActionComment), nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("Expected a single request, got %d", calls)
+	}
+	if len(result.Succeeded) != 3 || len(result.Failed) != 0 {
+		t.Errorf("Expected all 3 to succeed, got %+v", result)
+	}
+}
+
+func TestCreateBatchAnnotation_PartialFailure(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithBodyFunc: func(method, urlPath string, body []byte, params url.Values) ([]byte, error) {
+			var data AnnotationData
+			if err := json.Unmarshal(body, &data); err != nil {
+				t.Fatalf("Failed to decode request body: %v", err)
+			}
+			// Reject the batch and issue 2 on its own
+			if data.IssueList == "1,2,3" || data.IssueList == "2" {
+				return nil, fmt.Errorf("HTTP 400: issue %s cannot be annotated", data.IssueList)
+			}
+			return []byte(`{}`), nil
+		},
+	}
+	service := NewService(client)
+
+	result, err := service.CreateBatchAnnotation("app-guid", []int64{1, 2, 3}, "Reviewed", string(ActionFalsePositive), nil)
+	if err != nil {
+		t.Fatalf("Expected partial result without error, got %v", err)
+	}
+	if len(result.Succeeded) != 2 || result.Succeeded[0] != 1 || result.Succeeded[1] != 3 {
+		t.Errorf("Expected issues 1 and 3 to succeed, got %v", result.Succeeded)
+	}
+	if _, ok := result.Failed[2]; !ok || len(result.Failed) != 1 {
+		t.Errorf("Expected only issue 2 to fail, got %v", result.Failed)
+	}
+}
+
+func TestCreateBatchAnnotation_NoIDs(t *testing.T) {
+	service := NewService(&MockHTTPClient{})
+
+	if _, err := service.CreateBatchAnnotation("app-guid", nil, "Reviewed", string(ActionComment), nil); err == nil {
+		t.Error("Expected error for empty issue IDs")
+	}
+}
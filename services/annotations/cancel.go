@@ -0,0 +1,13 @@
+package annotations
+
+// CancelProposalAction is the annotation action used to cancel a pending mitigation proposal. The
+// Annotations API has no separate cancel action, so a proposal is withdrawn by rejecting it, after
+// which the finding's resolution status is REJECTED and a new mitigation can be proposed.
+const CancelProposalAction = ActionRejected
+
+// CancelProposals cancels the pending mitigation proposals on several findings, recording comment
+// as the reason. Like CreateBatchAnnotation, the result reports which findings succeeded. Callers
+// should first check that each finding has a pending proposal.
+func (s *Service) CancelProposals(applicationGUID string, ids []int64, comment string, opts *CreateAnnotationOptions) (*BatchAnnotationResult, error) {
+	return s.CreateBatchAnnotation(applicationGUID, ids, comment, string(CancelProposalAction), opts)
+}
@@ -0,0 +1,39 @@
+package annotations
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+)
+
+func TestCancelProposals_PostsRejection(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithBodyFunc: func(method, urlPath string, body []byte, params url.Values) ([]byte, error) {
+			if method != "POST" || urlPath != "/appsec/v2/applications/app-guid/annotations" {
+				t.Errorf("Unexpected request %s %s", method, urlPath)
+			}
+			if got := params.Get("context"); got != "sandbox-guid" {
+				t.Errorf("Expected the sandbox context, got %q", got)
+			}
+
+			var data AnnotationData
+			if err := json.Unmarshal(body, &data); err != nil {
+				t.Fatalf("Failed to decode request body: %v", err)
+			}
+			want := AnnotationData{IssueList: "7,9", Comment: "Proposed in error", Action: "REJECTED"}
+			if data != want {
+				t.Errorf("Expected request body %+v, got %+v", want, data)
+			}
+			return []byte(`{}`), nil
+		},
+	}
+
+	opts := &CreateAnnotationOptions{Context: "sandbox-guid"}
+	result, err := NewService(client).CancelProposals("app-guid", []int64{7, 9}, "Proposed in error", opts)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(result.Succeeded) != 2 || len(result.Failed) != 0 {
+		t.Errorf("Expected both proposals canceled, got %+v", result)
+	}
+}
@@ -0,0 +1,60 @@
+package annotations
+
+import "sync"
+
+// inFlightCall tracks a CreateAnnotation request that identical concurrent calls wait on
+type inFlightCall struct {
+	done   chan struct{}
+	result *AnnotationResponse
+	err    error
+}
+
+// inFlightGroup collapses identical concurrent annotation requests into one
+type inFlightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*inFlightCall
+}
+
+// do runs fn for key unless an identical call is already in flight, in which case it waits
+// for that call and returns its result
+func (g *inFlightGroup) do(key string, fn func() (*AnnotationResponse, error)) (*AnnotationResponse, error) {
+	g.mu.Lock()
+	if call, ok := g.calls[key]; ok {
+		g.mu.Unlock()
+		<-call.done
+		return call.result, call.err
+	}
+	call := &inFlightCall{done: make(chan struct{})}
+	g.calls[key] = call
+	g.mu.Unlock()
+
+	call.result, call.err = fn()
+
+	g.mu.Lock()
+	delete(g.calls, key)
+	g.mu.Unlock()
+	close(call.done)
+
+	return call.result, call.err
+}
+
+// SetDeduplicateInFlight controls whether identical concurrent CreateAnnotation calls share a single
+// request. Calls are identical when they have the same application GUID, scan context, issue list
+// and action.
+func (s *Service) SetDeduplicateInFlight(enabled bool) {
+	if enabled {
+		s.inFlight = &inFlightGroup{calls: make(map[string]*inFlightCall)}
+	} else {
+		s.inFlight = nil
+	}
+}
+
+// inFlightKey identifies an annotation request for de-duplication. The policy scan has an empty
+// context, so it never matches a sandbox.
+func inFlightKey(applicationGUID string, annotation *AnnotationData, opts *CreateAnnotationOptions) string {
+	context := ""
+	if opts != nil {
+		context = opts.Context
+	}
+	return applicationGUID + "|" + context + "|" + annotation.IssueList + "|" + annotation.Action
+}
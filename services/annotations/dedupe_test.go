@@ -0,0 +1,119 @@
+package annotations
+
+import (
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCreateAnnotation_DeduplicatesConcurrentCalls(t *testing.T) {
+	var calls int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+	client := &MockHTTPClient{
+		DoRequestWithBodyFunc: func(method, urlPath string, body []byte, params url.Values) ([]byte, error) {
+			atomic.AddInt32(&calls, 1)
+			close(started)
+			<-release
+			return []byte(`{}`), nil
+		},
+	}
+	service := NewService(client)
+	service.SetDeduplicateInFlight(true)
+
+	annotation := &AnnotationData{IssueList: "123", Comment: "Reviewed", Action: string(ActionComment)}
+	errs := make([]error, 2)
+	var wg sync.WaitGroup
+	submit := func(i int) {
+		defer wg.Done()
+		_, errs[i] = service.CreateAnnotation("app-guid", annotation, nil)
+	}
+
+	wg.Add(2)
+	go submit(0)
+	<-started
+	go submit(1)
+
+	// Give the second call time to join the in-flight request before letting it complete. Had it
+	// not joined, it would make its own network call.
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("Expected one network call, got %d", n)
+	}
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("Call %d: expected no error, got %v", i, err)
+		}
+	}
+}
+
+func TestCreateAnnotation_NoDeduplicationByDefault(t *testing.T) {
+	calls := 0
+	client := &MockHTTPClient{
+		DoRequestWithBodyFunc: func(method, urlPath string, body []byte, params url.Values) ([]byte, error) {
+			calls++
+			return []byte(`{}`), nil
+		},
+	}
+	service := NewService(client)
+
+	annotation := &AnnotationData{IssueList: "123", Comment: "Reviewed", Action: string(ActionComment)}
+	for i := 0; i < 2; i++ {
+		if _, err := service.CreateAnnotation("app-guid", annotation, nil); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("Expected two network calls, got %d", calls)
+	}
+}
+
+func TestCreateAnnotation_DoesNotMergeDifferentContexts(t *testing.T) {
+	var calls int32
+	second := make(chan struct{})
+	var contexts sync.Map
+	client := &MockHTTPClient{
+		DoRequestWithBodyFunc: func(method, urlPath string, body []byte, params url.Values) ([]byte, error) {
+			contexts.Store(params.Get("context"), true)
+			// The first request stays in flight until the second arrives, so merging them would time out
+			if atomic.AddInt32(&calls, 1) == 1 {
+				select {
+				case <-second:
+				case <-time.After(5 * time.Second):
+				}
+			} else {
+				close(second)
+			}
+			return []byte(`{}`), nil
+		},
+	}
+	service := NewService(client)
+	service.SetDeduplicateInFlight(true)
+
+	annotation := &AnnotationData{IssueList: "123", Comment: "Reviewed", Action: string(ActionComment)}
+	var wg sync.WaitGroup
+	for _, opts := range []*CreateAnnotationOptions{nil, {Context: "sandbox-1"}} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := service.CreateAnnotation("app-guid", annotation, opts); err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := atomic.LoadInt32(&calls); n != 2 {
+		t.Errorf("Expected a network call for the policy scan and one for the sandbox, got %d", n)
+	}
+	for _, context := range []string{"", "sandbox-1"} {
+		if _, ok := contexts.Load(context); !ok {
+			t.Errorf("Expected a request in context %q", context)
+		}
+	}
+}
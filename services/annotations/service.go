@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"strconv"
+	"strings"
 )
 
 const (
@@ -12,7 +14,8 @@ const (
 
 // Service provides methods to interact with the Veracode Annotations API
 type Service struct {
-	client HTTPClient
+	client   HTTPClient
+	inFlight *inFlightGroup // nil unless in-flight de-duplication is enabled
 }
 
 // HTTPClient interface for making HTTP requests
@@ -44,6 +47,16 @@ func (s *Service) CreateAnnotation(applicationGUID string, annotation *Annotatio
 		return nil, fmt.Errorf("issue_list is required")
 	}
 
+	if s.inFlight != nil {
+		return s.inFlight.do(inFlightKey(applicationGUID, annotation, opts), func() (*AnnotationResponse, error) {
+			return s.createAnnotation(applicationGUID, annotation, opts)
+		})
+	}
+	return s.createAnnotation(applicationGUID, annotation, opts)
+}
+
+// createAnnotation posts a validated annotation
+func (s *Service) createAnnotation(applicationGUID string, annotation *AnnotationData, opts *CreateAnnotationOptions) (*AnnotationResponse, error) {
 	params := url.Values{}
 	if opts != nil && opts.Context != "" {
 		params.Add("context", opts.Context)
@@ -69,3 +82,66 @@ func (s *Service) CreateAnnotation(applicationGUID string, annotation *Annotatio
 
 	return &result, nil
 }
+
+// BuildIssueList joins issue IDs into the comma-separated form expected by IssueList,
+// skipping duplicates while preserving order
+func BuildIssueList(ids []int64) string {
+	seen := make(map[int64]bool, len(ids))
+	parts := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		parts = append(parts, strconv.FormatInt(id, 10))
+	}
+	return strings.Join(parts, ",")
+}
+
+// BatchAnnotationResult reports the outcome of annotating several findings at once
+type BatchAnnotationResult struct {
+	Succeeded []int64         // Issue IDs that were annotated
+	Failed    map[int64]error // Issue IDs that were rejected, with the reason
+}
+
+// CreateBatchAnnotation applies one action and comment to several findings.
+// The IDs are submitted together; if the API rejects the batch, each ID is retried
+// individually so the result reports exactly which findings succeeded.
+func (s *Service) CreateBatchAnnotation(applicationGUID string, ids []int64, comment, action string, opts *CreateAnnotationOptions) (*BatchAnnotationResult, error) {
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("at least one issue ID is required")
+	}
+
+	annotation := &AnnotationData{
+		IssueList: BuildIssueList(ids),
+		Comment:   comment,
+		Action:    action,
+	}
+
+	result := &BatchAnnotationResult{Failed: make(map[int64]error)}
+
+	_, err := s.CreateAnnotation(applicationGUID, annotation, opts)
+	if err == nil {
+		for _, part := range strings.Split(annotation.IssueList, ",") {
+			id, _ := strconv.ParseInt(part, 10, 64)
+			result.Succeeded = append(result.Succeeded, id)
+		}
+		return result, nil
+	}
+	if len(ids) == 1 || applicationGUID == "" {
+		return nil, err
+	}
+
+	// The batch was rejected as a whole; find out which IDs are acceptable on their own
+	for _, part := range strings.Split(annotation.IssueList, ",") {
+		id, _ := strconv.ParseInt(part, 10, 64)
+		single := &AnnotationData{IssueList: part, Comment: comment, Action: action}
+		if _, singleErr := s.CreateAnnotation(applicationGUID, single, opts); singleErr != nil {
+			result.Failed[id] = singleErr
+		} else {
+			result.Succeeded = append(result.Succeeded, id)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,84 @@
+package applications
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+)
+
+func TestGetAllApplications_ConcatenatesPages(t *testing.T) {
+	var pages, names []string
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			page := params.Get("page")
+			pages = append(pages, page)
+			names = append(names, params.Get("name"))
+			switch page {
+			case "":
+				return []byte(`{"_embedded":{"applications":[{"guid":"app-1"},{"guid":"app-2"}]},"page":{"total_elements":5,"total_pages":3}}`), nil
+			case "1":
+				return []byte(`{"_embedded":{"applications":[{"guid":"app-3"},{"guid":"app-4"}]},"page":{"total_elements":5,"total_pages":3}}`), nil
+			}
+			return []byte(`{"_embedded":{"applications":[{"guid":"app-5"}]},"page":{"total_elements":5,"total_pages":3}}`), nil
+		},
+	}
+	service := NewService(client)
+
+	var progress []string
+	apps, err := service.GetAllApplications(&GetApplicationsOptions{Name: "shop", Page: 7}, func(fetched, total int) {
+		progress = append(progress, fmt.Sprintf("%d/%d", fetched, total))
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(apps) != 5 || apps[0].GUID != "app-1" || apps[4].GUID != "app-5" {
+		t.Errorf("Expected 5 applications across pages, got %+v", apps)
+	}
+	if fmt.Sprint(pages) != "[ 1 2]" {
+		t.Errorf("Expected pages 0 to 2 to be requested, got %q", pages)
+	}
+	if fmt.Sprint(names) != "[shop shop shop]" {
+		t.Errorf("Expected the name filter on every page, got %q", names)
+	}
+	if fmt.Sprint(progress) != "[2/5 4/5 5/5]" {
+		t.Errorf("Unexpected progress reports: %q", progress)
+	}
+}
+
+func TestGetAllApplications_ContinuesPastFilteredEmptyPage(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			if params.Get("page") == "" {
+				return []byte(`{"_embedded":{"applications":[{"guid":"app-new","modified":"2025-06-01T00:00:00.000Z"}]},"page":{"total_pages":2}}`), nil
+			}
+			return []byte(`{"_embedded":{"applications":[{"guid":"app-old","modified":"2024-01-01T00:00:00.000Z"}]},"page":{"total_pages":2}}`), nil
+		},
+	}
+	service := NewService(client)
+
+	apps, err := service.GetAllApplications(&GetApplicationsOptions{ModifiedBefore: "2024-12-31"}, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(apps) != 1 || apps[0].GUID != "app-old" {
+		t.Errorf("Expected only the application on the second page, got %+v", apps)
+	}
+}
+
+func TestGetAllApplications_StopsAtMaxPages(t *testing.T) {
+	calls := 0
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			calls++
+			return []byte(`{"_embedded":{"applications":[{"guid":"app"}]},"page":{"total_pages":1000000}}`), nil
+		},
+	}
+	service := NewService(client)
+
+	if _, err := service.GetAllApplications(nil, nil); err == nil {
+		t.Fatal("Expected an error once the page cap is reached, got nil")
+	}
+	if calls != maxApplicationPages {
+		t.Errorf("Expected %d requests, got %d", maxApplicationPages, calls)
+	}
+}
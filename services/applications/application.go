@@ -0,0 +1,56 @@
+package applications
+
+// The API omits the profile of applications the caller cannot fully see, so these accessors are safe
+// to call on an application without one (or a nil application), returning the zero value. Callers
+// choose their own placeholder for display.
+
+// Name returns the application's profile name, or "" when it has no profile
+func (a *Application) Name() string {
+	if a == nil || a.Profile == nil {
+		return ""
+	}
+	return a.Profile.Name
+}
+
+// Description returns the application's profile description, or "" when it has no profile
+func (a *Application) Description() string {
+	if a == nil || a.Profile == nil {
+		return ""
+	}
+	return a.Profile.Description
+}
+
+// BusinessUnitName returns the name of the application's business unit, or "" when it has none
+func (a *Application) BusinessUnitName() string {
+	if a == nil || a.Profile == nil || a.Profile.BusinessUnit == nil {
+		return ""
+	}
+	return a.Profile.BusinessUnit.Name
+}
+
+// BusinessCriticality returns the application's business criticality, e.g. "HIGH", or "" when it
+// has no profile
+func (a *Application) BusinessCriticality() string {
+	if a == nil || a.Profile == nil {
+		return ""
+	}
+	return a.Profile.BusinessCriticality
+}
+
+// Policies returns the policies assigned to the application, or nil when it has no profile
+func (a *Application) Policies() []AppPolicy {
+	if a == nil || a.Profile == nil {
+		return nil
+	}
+	return a.Profile.Policies
+}
+
+// PolicyComplianceStatus returns the compliance status of the application's first policy, or ""
+// when it has none
+func (a *Application) PolicyComplianceStatus() string {
+	policies := a.Policies()
+	if len(policies) == 0 {
+		return ""
+	}
+	return policies[0].PolicyComplianceStatus
+}
@@ -0,0 +1,74 @@
+package applications
+
+import "testing"
+
+func TestApplicationAccessors_WithoutProfile(t *testing.T) {
+	for name, app := range map[string]*Application{
+		"nil profile":     {GUID: "app-guid"},
+		"nil application": nil,
+	} {
+		t.Run(name, func(t *testing.T) {
+			if got := app.Name(); got != "" {
+				t.Errorf("Name() = %q, want empty", got)
+			}
+			if got := app.Description(); got != "" {
+				t.Errorf("Description() = %q, want empty", got)
+			}
+			if got := app.BusinessUnitName(); got != "" {
+				t.Errorf("BusinessUnitName() = %q, want empty", got)
+			}
+			if got := app.BusinessCriticality(); got != "" {
+				t.Errorf("BusinessCriticality() = %q, want empty", got)
+			}
+			if got := app.Policies(); got != nil {
+				t.Errorf("Policies() = %v, want nil", got)
+			}
+			if got := app.PolicyComplianceStatus(); got != "" {
+				t.Errorf("PolicyComplianceStatus() = %q, want empty", got)
+			}
+		})
+	}
+}
+
+func TestApplicationAccessors_ProfileWithoutBusinessUnitOrPolicies(t *testing.T) {
+	app := &Application{Profile: &ApplicationProfile{Name: "My App"}}
+
+	if got := app.Name(); got != "My App" {
+		t.Errorf("Name() = %q, want %q", got, "My App")
+	}
+	if got := app.BusinessUnitName(); got != "" {
+		t.Errorf("BusinessUnitName() = %q, want empty", got)
+	}
+	if got := app.PolicyComplianceStatus(); got != "" {
+		t.Errorf("PolicyComplianceStatus() = %q, want empty", got)
+	}
+}
+
+func TestApplicationAccessors_WithProfile(t *testing.T) {
+	app := &Application{Profile: &ApplicationProfile{
+		Name:                "My App",
+		Description:         "Payments service",
+		BusinessCriticality: "HIGH",
+		BusinessUnit:        &BusinessUnit{Name: "Retail"},
+		Policies: []AppPolicy{
+			{Name: "Default", PolicyComplianceStatus: "PASSED"},
+			{Name: "Strict", PolicyComplianceStatus: "DID_NOT_PASS"},
+		},
+	}}
+
+	if got := app.Description(); got != "Payments service" {
+		t.Errorf("Description() = %q, want %q", got, "Payments service")
+	}
+	if got := app.BusinessUnitName(); got != "Retail" {
+		t.Errorf("BusinessUnitName() = %q, want %q", got, "Retail")
+	}
+	if got := app.BusinessCriticality(); got != "HIGH" {
+		t.Errorf("BusinessCriticality() = %q, want %q", got, "HIGH")
+	}
+	if got := len(app.Policies()); got != 2 {
+		t.Errorf("len(Policies()) = %d, want 2", got)
+	}
+	if got := app.PolicyComplianceStatus(); got != "PASSED" {
+		t.Errorf("PolicyComplianceStatus() = %q, want the first policy's status", got)
+	}
+}
@@ -0,0 +1,131 @@
+package applications
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+)
+
+// MockHTTPClient is a mock implementation of HTTPClient for testing
+type MockHTTPClient struct {
+	DoRequestWithQueryParamsFunc func(method, urlPath string, params url.Values) ([]byte, error)
+}
+
+func (m *MockHTTPClient) DoRequestWithQueryParams(method, urlPath string, params url.Values) ([]byte, error) {
+	if m.DoRequestWithQueryParamsFunc != nil {
+		return m.DoRequestWithQueryParamsFunc(method, urlPath, params)
+	}
+	return []byte("{}"), nil
+}
+
+func TestGetApplicationCached_ReusesResult(t *testing.T) {
+	calls := 0
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			calls++
+			return []byte(`{"guid":"app-guid","profile":{"name":"Cached App"}}`), nil
+		},
+	}
+	service := NewService(client)
+
+	for i := 0; i < 3; i++ {
+		app, err := service.GetApplicationCached("app-guid")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if app.Profile == nil || app.Profile.Name != "Cached App" {
+			t.Errorf("Expected cached application, got %+v", app)
+		}
+	}
+
+	if calls != 1 {
+		t.Errorf("Expected 1 API call, got %d", calls)
+	}
+
+	service.InvalidateApplication("app-guid")
+	if _, err := service.GetApplicationCached("app-guid"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("Expected invalidation to force a refetch, got %d calls", calls)
+	}
+
+	service.ClearApplicationCache()
+	if _, err := service.GetApplicationCached("app-guid"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("Expected clearing the cache to force a refetch, got %d calls", calls)
+	}
+}
+
+func TestGetApplicationCached_DoesNotCacheErrors(t *testing.T) {
+	calls := 0
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			calls++
+			if calls == 1 {
+				return nil, fmt.Errorf("HTTP 404: not found")
+			}
+			return []byte(`{"guid":"app-guid"}`), nil
+		},
+	}
+	service := NewService(client)
+
+	if _, err := service.GetApplicationCached("app-guid"); err == nil {
+		t.Fatal("Expected error on first lookup, got nil")
+	}
+
+	app, err := service.GetApplicationCached("app-guid")
+	if err != nil {
+		t.Fatalf("Expected second lookup to succeed, got %v", err)
+	}
+	if app.GUID != "app-guid" {
+		t.Errorf("Expected GUID 'app-guid', got %s", app.GUID)
+	}
+
+	if _, err := service.GetApplicationCached(""); err == nil {
+		t.Error("Expected error for empty GUID, got nil")
+	}
+}
+
+func TestGetApplicationCached_ReturnsIndependentCopies(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			return []byte(`{"guid":"app-guid","profile":{"name":"Cached App","teams":[{"team_name":"Red"}]},"scans":[{"status":"PUBLISHED"}]}`), nil
+		},
+	}
+	service := NewService(client)
+
+	for i := 0; i < 2; i++ {
+		app, err := service.GetApplicationCached("app-guid")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if app.Profile.Name != "Cached App" || app.Profile.Teams[0].TeamName != "Red" || app.Scans[0].Status != "PUBLISHED" {
+			t.Fatalf("Expected the application as fetched, got %+v", app)
+		}
+		app.Profile.Name = "Changed"
+		app.Profile.Teams[0].TeamName = "Blue"
+		app.Scans[0].Status = "CANCELLED"
+	}
+}
+
+// evictingClient records the paths evicted from its cache
+type evictingClient struct {
+	MockHTTPClient
+	evicted []string
+}
+
+func (c *evictingClient) EvictCached(urlPath string) {
+	c.evicted = append(c.evicted, urlPath)
+}
+
+func TestInvalidateApplication_EvictsClientCache(t *testing.T) {
+	client := &evictingClient{}
+	NewService(client).InvalidateApplication("app-guid")
+
+	if len(client.evicted) != 1 || client.evicted[0] != "/appsec/v1/applications/app-guid" {
+		t.Errorf("Expected the application's response to be evicted, got %q", client.evicted)
+	}
+}
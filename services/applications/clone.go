@@ -0,0 +1,46 @@
+package applications
+
+import (
+	"maps"
+	"slices"
+)
+
+// cloneApplication returns a copy of app that shares no profile, scan or custom field data with it,
+// so the detail cache cannot be changed through an application it returned. Timestamps are
+// shared, as they are never modified in place.
+func cloneApplication(app *Application) *Application {
+	clone := *app
+	clone.Profile = cloneProfile(app.Profile)
+	clone.Scans = slices.Clone(app.Scans)
+	for i := range clone.Scans {
+		clone.Scans[i].DisplayStatus = maps.Clone(clone.Scans[i].DisplayStatus)
+	}
+	return &clone
+}
+
+func cloneProfile(profile *ApplicationProfile) *ApplicationProfile {
+	if profile == nil {
+		return nil
+	}
+	clone := *profile
+	if profile.BusinessUnit != nil {
+		unit := *profile.BusinessUnit
+		clone.BusinessUnit = &unit
+	}
+	if profile.Settings != nil {
+		settings := *profile.Settings
+		clone.Settings = &settings
+	}
+	clone.BusinessOwners = slices.Clone(profile.BusinessOwners)
+	clone.Policies = slices.Clone(profile.Policies)
+	clone.Teams = slices.Clone(profile.Teams)
+	clone.CustomFields = slices.Clone(profile.CustomFields)
+	clone.CustomFieldValues = slices.Clone(profile.CustomFieldValues)
+	for i, value := range clone.CustomFieldValues {
+		if value.AppCustomFieldName != nil {
+			name := *value.AppCustomFieldName
+			clone.CustomFieldValues[i].AppCustomFieldName = &name
+		}
+	}
+	return &clone
+}
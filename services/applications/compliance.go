@@ -0,0 +1,29 @@
+package applications
+
+import "slices"
+
+// Policy compliance statuses accepted by the policy_compliance filter
+const (
+	PolicyCompliancePassed          = "PASSED"
+	PolicyComplianceDidNotPass      = "DID_NOT_PASS"
+	PolicyComplianceConditionalPass = "CONDITIONAL_PASS"
+	PolicyComplianceNotAssessed     = "NOT_ASSESSED"
+	PolicyComplianceDetermining     = "DETERMINING"
+	PolicyComplianceVendorReview    = "VENDOR_REVIEW"
+)
+
+// PolicyComplianceStatuses lists the policy compliance statuses that can be filtered on, most
+// commonly used first
+var PolicyComplianceStatuses = []string{
+	PolicyCompliancePassed,
+	PolicyComplianceDidNotPass,
+	PolicyComplianceConditionalPass,
+	PolicyComplianceNotAssessed,
+	PolicyComplianceDetermining,
+	PolicyComplianceVendorReview,
+}
+
+// IsPolicyComplianceStatus reports whether status is one of PolicyComplianceStatuses
+func IsPolicyComplianceStatus(status string) bool {
+	return slices.Contains(PolicyComplianceStatuses, status)
+}
@@ -0,0 +1,27 @@
+package applications
+
+import "testing"
+
+func TestBuildApplicationQueryParams_PolicyCompliance(t *testing.T) {
+	for _, status := range PolicyComplianceStatuses {
+		params, err := buildApplicationQueryParams(&GetApplicationsOptions{PolicyCompliance: status})
+		if err != nil {
+			t.Fatalf("Expected no error for %s, got %v", status, err)
+		}
+		if got := params["policy_compliance"]; len(got) != 1 || got[0] != status {
+			t.Errorf("Expected policy_compliance=%s, got %v", status, got)
+		}
+	}
+
+	params, err := buildApplicationQueryParams(&GetApplicationsOptions{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if params.Has("policy_compliance") {
+		t.Errorf("Expected no policy_compliance when unset, got %q", params.Encode())
+	}
+
+	if _, err := buildApplicationQueryParams(&GetApplicationsOptions{PolicyCompliance: "CONDITIONAL"}); err == nil {
+		t.Error("Expected an error for an unknown policy compliance status")
+	}
+}
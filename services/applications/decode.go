@@ -0,0 +1,51 @@
+package applications
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/dipsylala/veracode-tui/veracode"
+)
+
+// decodeApplicationsPage decodes a page of applications. If the typed decode fails, for example
+// because Veracode changed the type of a field, the applications are decoded one at a time and the
+// malformed ones skipped, so one bad record does not fail the whole list.
+func (s *Service) decodeApplicationsPage(body []byte) (*PagedResourceOfApplication, error) {
+	var result PagedResourceOfApplication
+	err := veracode.DecodeJSON(body, &result)
+	if err == nil {
+		return &result, nil
+	}
+
+	var envelope struct {
+		Embedded *struct {
+			Applications json.RawMessage `json:"applications"`
+		} `json:"_embedded"`
+		Links json.RawMessage `json:"_links"`
+		Page  json.RawMessage `json:"page"`
+	}
+	if json.Unmarshal(body, &envelope) != nil {
+		return nil, fmt.Errorf("failed to parse applications response: %w", err)
+	}
+
+	var partial PagedResourceOfApplication
+	skipped := 0
+	if envelope.Embedded != nil {
+		apps, n, elementsErr := veracode.DecodeElements[Application](envelope.Embedded.Applications)
+		if elementsErr != nil {
+			return nil, fmt.Errorf("failed to parse applications response: %w", err)
+		}
+		partial.Embedded = &EmbeddedApplication{Applications: apps}
+		skipped = n
+	}
+	// Paging and links are best effort; the list still renders without them
+	if json.Unmarshal(envelope.Page, &partial.Page) != nil {
+		partial.Page = nil
+	}
+	if json.Unmarshal(envelope.Links, &partial.Links) != nil {
+		partial.Links = nil
+	}
+
+	veracode.Warnf(s.client, "Applications response did not fully decode, skipped %d malformed applications: %v", skipped, err)
+	return &partial, nil
+}
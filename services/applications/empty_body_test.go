@@ -0,0 +1,44 @@
+package applications
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestGetApplications_EmptyBody(t *testing.T) {
+	for _, body := range []string{"", " \n"} {
+		client := &MockHTTPClient{
+			DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+				return []byte(body), nil
+			},
+		}
+		service := NewService(client)
+
+		result, err := service.GetApplications(nil)
+		if err != nil {
+			t.Fatalf("Expected no error for body %q, got %v", body, err)
+		}
+		if result == nil {
+			t.Fatalf("Expected a result for body %q, got nil", body)
+		}
+		if result.Embedded != nil || result.Page != nil {
+			t.Errorf("Expected an empty result for body %q, got %+v", body, result)
+		}
+	}
+}
+
+func TestGetSandboxes_EmptyBody(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			return nil, nil
+		},
+	}
+
+	result, err := NewService(client).GetSandboxes("app-guid", nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result == nil || result.Embedded != nil {
+		t.Errorf("Expected an empty result, got %+v", result)
+	}
+}
@@ -0,0 +1,200 @@
+package applications
+
+import (
+	"net/url"
+	"testing"
+)
+
+const modifiedApplicationsResponse = `{
+	"_embedded": {
+		"applications": [
+			{"guid": "old", "modified": "2024-12-31T23:59:59.000Z"},
+			{"guid": "boundary", "modified": "2025-01-15T18:30:00.000Z"},
+			{"guid": "new", "modified": "2025-01-16T00:00:01.000Z"},
+			{"guid": "unknown"}
+		]
+	}
+}`
+
+func TestGetApplications_ModifiedBefore(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			if params.Get("modified_after") != "2024-01-01" {
+				t.Errorf("Expected modified_after to be sent, got %q", params.Get("modified_after"))
+			}
+			return []byte(modifiedApplicationsResponse), nil
+		},
+	}
+	service := NewService(client)
+
+	result, err := service.GetApplications(&GetApplicationsOptions{ModifiedAfter: "2024-01-01", ModifiedBefore: "2025-01-15"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	apps := result.Embedded.Applications
+	if len(apps) != 2 || apps[0].GUID != "old" || apps[1].GUID != "boundary" {
+		t.Errorf("Expected applications modified on or before 2025-01-15, got %+v", apps)
+	}
+}
+
+func TestGetApplications_InvalidModifiedBefore(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			return []byte(modifiedApplicationsResponse), nil
+		},
+	}
+	service := NewService(client)
+
+	if _, err := service.GetApplications(&GetApplicationsOptions{ModifiedBefore: "15/01/2025"}); err == nil {
+		t.Error("Expected an error for an invalid modified before date")
+	}
+}
+
+func TestGetApplications_MultipleScanTypes(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			got := params["scan_type"]
+			if len(got) != 2 || got[0] != "STATIC" || got[1] != "DYNAMIC" {
+				t.Errorf("Expected scan_type=STATIC and scan_type=DYNAMIC, got %v", got)
+			}
+			return []byte(`{}`), nil
+		},
+	}
+
+	// The deprecated single field is not sent twice when it is also in ScanTypes
+	opts := &GetApplicationsOptions{ScanTypes: []string{"STATIC", "DYNAMIC"}, ScanType: "DYNAMIC"}
+	if _, err := NewService(client).GetApplications(opts); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestGetApplications_SingleScanType(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			if got := params["scan_type"]; len(got) != 1 || got[0] != "MANUAL" {
+				t.Errorf("Expected scan_type=MANUAL, got %v", got)
+			}
+			return []byte(`{}`), nil
+		},
+	}
+
+	if _, err := NewService(client).GetApplications(&GetApplicationsOptions{ScanType: "MANUAL"}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestGetApplications_SkipsMalformedApplications(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			return []byte(`{
+				"_embedded": {"applications": [
+					{"guid": "first", "profile": {"name": "First"}},
+					{"guid": "bad", "profile": {"name": 42}},
+					{"guid": "second", "modified": "not a date"},
+					{"guid": "third"}
+				]},
+				"page": {"number": 0, "size": 4, "total_elements": 4, "total_pages": 1}
+			}`), nil
+		},
+	}
+
+	result, err := NewService(client).GetApplications(nil)
+	if err != nil {
+		t.Fatalf("Expected the valid applications despite malformed ones, got %v", err)
+	}
+
+	apps := result.Embedded.Applications
+	if len(apps) != 2 || apps[0].GUID != "first" || apps[1].GUID != "third" {
+		t.Errorf("Expected applications first and third, got %+v", apps)
+	}
+	if result.Page == nil || result.Page.TotalElements != 4 {
+		t.Errorf("Expected the page metadata to be kept, got %+v", result.Page)
+	}
+}
+
+func TestGetApplications_UnparseableResponse(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			return []byte(`{"_embedded": {"applications": {"guid": "not an array"}}}`), nil
+		},
+	}
+
+	if _, err := NewService(client).GetApplications(nil); err == nil {
+		t.Error("Expected an error when the applications are not an array")
+	}
+}
+
+func TestGetApplications_ModifiedBeforePagesFilteredResults(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			if params.Get("size") != "500" {
+				t.Errorf("Expected the largest page size while fetching every application, got %q", params.Get("size"))
+			}
+			if params.Get("page") == "" {
+				return []byte(`{"_embedded":{"applications":[
+					{"guid":"old-1","modified":"2024-01-01T00:00:00.000Z"},
+					{"guid":"new","modified":"2025-06-01T00:00:00.000Z"},
+					{"guid":"old-2","modified":"2024-02-01T00:00:00.000Z"}
+				]},"page":{"total_elements":5,"total_pages":2}}`), nil
+			}
+			return []byte(`{"_embedded":{"applications":[
+				{"guid":"old-3","modified":"2024-03-01T00:00:00.000Z"},
+				{"guid":"newer","modified":"2025-07-01T00:00:00.000Z"}
+			]},"page":{"total_elements":5,"total_pages":2}}`), nil
+		},
+	}
+	service := NewService(client)
+
+	result, err := service.GetApplications(&GetApplicationsOptions{ModifiedBefore: "2024-12-31", Page: 1, Size: 2})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	apps := result.Embedded.Applications
+	if len(apps) != 1 || apps[0].GUID != "old-3" {
+		t.Errorf("Expected the third matching application on the second page, got %+v", apps)
+	}
+	want := PageMetadata{Number: 1, Size: 2, TotalElements: 3, TotalPages: 2}
+	if result.Page == nil || *result.Page != want {
+		t.Errorf("Expected page metadata counting only matching applications %+v, got %+v", want, result.Page)
+	}
+}
+
+func TestGetApplications_ModifiedBeforeKeepsResultsForPaging(t *testing.T) {
+	calls := 0
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			calls++
+			return []byte(modifiedApplicationsResponse), nil
+		},
+	}
+	service := NewService(client)
+
+	opts := &GetApplicationsOptions{ModifiedBefore: "2025-01-15", Size: 1}
+	for page := 0; page < 2; page++ {
+		opts.Page = page
+		if _, err := service.GetApplications(opts); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("Expected the second page to be served from the kept results, got %d API calls", calls)
+	}
+
+	opts.Name = "shop"
+	if _, err := service.GetApplications(opts); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("Expected a changed filter to fetch again, got %d API calls", calls)
+	}
+
+	service.ClearApplicationCache()
+	if _, err := service.GetApplications(opts); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("Expected clearing the cache to fetch again, got %d API calls", calls)
+	}
+}
@@ -0,0 +1,79 @@
+package applications
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestPagingParams(t *testing.T) {
+	tests := []struct {
+		name     string
+		page     int
+		size     int
+		wantPage string
+		wantSize string
+		wantErr  bool
+	}{
+		{"unset", 0, 0, "", "", false},
+		{"first page omitted", 0, 100, "", "100", false},
+		{"later page", 3, 100, "3", "100", false},
+		{"max size", 0, MaxPageSize, "", "500", false},
+		{"oversized size capped", 0, MaxPageSize + 1, "", "500", false},
+		{"negative size omitted", 0, -10, "", "", false},
+		{"negative page", -1, 100, "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params, err := pagingParams(tt.page, tt.size)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Expected an error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if params.Get("page") != tt.wantPage || params.Get("size") != tt.wantSize {
+				t.Errorf("Expected page %q size %q, got %q", tt.wantPage, tt.wantSize, params.Encode())
+			}
+		})
+	}
+}
+
+func TestGetApplications_NegativePage(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			t.Error("Expected no request for a negative page")
+			return []byte("{}"), nil
+		},
+	}
+
+	if _, err := NewService(client).GetApplications(&GetApplicationsOptions{Page: -1}); err == nil {
+		t.Fatal("Expected an error for a negative page, got nil")
+	}
+}
+
+func TestGetApplications_OversizedPageSizeCapped(t *testing.T) {
+	var got url.Values
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			got = params
+			return []byte("{}"), nil
+		},
+	}
+
+	if _, err := NewService(client).GetApplications(&GetApplicationsOptions{Size: 1000, Name: "shop"}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if got.Get("size") != "500" || got.Get("name") != "shop" {
+		t.Errorf("Expected size capped at 500 alongside the filters, got %q", got.Encode())
+	}
+}
+
+func TestGetSandboxes_NegativePage(t *testing.T) {
+	if _, err := NewService(&MockHTTPClient{}).GetSandboxes("app-guid", &GetSandboxesOptions{Page: -2}); err == nil {
+		t.Fatal("Expected an error for a negative page, got nil")
+	}
+}
@@ -0,0 +1,43 @@
+package applications
+
+import (
+	"context"
+	"sync"
+)
+
+// PrefetchApplications loads the given applications into the detail cache using at most workers
+// concurrent requests, so a later GetApplicationCached returns immediately. It blocks until every
+// application has been fetched or ctx is cancelled. Errors are ignored; a failed application is
+// simply fetched again on demand.
+func (s *Service) PrefetchApplications(ctx context.Context, applicationGUIDs []string, workers int) {
+	if workers < 1 {
+		workers = 1
+	}
+
+	guids := make(chan string)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for guid := range guids {
+				if ctx.Err() != nil {
+					continue
+				}
+				_, _ = s.GetApplicationCached(guid)
+			}
+		}()
+	}
+
+	for _, guid := range applicationGUIDs {
+		select {
+		case guids <- guid:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	close(guids)
+	wg.Wait()
+}
@@ -0,0 +1,73 @@
+package applications
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestPrefetchApplications_BoundsConcurrency(t *testing.T) {
+	var mu sync.Mutex
+	active, maxActive, calls := 0, 0, 0
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			mu.Lock()
+			active++
+			calls++
+			if active > maxActive {
+				maxActive = active
+			}
+			mu.Unlock()
+
+			time.Sleep(5 * time.Millisecond)
+
+			mu.Lock()
+			active--
+			mu.Unlock()
+			guid := urlPath[strings.LastIndex(urlPath, "/")+1:]
+			return []byte(fmt.Sprintf(`{"guid":%q}`, guid)), nil
+		},
+	}
+	service := NewService(client)
+
+	guids := []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"}
+	service.PrefetchApplications(context.Background(), guids, 3)
+
+	if calls != len(guids) {
+		t.Errorf("Expected %d requests, got %d", len(guids), calls)
+	}
+	if maxActive > 3 {
+		t.Errorf("Expected at most 3 concurrent requests, got %d", maxActive)
+	}
+
+	// Prefetched applications are served from the cache
+	if _, err := service.GetApplicationCached("e"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if calls != len(guids) {
+		t.Errorf("Expected cached application to avoid a request, got %d requests", calls)
+	}
+}
+
+func TestPrefetchApplications_StopsWhenCancelled(t *testing.T) {
+	calls := 0
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			calls++
+			return []byte(`{}`), nil
+		},
+	}
+	service := NewService(client)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	service.PrefetchApplications(ctx, []string{"a", "b", "c"}, 1)
+
+	if calls != 0 {
+		t.Errorf("Expected no requests after cancellation, got %d", calls)
+	}
+}
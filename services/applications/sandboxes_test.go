@@ -0,0 +1,85 @@
+package applications
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"testing"
+)
+
+func TestGetAllSandboxes_FollowsPages(t *testing.T) {
+	var pages []string
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			page := params.Get("page")
+			pages = append(pages, page)
+			if page == "" {
+				return []byte(`{"_embedded":{"sandboxes":[{"guid":"sb-1"},{"guid":"sb-2"}]},"page":{"number":0,"total_pages":2}}`), nil
+			}
+			return []byte(`{"_embedded":{"sandboxes":[{"guid":"sb-3"}]},"page":{"number":1,"total_pages":2}}`), nil
+		},
+	}
+	service := NewService(client)
+
+	sandboxes, err := service.GetAllSandboxes("app-guid", nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(sandboxes) != 3 || sandboxes[2].GUID != "sb-3" {
+		t.Errorf("Expected 3 sandboxes across pages, got %+v", sandboxes)
+	}
+	if fmt.Sprint(pages) != "[ 1]" {
+		t.Errorf("Expected pages 0 and 1 to be requested, got %q", pages)
+	}
+}
+
+func TestGetAllSandboxes_NoSandboxes(t *testing.T) {
+	service := NewService(&MockHTTPClient{})
+
+	sandboxes, err := service.GetAllSandboxes("app-guid", nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if sandboxes == nil || len(sandboxes) != 0 {
+		t.Errorf("Expected an empty list, got %+v", sandboxes)
+	}
+}
+
+func TestGetAllSandboxes_Error(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			if params.Get("page") == "1" {
+				return nil, errors.New("boom")
+			}
+			return []byte(`{"_embedded":{"sandboxes":[{"guid":"sb-1"}]},"page":{"total_pages":2}}`), nil
+		},
+	}
+	service := NewService(client)
+
+	if _, err := service.GetAllSandboxes("app-guid", nil); err == nil {
+		t.Fatal("Expected an error, got nil")
+	}
+}
+
+func TestGetAllSandboxes_ReportsProgress(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			if params.Get("page") == "" {
+				return []byte(`{"_embedded":{"sandboxes":[{"guid":"sb-1"},{"guid":"sb-2"}]},"page":{"total_elements":3,"total_pages":2}}`), nil
+			}
+			return []byte(`{"_embedded":{"sandboxes":[{"guid":"sb-3"}]},"page":{"number":1,"total_elements":3,"total_pages":2}}`), nil
+		},
+	}
+	service := NewService(client)
+
+	var calls []string
+	_, err := service.GetAllSandboxes("app-guid", func(fetched, total int) {
+		calls = append(calls, fmt.Sprintf("%d/%d", fetched, total))
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if fmt.Sprint(calls) != "[2/3 3/3]" {
+		t.Errorf("Expected one progress call per page, got %v", calls)
+	}
+}
@@ -0,0 +1,89 @@
+package applications
+
+import "time"
+
+// ScanStatusSummary maps each scan type of the application to its latest status, formatted as
+// "STATUS 2006-01-02T15:04:05Z07:00" (or just the status when the scan has no date). The embedded
+// Scans are used when present; otherwise the scans are fetched from the /scans endpoint. A failed
+// fetch yields an empty summary.
+func (s *Service) ScanStatusSummary(app *Application) map[string]string {
+	if app == nil {
+		return map[string]string{}
+	}
+
+	scans := app.Scans
+	if len(scans) == 0 && app.GUID != "" {
+		if result, err := s.GetScans(app.GUID, nil); err == nil && result.Embedded != nil {
+			scans = result.Embedded.Scans
+		}
+	}
+
+	summary := make(map[string]string)
+	for scanType, scan := range LatestScans(scans) {
+		status := ScanStatus(&scan)
+		if date := scanDate(&scan); date != nil {
+			status += " " + date.Format(time.RFC3339)
+		}
+		summary[scanType] = status
+	}
+	return summary
+}
+
+// LatestScans returns the most recently modified scan of each scan type, ignoring deleted scans
+func LatestScans(scans []ApplicationScan) map[string]ApplicationScan {
+	latest := make(map[string]ApplicationScan)
+	for _, scan := range scans {
+		if scan.Deleted || scan.ScanType == "" {
+			continue
+		}
+		current, ok := latest[scan.ScanType]
+		if !ok || isLater(scanDate(&scan), scanDate(&current)) {
+			latest[scan.ScanType] = scan
+		}
+	}
+	return latest
+}
+
+// ScanStatus returns the scan's status, falling back to the display_status returned by the /scans endpoint
+func ScanStatus(scan *ApplicationScan) string {
+	if scan.Status != "" {
+		return scan.Status
+	}
+	for _, key := range []string{"status", "name"} {
+		if value, ok := scan.DisplayStatus[key].(string); ok && value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// terminalScanStatuses are the scan statuses after which a scan will not progress further
+var terminalScanStatuses = map[string]bool{
+	"PUBLISHED":       true,
+	"UNPUBLISHED":     true,
+	"ANALYSIS_ERRORS": true,
+	"SCAN_CANCELED":   true,
+	"DELETED":         true,
+}
+
+// IsTerminalScanStatus reports whether a scan with the given status has finished, successfully or not.
+// Unknown statuses are treated as still in progress.
+func IsTerminalScanStatus(status string) bool {
+	return terminalScanStatuses[status]
+}
+
+// scanDate returns when the scan last changed, preferring the modified date over the published date
+func scanDate(scan *ApplicationScan) *time.Time {
+	if scan.ModifiedDate != nil {
+		return scan.ModifiedDate
+	}
+	return scan.PublishedDate
+}
+
+// isLater reports whether a is after b, treating a missing date as earliest
+func isLater(a, b *time.Time) bool {
+	if a == nil {
+		return false
+	}
+	return b == nil || a.After(*b)
+}
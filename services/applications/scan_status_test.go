@@ -0,0 +1,86 @@
+package applications
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+)
+
+func TestScanStatusSummary_LatestPerType(t *testing.T) {
+	var app Application
+	err := json.Unmarshal([]byte(`{
+		"guid": "app-guid",
+		"scans": [
+			{"scan_type": "STATIC", "status": "PUBLISHED", "modified_date": "2025-01-10T08:00:00Z"},
+			{"scan_type": "STATIC", "status": "SCAN_IN_PROGRESS", "modified_date": "2025-02-01T12:00:00Z"},
+			{"scan_type": "STATIC", "status": "INCOMPLETE", "modified_date": "2024-12-01T12:00:00Z"},
+			{"scan_type": "DYNAMIC", "status": "PUBLISHED", "modified_date": "2025-01-05T09:30:00Z"},
+			{"scan_type": "SCA", "status": "PUBLISHED"}
+		]
+	}`), &app)
+	if err != nil {
+		t.Fatalf("Failed to decode application: %v", err)
+	}
+
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			t.Errorf("Expected embedded scans to be used, got request to %s", urlPath)
+			return []byte(`{}`), nil
+		},
+	}
+	summary := NewService(client).ScanStatusSummary(&app)
+
+	expected := map[string]string{
+		"STATIC":  "SCAN_IN_PROGRESS 2025-02-01T12:00:00Z",
+		"DYNAMIC": "PUBLISHED 2025-01-05T09:30:00Z",
+		"SCA":     "PUBLISHED",
+	}
+	if len(summary) != len(expected) {
+		t.Fatalf("Expected %d scan types, got %v", len(expected), summary)
+	}
+	for scanType, status := range expected {
+		if summary[scanType] != status {
+			t.Errorf("Expected %s status %q, got %q", scanType, status, summary[scanType])
+		}
+	}
+}
+
+func TestScanStatusSummary_FetchesScansWithDisplayStatus(t *testing.T) {
+	var gotPath string
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			gotPath = urlPath
+			return []byte(`{
+				"_embedded": {
+					"scans": [
+						{"scan_type": "STATIC", "display_status": {"status": "RESULTS_READY"}, "published_date": "2025-03-01T10:00:00Z"},
+						{"scan_type": "STATIC", "display_status": {"status": "PUBLISHED"}, "published_date": "2025-02-01T10:00:00Z"},
+						{"scan_type": "STATIC", "status": "PUBLISHED", "published_date": "2025-04-01T10:00:00Z", "deleted": true}
+					]
+				}
+			}`), nil
+		},
+	}
+
+	summary := NewService(client).ScanStatusSummary(&Application{GUID: "app-guid"})
+
+	if gotPath != "/appsec/v1/applications/app-guid/scans" {
+		t.Errorf("Expected scans to be fetched, got path %q", gotPath)
+	}
+	if summary["STATIC"] != "RESULTS_READY 2025-03-01T10:00:00Z" {
+		t.Errorf("Expected latest non-deleted static scan, got %q", summary["STATIC"])
+	}
+}
+
+func TestIsTerminalScanStatus(t *testing.T) {
+	for _, status := range []string{"PUBLISHED", "ANALYSIS_ERRORS", "SCAN_CANCELED"} {
+		if !IsTerminalScanStatus(status) {
+			t.Errorf("Expected %s to be terminal", status)
+		}
+	}
+	for _, status := range []string{"IN_PROGRESS", "SCAN_IN_PROGRESS", "IN_QUEUE", "SCAN_SUBMITTED", ""} {
+		if IsTerminalScanStatus(status) {
+			t.Errorf("Expected %q to be in progress", status)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package applications
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestGetScans_BuildsURLAndDecodes(t *testing.T) {
+	var gotPath string
+	var gotParams url.Values
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			gotPath = urlPath
+			gotParams = params
+			return []byte(`{
+				"_embedded": {
+					"scans": [
+						{
+							"guid": "scan-guid-1",
+							"scan_type": "STATIC",
+							"status": "PUBLISHED",
+							"analysis_id": 12345,
+							"published_date": "2024-05-01T10:30:00Z"
+						}
+					]
+				},
+				"page": {"number": 1, "size": 50, "total_elements": 51, "total_pages": 2}
+			}`), nil
+		},
+	}
+	service := NewService(client)
+
+	result, err := service.GetScans("app-guid", &GetScansOptions{Page: 1, Size: 50})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if gotPath != "/appsec/v1/applications/app-guid/scans" {
+		t.Errorf("Unexpected URL path: %s", gotPath)
+	}
+	if gotParams.Get("page") != "1" || gotParams.Get("size") != "50" {
+		t.Errorf("Unexpected paging params: %v", gotParams)
+	}
+
+	if result.Embedded == nil || len(result.Embedded.Scans) != 1 {
+		t.Fatalf("Expected 1 scan, got %+v", result.Embedded)
+	}
+	scan := result.Embedded.Scans[0]
+	if scan.GUID != "scan-guid-1" || scan.AnalysisID != 12345 {
+		t.Errorf("Unexpected scan identifiers: %+v", scan)
+	}
+	if scan.PublishedDate == nil || scan.PublishedDate.Format("2006-01-02") != "2024-05-01" {
+		t.Errorf("Expected published date 2024-05-01, got %v", scan.PublishedDate)
+	}
+	if result.Page == nil || result.Page.TotalPages != 2 {
+		t.Errorf("Expected page metadata with 2 pages, got %+v", result.Page)
+	}
+}
+
+func TestGetScans_RequiresApplicationGUID(t *testing.T) {
+	service := NewService(&MockHTTPClient{})
+
+	if _, err := service.GetScans("", nil); err == nil {
+		t.Error("Expected error for empty applicationGUID")
+	}
+}
@@ -1,10 +1,14 @@
 package applications
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/url"
+	"slices"
 	"strconv"
+	"sync"
+	"time"
+
+	"github.com/dipsylala/veracode-tui/veracode"
 )
 
 const (
@@ -14,6 +18,14 @@ const (
 // Service provides methods to interact with the Veracode Applications API
 type Service struct {
 	client HTTPClient
+
+	appCacheMu sync.RWMutex
+	appCache   map[string]*Application
+
+	// Every application matching the last ModifiedBefore query, so paging through it does not
+	// fetch them all again. Guarded by appCacheMu.
+	modifiedBeforeKey  string
+	modifiedBeforeApps []Application
 }
 
 // HTTPClient interface for making HTTP requests
@@ -23,7 +35,8 @@ type HTTPClient interface {
 
 func NewService(client HTTPClient) *Service {
 	return &Service{
-		client: client,
+		client:   client,
+		appCache: make(map[string]*Application),
 	}
 }
 
@@ -34,6 +47,7 @@ type GetApplicationsOptions struct {
 	CustomFieldValues            []string
 	LegacyID                     int
 	ModifiedAfter                string // Format: yyyy-MM-dd
+	ModifiedBefore               string // Format: yyyy-MM-dd, inclusive. Applied by the service, as the API has no equivalent parameter
 	Name                         string
 	Page                         int
 	Policy                       string
@@ -41,38 +55,129 @@ type GetApplicationsOptions struct {
 	PolicyComplianceCheckedAfter string // Format: yyyy-MM-dd
 	PolicyGUID                   string
 	ScanStatus                   []string
-	ScanType                     string
+	ScanType                     string // Deprecated: use ScanTypes. Still sent when set, alongside ScanTypes
+	ScanTypes                    []string
 	Size                         int
 	SortByCustomFieldName        string
 	Tag                          string
 	Team                         string
 }
 
-// GetApplications retrieves a list of applications with optional filtering
+// GetApplications retrieves a list of applications with optional filtering. With ModifiedBefore set,
+// every matching application is fetched so the requested page and its totals count only the
+// applications that pass the filter.
 func (s *Service) GetApplications(opts *GetApplicationsOptions) (*PagedResourceOfApplication, error) {
-	params := buildApplicationQueryParams(opts)
+	if opts != nil && opts.ModifiedBefore != "" {
+		return s.getApplicationsModifiedBefore(opts)
+	}
+	return s.getApplicationsPage(opts)
+}
+
+// getApplicationsPage retrieves one page of applications from the API, dropping those modified
+// after opts.ModifiedBefore. The page metadata is the API's, from before that filter.
+func (s *Service) getApplicationsPage(opts *GetApplicationsOptions) (*PagedResourceOfApplication, error) {
+	params, err := buildApplicationQueryParams(opts)
+	if err != nil {
+		return nil, err
+	}
 
 	body, err := s.client.DoRequestWithQueryParams("GET", applicationsBasePath, params)
 	if err != nil {
 		return nil, err
 	}
 
-	var result PagedResourceOfApplication
-	if err := json.Unmarshal(body, &result); err != nil {
-		return nil, fmt.Errorf("failed to parse applications response: %w", err)
+	result, err := s.decodeApplicationsPage(body)
+	if err != nil {
+		return nil, err
 	}
 
-	return &result, nil
+	if opts != nil && opts.ModifiedBefore != "" && result.Embedded != nil {
+		filtered, err := filterModifiedBefore(result.Embedded.Applications, opts.ModifiedBefore)
+		if err != nil {
+			return nil, err
+		}
+		result.Embedded.Applications = filtered
+	}
+
+	return result, nil
 }
 
-// buildApplicationQueryParams builds URL query parameters from options
+// getApplicationsModifiedBefore fetches every application matching opts, which filters them by
+// ModifiedBefore, and returns the requested page of those with page metadata counting only them.
+// The matching applications are kept until the filters change or ClearApplicationCache is called,
+// so other pages of the same query are served without fetching them again.
+func (s *Service) getApplicationsModifiedBefore(opts *GetApplicationsOptions) (*PagedResourceOfApplication, error) {
+	if _, err := pagingParams(opts.Page, opts.Size); err != nil {
+		return nil, err
+	}
+	size := applicationsPageSize
+	if opts.Size > 0 {
+		size = min(opts.Size, MaxPageSize)
+	}
+
+	allOpts := *opts
+	allOpts.Page = 0
+	allOpts.Size = MaxPageSize
+	key := fmt.Sprintf("%+v", allOpts)
+
+	s.appCacheMu.RLock()
+	apps, ok := s.modifiedBeforeApps, s.modifiedBeforeKey == key
+	s.appCacheMu.RUnlock()
+	if !ok {
+		var err error
+		apps, err = s.GetAllApplications(&allOpts, nil)
+		if err != nil {
+			return nil, err
+		}
+		s.appCacheMu.Lock()
+		s.modifiedBeforeKey, s.modifiedBeforeApps = key, apps
+		s.appCacheMu.Unlock()
+	}
+
+	// The page is copied so callers sorting it do not reorder the kept applications
+	start := min(opts.Page*size, len(apps))
+	end := min(start+size, len(apps))
+	return &PagedResourceOfApplication{
+		Embedded: &EmbeddedApplication{Applications: slices.Clone(apps[start:end])},
+		Page: &PageMetadata{
+			Number:        int64(opts.Page),
+			Size:          int64(size),
+			TotalElements: int64(len(apps)),
+			TotalPages:    int64((len(apps) + size - 1) / size),
+		},
+	}, nil
+}
+
+// filterModifiedBefore keeps the applications last modified on or before the given yyyy-MM-dd date.
+// Applications without a modified date are dropped.
+func filterModifiedBefore(apps []Application, before string) ([]Application, error) {
+	day, err := time.Parse("2006-01-02", before)
+	if err != nil {
+		return nil, fmt.Errorf("invalid modified before date %q: %w", before, err)
+	}
+	end := day.AddDate(0, 0, 1)
+
+	filtered := make([]Application, 0, len(apps))
+	for _, app := range apps {
+		if app.Modified != nil && app.Modified.Before(end) {
+			filtered = append(filtered, app)
+		}
+	}
+	return filtered, nil
+}
+
+// buildApplicationQueryParams builds URL query parameters from options, rejecting a negative page
+// or an unknown policy compliance status and capping the size at MaxPageSize
 //
 //nolint:gocyclo // Parameter building with many optional fields
-func buildApplicationQueryParams(opts *GetApplicationsOptions) url.Values {
-	params := url.Values{}
-
+func buildApplicationQueryParams(opts *GetApplicationsOptions) (url.Values, error) {
 	if opts == nil {
-		return params
+		return url.Values{}, nil
+	}
+
+	params, err := pagingParams(opts.Page, opts.Size)
+	if err != nil {
+		return nil, err
 	}
 
 	if opts.BusinessUnit != "" {
@@ -93,13 +198,13 @@ func buildApplicationQueryParams(opts *GetApplicationsOptions) url.Values {
 	if opts.Name != "" {
 		params.Add("name", opts.Name)
 	}
-	if opts.Page > 0 {
-		params.Add("page", strconv.Itoa(opts.Page))
-	}
 	if opts.Policy != "" {
 		params.Add("policy", opts.Policy)
 	}
 	if opts.PolicyCompliance != "" {
+		if !IsPolicyComplianceStatus(opts.PolicyCompliance) {
+			return nil, fmt.Errorf("unknown policy compliance status %q", opts.PolicyCompliance)
+		}
 		params.Add("policy_compliance", opts.PolicyCompliance)
 	}
 	if opts.PolicyComplianceCheckedAfter != "" {
@@ -111,11 +216,11 @@ func buildApplicationQueryParams(opts *GetApplicationsOptions) url.Values {
 	for _, status := range opts.ScanStatus {
 		params.Add("scan_status", status)
 	}
-	if opts.ScanType != "" {
-		params.Add("scan_type", opts.ScanType)
+	for _, scanType := range opts.ScanTypes {
+		params.Add("scan_type", scanType)
 	}
-	if opts.Size > 0 {
-		params.Add("size", strconv.Itoa(opts.Size))
+	if opts.ScanType != "" && !slices.Contains(opts.ScanTypes, opts.ScanType) {
+		params.Add("scan_type", opts.ScanType)
 	}
 	if opts.SortByCustomFieldName != "" {
 		params.Add("sort_by_custom_field_name", opts.SortByCustomFieldName)
@@ -127,7 +232,7 @@ func buildApplicationQueryParams(opts *GetApplicationsOptions) url.Values {
 		params.Add("team", opts.Team)
 	}
 
-	return params
+	return params, nil
 }
 
 // GetApplication retrieves a single application by GUID
@@ -143,13 +248,83 @@ func (s *Service) GetApplication(applicationGUID string) (*Application, error) {
 	}
 
 	var result Application
-	if err := json.Unmarshal(body, &result); err != nil {
+	if err := veracode.DecodeJSON(body, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse application response: %w", err)
 	}
 
 	return &result, nil
 }
 
+// GetApplicationCached retrieves a single application by GUID, returning a copy of a previously
+// fetched one when available. Callers may modify the result without affecting the cache. Failed
+// lookups are never cached.
+func (s *Service) GetApplicationCached(applicationGUID string) (*Application, error) {
+	s.appCacheMu.RLock()
+	cached, ok := s.appCache[applicationGUID]
+	s.appCacheMu.RUnlock()
+	if ok {
+		return cloneApplication(cached), nil
+	}
+
+	result, err := s.GetApplication(applicationGUID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.appCacheMu.Lock()
+	s.appCache[applicationGUID] = cloneApplication(result)
+	s.appCacheMu.Unlock()
+
+	return result, nil
+}
+
+// cacheEvicter is implemented by clients that cache responses, such as *veracode.Client
+type cacheEvicter interface {
+	EvictCached(urlPath string)
+}
+
+// InvalidateApplication removes a single application from the detail cache, and its cached
+// response from the client when the client caches, so the next GetApplication or
+// GetApplicationCached fetches it again
+func (s *Service) InvalidateApplication(applicationGUID string) {
+	s.appCacheMu.Lock()
+	delete(s.appCache, applicationGUID)
+	s.appCacheMu.Unlock()
+
+	if evicter, ok := s.client.(cacheEvicter); ok {
+		evicter.EvictCached(fmt.Sprintf("%s/%s", applicationsBasePath, applicationGUID))
+	}
+}
+
+// ClearApplicationCache removes all applications from the detail cache, and the applications kept
+// for the last ModifiedBefore query
+func (s *Service) ClearApplicationCache() {
+	s.appCacheMu.Lock()
+	defer s.appCacheMu.Unlock()
+	s.appCache = make(map[string]*Application)
+	s.modifiedBeforeKey, s.modifiedBeforeApps = "", nil
+}
+
+// MaxPageSize is the largest page size the Applications API accepts. Larger sizes are capped.
+const MaxPageSize = 500
+
+// pagingParams builds the page and size query parameters, omitting unset values. A negative page
+// is an error, and a size above MaxPageSize is capped rather than sent for the API to reject.
+func pagingParams(page, size int) (url.Values, error) {
+	if page < 0 {
+		return nil, fmt.Errorf("page must not be negative, got %d", page)
+	}
+
+	params := url.Values{}
+	if page > 0 {
+		params.Add("page", strconv.Itoa(page))
+	}
+	if size > 0 {
+		params.Add("size", strconv.Itoa(min(size, MaxPageSize)))
+	}
+	return params, nil
+}
+
 // GetSandboxesOptions contains optional parameters for GetSandboxes
 type GetSandboxesOptions struct {
 	Page int
@@ -164,11 +339,9 @@ func (s *Service) GetSandboxes(applicationGUID string, opts *GetSandboxesOptions
 
 	params := url.Values{}
 	if opts != nil {
-		if opts.Page > 0 {
-			params.Add("page", strconv.Itoa(opts.Page))
-		}
-		if opts.Size > 0 {
-			params.Add("size", strconv.Itoa(opts.Size))
+		var err error
+		if params, err = pagingParams(opts.Page, opts.Size); err != nil {
+			return nil, err
 		}
 	}
 
@@ -179,13 +352,126 @@ func (s *Service) GetSandboxes(applicationGUID string, opts *GetSandboxesOptions
 	}
 
 	var result PagedResourceOfSandbox
-	if err := json.Unmarshal(body, &result); err != nil {
+	if err := veracode.DecodeJSON(body, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse sandboxes response: %w", err)
 	}
 
 	return &result, nil
 }
 
+// sandboxesPageSize is the page size used by GetAllSandboxes
+const sandboxesPageSize = 100
+
+// ProgressFunc is called by the GetAll methods after each page with the number of items fetched so
+// far and the total reported by the API. It runs on the goroutine doing the fetching.
+type ProgressFunc func(fetched, total int)
+
+// report calls the progress callback, if any, taking the total from the page metadata and falling
+// back to fetched when the API does not report one
+func (p ProgressFunc) report(fetched int, page *PageMetadata) {
+	if p == nil {
+		return
+	}
+	total := fetched
+	if page != nil && int(page.TotalElements) > total {
+		total = int(page.TotalElements)
+	}
+	p(fetched, total)
+}
+
+// GetAllSandboxes retrieves every sandbox of an application, requesting further pages until the
+// last one. An error on any page discards the sandboxes loaded so far. progress may be nil.
+func (s *Service) GetAllSandboxes(applicationGUID string, progress ProgressFunc) ([]Sandbox, error) {
+	sandboxes := []Sandbox{}
+	for page := 0; ; page++ {
+		result, err := s.GetSandboxes(applicationGUID, &GetSandboxesOptions{Page: page, Size: sandboxesPageSize})
+		if err != nil {
+			return nil, err
+		}
+		if result.Embedded == nil || len(result.Embedded.Sandboxes) == 0 {
+			return sandboxes, nil
+		}
+		sandboxes = append(sandboxes, result.Embedded.Sandboxes...)
+		progress.report(len(sandboxes), result.Page)
+		if result.Page == nil || int64(page+1) >= result.Page.TotalPages {
+			return sandboxes, nil
+		}
+	}
+}
+
+// applicationsPageSize is the page size used by GetAllApplications when opts does not set one
+const applicationsPageSize = 100
+
+// maxApplicationPages caps GetAllApplications so a server that keeps reporting further pages
+// cannot loop forever
+const maxApplicationPages = 1000
+
+// GetAllApplications retrieves every page of applications matching opts, ignoring opts.Page. An
+// error on any page discards the applications loaded so far, as does passing maxApplicationPages.
+// progress may be nil.
+func (s *Service) GetAllApplications(opts *GetApplicationsOptions, progress ProgressFunc) ([]Application, error) {
+	pageOpts := GetApplicationsOptions{}
+	if opts != nil {
+		pageOpts = *opts
+	}
+	if pageOpts.Size <= 0 {
+		pageOpts.Size = applicationsPageSize
+	}
+
+	apps := []Application{}
+	for page := 0; page < maxApplicationPages; page++ {
+		pageOpts.Page = page
+		result, err := s.getApplicationsPage(&pageOpts)
+		if err != nil {
+			return nil, err
+		}
+		// A page can be empty after the ModifiedBefore filter, so only the page metadata ends the loop
+		if result.Embedded != nil {
+			apps = append(apps, result.Embedded.Applications...)
+		}
+		progress.report(len(apps), result.Page)
+		if result.Page == nil || int64(page+1) >= result.Page.TotalPages {
+			return apps, nil
+		}
+	}
+	return nil, fmt.Errorf("stopped after %d pages of applications; narrow the filters and try again", maxApplicationPages)
+}
+
+// GetScansOptions contains optional parameters for GetScans
+type GetScansOptions struct {
+	Page int
+	Size int
+}
+
+// GetScans retrieves the scans for a specific application from the dedicated scans endpoint,
+// which includes fields such as the scan GUID and published date
+func (s *Service) GetScans(applicationGUID string, opts *GetScansOptions) (*PagedResourceOfScan, error) {
+	if applicationGUID == "" {
+		return nil, fmt.Errorf("applicationGUID is required")
+	}
+
+	params := url.Values{}
+	if opts != nil {
+		var err error
+		if params, err = pagingParams(opts.Page, opts.Size); err != nil {
+			return nil, err
+		}
+	}
+
+	urlPath := fmt.Sprintf("%s/%s/scans", applicationsBasePath, applicationGUID)
+	body, err := s.client.DoRequestWithQueryParams("GET", urlPath, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var result PagedResourceOfScan
+	if err := veracode.DecodeJSON(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse scans response: %w", err)
+	}
+
+	return &result, nil
+}
+
 // GetSandbox retrieves a single sandbox by application GUID and sandbox GUID
 func (s *Service) GetSandbox(applicationGUID, sandboxGUID string) (*Sandbox, error) {
 	if applicationGUID == "" {
@@ -202,7 +488,7 @@ func (s *Service) GetSandbox(applicationGUID, sandboxGUID string) (*Sandbox, err
 	}
 
 	var result Sandbox
-	if err := json.Unmarshal(body, &result); err != nil {
+	if err := veracode.DecodeJSON(body, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse sandbox response: %w", err)
 	}
 
@@ -0,0 +1,78 @@
+package findings
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"testing"
+)
+
+func TestGetAllFindings_FollowsPagesAndReportsProgress(t *testing.T) {
+	var requests []url.Values
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			requests = append(requests, params)
+			switch params.Get("page") {
+			case "":
+				return []byte(`{"_embedded":{"findings":[{"issue_id":1},{"issue_id":2}]},"page":{"total_elements":5,"total_pages":3}}`), nil
+			case "1":
+				return []byte(`{"_embedded":{"findings":[{"issue_id":3},{"issue_id":4}]},"page":{"number":1,"total_elements":5,"total_pages":3}}`), nil
+			default:
+				return []byte(`{"_embedded":{"findings":[{"issue_id":5}]},"page":{"number":2,"total_elements":5,"total_pages":3}}`), nil
+			}
+		},
+	}
+	service := NewService(client)
+
+	var calls []string
+	all, err := service.GetAllFindings("app-guid", &GetFindingsOptions{ScanType: []string{"STATIC"}, Page: 7}, func(fetched, total int) {
+		calls = append(calls, fmt.Sprintf("%d/%d", fetched, total))
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(all) != 5 || all[4].IssueID != 5 {
+		t.Errorf("Expected 5 findings across pages, got %+v", all)
+	}
+	if fmt.Sprint(calls) != "[2/5 4/5 5/5]" {
+		t.Errorf("Expected one progress call per page with increasing counts, got %v", calls)
+	}
+	for _, params := range requests {
+		if params.Get("scan_type") != "STATIC" || params.Get("size") != "500" {
+			t.Errorf("Expected the options and default page size on every request, got %v", params)
+		}
+	}
+}
+
+func TestGetAllFindings_NilProgress(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			return []byte(`{"_embedded":{"findings":[{"issue_id":1}]},"page":{"total_pages":1}}`), nil
+		},
+	}
+	service := NewService(client)
+
+	all, err := service.GetAllFindings("app-guid", nil, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(all) != 1 {
+		t.Errorf("Expected 1 finding, got %d", len(all))
+	}
+}
+
+func TestGetAllFindings_Error(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			if params.Get("page") == "1" {
+				return nil, errors.New("boom")
+			}
+			return []byte(`{"_embedded":{"findings":[{"issue_id":1}]},"page":{"total_pages":2}}`), nil
+		},
+	}
+	service := NewService(client)
+
+	if _, err := service.GetAllFindings("app-guid", nil, nil); err == nil {
+		t.Fatal("Expected an error, got nil")
+	}
+}
@@ -1,5 +1,7 @@
 package findings
 
+import "strings"
+
 // ScanType represents the type of security scan
 type ScanType string
 
@@ -55,6 +57,20 @@ const (
 	ScanFilterSCA     ScanFilterType = "SCA"
 )
 
+// ScanFilterTypes lists the scan filter types in the order the UI offers them
+var ScanFilterTypes = []ScanFilterType{ScanFilterStatic, ScanFilterDynamic, ScanFilterSCA}
+
+// ParseScanFilterType returns the scan filter type named by value, ignoring case,
+// and false if value is not a known scan type
+func ParseScanFilterType(value string) (ScanFilterType, bool) {
+	for _, scanType := range ScanFilterTypes {
+		if strings.EqualFold(value, string(scanType)) {
+			return scanType, true
+		}
+	}
+	return "", false
+}
+
 // PolicyFilterType represents the policy filter for UI
 type PolicyFilterType string
 
@@ -65,4 +81,61 @@ const (
 	PolicyFilterNonViolations PolicyFilterType = "Non-Violations"
 )
 
+// PolicyFilterTypes lists the policy filters in the order the UI offers them
+var PolicyFilterTypes = []PolicyFilterType{PolicyFilterAll, PolicyFilterViolations, PolicyFilterNonViolations}
+
+// ParsePolicyFilterType returns the policy filter named by value, ignoring case,
+// and false if value is not a known policy filter
+func ParsePolicyFilterType(value string) (PolicyFilterType, bool) {
+	for _, filter := range PolicyFilterTypes {
+		if strings.EqualFold(value, string(filter)) {
+			return filter, true
+		}
+	}
+	return "", false
+}
+
+// ToViolatesPolicy converts the filter to the GetFindingsOptions.ViolatesPolicy value:
+// nil (no filter) for All, true for Violations and false for Non-Violations
+func (p PolicyFilterType) ToViolatesPolicy() *bool {
+	var violates bool
+	switch p {
+	case PolicyFilterViolations:
+		violates = true
+	case PolicyFilterNonViolations:
+		violates = false
+	default:
+		return nil
+	}
+	return &violates
+}
+
 // Severity levels
+const (
+	SeverityInformational = 0
+	SeverityVeryLow       = 1
+	SeverityLow           = 2
+	SeverityMedium        = 3
+	SeverityHigh          = 4
+	SeverityVeryHigh      = 5
+)
+
+// SeverityName returns the display name for a numeric severity level
+func SeverityName(severity int) string {
+	switch severity {
+	case SeverityVeryHigh:
+		return "Very High"
+	case SeverityHigh:
+		return "High"
+	case SeverityMedium:
+		return "Medium"
+	case SeverityLow:
+		return "Low"
+	case SeverityVeryLow:
+		return "Very Low"
+	case SeverityInformational:
+		return "Informational"
+	default:
+		return "Unknown"
+	}
+}
@@ -0,0 +1,73 @@
+package findings
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestPolicyFilterType_ToViolatesPolicy(t *testing.T) {
+	if got := PolicyFilterAll.ToViolatesPolicy(); got != nil {
+		t.Errorf("Expected nil for All, got %v", *got)
+	}
+	if got := PolicyFilterViolations.ToViolatesPolicy(); got == nil || !*got {
+		t.Errorf("Expected true for Violations, got %v", got)
+	}
+	if got := PolicyFilterNonViolations.ToViolatesPolicy(); got == nil || *got {
+		t.Errorf("Expected false for Non-Violations, got %v", got)
+	}
+}
+
+func TestGetFindings_ViolatesPolicyParam(t *testing.T) {
+	tests := []struct {
+		filter   PolicyFilterType
+		expected []string
+	}{
+		{PolicyFilterAll, nil},
+		{PolicyFilterViolations, []string{"true"}},
+		{PolicyFilterNonViolations, []string{"false"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.filter), func(t *testing.T) {
+			var got []string
+			client := &MockHTTPClient{
+				DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+					got = params["violates_policy"]
+					return []byte(`{}`), nil
+				},
+			}
+			service := NewService(client)
+
+			if _, err := service.GetFindings("app-guid", &GetFindingsOptions{ViolatesPolicy: tt.filter.ToViolatesPolicy()}); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if len(got) != len(tt.expected) || (len(got) == 1 && got[0] != tt.expected[0]) {
+				t.Errorf("Expected violates_policy %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestParseScanFilterType(t *testing.T) {
+	if got, ok := ParseScanFilterType("dynamic"); !ok || got != ScanFilterDynamic {
+		t.Errorf("Expected DYNAMIC, got %q %v", got, ok)
+	}
+	if got, ok := ParseScanFilterType("SCA"); !ok || got != ScanFilterSCA {
+		t.Errorf("Expected SCA, got %q %v", got, ok)
+	}
+	if _, ok := ParseScanFilterType("MANUAL"); ok {
+		t.Error("Expected MANUAL to be rejected")
+	}
+}
+
+func TestParsePolicyFilterType(t *testing.T) {
+	if got, ok := ParsePolicyFilterType("violations"); !ok || got != PolicyFilterViolations {
+		t.Errorf("Expected Violations, got %q %v", got, ok)
+	}
+	if got, ok := ParsePolicyFilterType("Non-Violations"); !ok || got != PolicyFilterNonViolations {
+		t.Errorf("Expected Non-Violations, got %q %v", got, ok)
+	}
+	if _, ok := ParsePolicyFilterType(""); ok {
+		t.Error("Expected an empty value to be rejected")
+	}
+}
@@ -0,0 +1,67 @@
+package findings
+
+import (
+	"context"
+	"sync"
+)
+
+// CountFindings returns the total number of findings in a context of an application, where
+// contextGUID is empty for the policy context. Only a single finding is requested; the count is
+// read from the page metadata.
+func (s *Service) CountFindings(applicationGUID, contextGUID string) (int64, error) {
+	result, err := s.GetFindings(applicationGUID, &GetFindingsOptions{
+		Context: contextGUID,
+		Size:    1,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if result.Page == nil {
+		if result.Embedded != nil {
+			return int64(len(result.Embedded.Findings)), nil
+		}
+		return 0, nil
+	}
+	return result.Page.TotalElements, nil
+}
+
+// CountFindingsByContext counts the findings in each of contextGUIDs using at most workers concurrent
+// requests, calling done with each result as it arrives. done runs on the worker goroutines and is not
+// called for contexts skipped after ctx is cancelled. It returns once every started request has finished.
+func (s *Service) CountFindingsByContext(ctx context.Context, applicationGUID string, contextGUIDs []string, workers int,
+	done func(contextGUID string, count int64, err error)) {
+	if workers < 1 {
+		workers = 1
+	}
+
+	guids := make(chan string)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for guid := range guids {
+				if ctx.Err() != nil {
+					continue
+				}
+				count, err := s.CountFindings(applicationGUID, guid)
+				if ctx.Err() != nil {
+					continue
+				}
+				done(guid, count, err)
+			}
+		}()
+	}
+
+	for _, guid := range contextGUIDs {
+		select {
+		case guids <- guid:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	close(guids)
+	wg.Wait()
+}
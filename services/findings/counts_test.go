@@ -0,0 +1,125 @@
+package findings
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCountFindings_ReadsTotalFromPageMetadata(t *testing.T) {
+	var requested url.Values
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			requested = params
+			return []byte(`{"_embedded":{"findings":[{"issue_id":1}]},"page":{"total_elements":42,"total_pages":42}}`), nil
+		},
+	}
+	service := NewService(client)
+
+	count, err := service.CountFindings("app-guid", "sandbox-guid")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if count != 42 {
+		t.Errorf("Expected count 42, got %d", count)
+	}
+	if requested.Get("size") != "1" || requested.Get("context") != "sandbox-guid" {
+		t.Errorf("Expected a size=1 request for the sandbox context, got %v", requested)
+	}
+}
+
+func TestCountFindings_PolicyContextOmitsContextParam(t *testing.T) {
+	var requested url.Values
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			requested = params
+			return []byte(`{}`), nil
+		},
+	}
+	service := NewService(client)
+
+	count, err := service.CountFindings("app-guid", "")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if count != 0 {
+		t.Errorf("Expected count 0 without page metadata, got %d", count)
+	}
+	if requested.Has("context") {
+		t.Errorf("Expected no context parameter for the policy context, got %v", requested)
+	}
+}
+
+func TestCountFindingsByContext_BoundsConcurrencyAndReportsEachContext(t *testing.T) {
+	var mu sync.Mutex
+	active, maxActive := 0, 0
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			mu.Lock()
+			active++
+			if active > maxActive {
+				maxActive = active
+			}
+			mu.Unlock()
+
+			time.Sleep(5 * time.Millisecond)
+
+			mu.Lock()
+			active--
+			mu.Unlock()
+			if params.Get("context") == "broken" {
+				return nil, errors.New("boom")
+			}
+			return []byte(`{"page":{"total_elements":3}}`), nil
+		},
+	}
+	service := NewService(client)
+
+	results := map[string]int64{}
+	failed := map[string]bool{}
+	contexts := []string{"", "a", "b", "c", "broken", "d", "e"}
+	service.CountFindingsByContext(context.Background(), "app-guid", contexts, 2, func(contextGUID string, count int64, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			failed[contextGUID] = true
+			return
+		}
+		results[contextGUID] = count
+	})
+
+	if len(results) != len(contexts)-1 || results[""] != 3 {
+		t.Errorf("Expected a count for every working context, got %v", results)
+	}
+	if !failed["broken"] {
+		t.Errorf("Expected the failing context to report its error, got %v", failed)
+	}
+	if maxActive > 2 {
+		t.Errorf("Expected at most 2 concurrent requests, got %d", maxActive)
+	}
+}
+
+func TestCountFindingsByContext_StopsWhenCancelled(t *testing.T) {
+	calls := 0
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			calls++
+			return []byte(`{}`), nil
+		},
+	}
+	service := NewService(client)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	reported := 0
+	service.CountFindingsByContext(ctx, "app-guid", []string{"", "a", "b"}, 1, func(string, int64, error) {
+		reported++
+	})
+
+	if calls != 0 || reported != 0 {
+		t.Errorf("Expected no requests or results after cancellation, got %d requests and %d results", calls, reported)
+	}
+}
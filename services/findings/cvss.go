@@ -0,0 +1,52 @@
+package findings
+
+// CVSSToSeverityBand maps a CVSS base score to the 0-5 severity scale of static findings, using the
+// CVSS qualitative ratings: None (0.0) is Informational, Low (0.1-3.9) is Low, Medium (4.0-6.9) is
+// Medium, High (7.0-8.9) is High and Critical (9.0-10.0) is Very High. Very Low has no CVSS rating
+// and is never returned.
+func CVSSToSeverityBand(cvss float64) int {
+	switch {
+	case cvss >= 9.0:
+		return SeverityVeryHigh
+	case cvss >= 7.0:
+		return SeverityHigh
+	case cvss >= 4.0:
+		return SeverityMedium
+	case cvss > 0:
+		return SeverityLow
+	default:
+		return SeverityInformational
+	}
+}
+
+// FindingCVSS returns the CVSS base score of an SCA finding's CVE, preferring CVSS v3 over v2. It
+// returns false when the finding has no score.
+func FindingCVSS(finding *Finding) (float64, bool) {
+	details, _ := finding.FindingDetails.(map[string]interface{})
+	cve, ok := details["cve"].(map[string]interface{})
+	if !ok {
+		return 0, false
+	}
+	if cvss3, ok := cve["cvss3"].(map[string]interface{}); ok {
+		if score, ok := cvss3["score"].(float64); ok {
+			return score, true
+		}
+	}
+	score, ok := cve["cvss"].(float64)
+	return score, ok
+}
+
+// FindingSeverity returns the finding's 0-5 severity. SCA findings without one are banded by their
+// CVSS score, so they rank and color like static findings.
+func FindingSeverity(finding *Finding) int {
+	details, _ := finding.FindingDetails.(map[string]interface{})
+	if severity, ok := details["severity"].(float64); ok {
+		return int(severity)
+	}
+	if finding.ScanType == ScanTypeSCA {
+		if cvss, ok := FindingCVSS(finding); ok {
+			return CVSSToSeverityBand(cvss)
+		}
+	}
+	return 0
+}
@@ -0,0 +1,83 @@
+package findings
+
+import "testing"
+
+func TestCVSSToSeverityBand(t *testing.T) {
+	tests := []struct {
+		cvss float64
+		want int
+	}{
+		{0.0, SeverityInformational},
+		{0.1, SeverityLow},
+		{3.9, SeverityLow},
+		{4.0, SeverityMedium},
+		{6.9, SeverityMedium},
+		{7.0, SeverityHigh},
+		{8.9, SeverityHigh},
+		{9.0, SeverityVeryHigh},
+		{10.0, SeverityVeryHigh},
+	}
+
+	for _, tt := range tests {
+		if got := CVSSToSeverityBand(tt.cvss); got != tt.want {
+			t.Errorf("CVSSToSeverityBand(%.1f) = %d, want %d", tt.cvss, got, tt.want)
+		}
+	}
+}
+
+func TestFindingCVSS(t *testing.T) {
+	v3 := &Finding{FindingDetails: map[string]interface{}{
+		"cve": map[string]interface{}{"cvss": 5.0, "cvss3": map[string]interface{}{"score": 7.5}},
+	}}
+	if score, ok := FindingCVSS(v3); !ok || score != 7.5 {
+		t.Errorf("Expected the CVSS v3 score 7.5, got %v, %v", score, ok)
+	}
+
+	v2 := &Finding{FindingDetails: map[string]interface{}{"cve": map[string]interface{}{"cvss": 4.3}}}
+	if score, ok := FindingCVSS(v2); !ok || score != 4.3 {
+		t.Errorf("Expected the CVSS v2 score 4.3, got %v, %v", score, ok)
+	}
+
+	if _, ok := FindingCVSS(&Finding{}); ok {
+		t.Error("Expected no score for a finding without a CVE")
+	}
+}
+
+func TestFindingSeverity(t *testing.T) {
+	tests := []struct {
+		name    string
+		finding Finding
+		want    int
+	}{
+		{
+			"severity given",
+			Finding{ScanType: ScanTypeSCA, FindingDetails: map[string]interface{}{
+				"severity": float64(4), "cve": map[string]interface{}{"cvss": 5.9},
+			}},
+			4,
+		},
+		{
+			"SCA banded by CVSS",
+			Finding{ScanType: ScanTypeSCA, FindingDetails: map[string]interface{}{
+				"cve": map[string]interface{}{"cvss3": map[string]interface{}{"score": 9.8}},
+			}},
+			SeverityVeryHigh,
+		},
+		{
+			"static without severity",
+			Finding{ScanType: ScanTypeStatic, FindingDetails: map[string]interface{}{
+				"cve": map[string]interface{}{"cvss": 9.8},
+			}},
+			0,
+		},
+		{"no details", Finding{ScanType: ScanTypeSCA}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FindingSeverity(&tt.finding); got != tt.want {
+				t.Errorf("FindingSeverity() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
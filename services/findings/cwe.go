@@ -0,0 +1,45 @@
+package findings
+
+//go:generate go run gen_cwe_names.go
+
+// CWEID returns the CWE id from a finding's details, or 0 if the finding has none
+func CWEID(finding *Finding) int {
+	cwe := cweDetails(finding)
+	if id, ok := cwe["id"].(float64); ok {
+		return int(id)
+	}
+	return 0
+}
+
+// CWEName returns the MITRE title of a common CWE id, or "" if the id is not in the embedded list.
+// The list is generated from cwe_names.csv.
+func CWEName(id int) string {
+	return cweNames[id]
+}
+
+// FindingCWEName returns the CWE name from a finding's details, falling back to CWEName when the
+// details only give the id. It returns "" if the finding has no CWE or the id is unknown.
+func FindingCWEName(finding *Finding) string {
+	if name := stringField(cweDetails(finding), "name"); name != "" {
+		return name
+	}
+	return CWEName(CWEID(finding))
+}
+
+// GroupByCWE groups findings by CWE id, preserving input order within each group.
+// Findings without a CWE are grouped under 0.
+func GroupByCWE(findings []Finding) map[int][]Finding {
+	groups := make(map[int][]Finding)
+	for i := range findings {
+		id := CWEID(&findings[i])
+		groups[id] = append(groups[id], findings[i])
+	}
+	return groups
+}
+
+// cweDetails returns the decoded cwe object from a finding's details, or nil
+func cweDetails(finding *Finding) map[string]interface{} {
+	details, _ := finding.FindingDetails.(map[string]interface{})
+	cwe, _ := details["cwe"].(map[string]interface{})
+	return cwe
+}
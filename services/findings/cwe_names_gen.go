@@ -0,0 +1,84 @@
+// Code generated by gen_cwe_names.go from cwe_names.csv; DO NOT EDIT.
+
+package findings
+
+// cweNames maps CWE ids to their MITRE titles
+var cweNames = map[int]string{
+	15:   "External Control of System or Configuration Setting",
+	20:   "Improper Input Validation",
+	22:   "Improper Limitation of a Pathname to a Restricted Directory ('Path Traversal')",
+	73:   "External Control of File Name or Path",
+	77:   "Improper Neutralization of Special Elements used in a Command ('Command Injection')",
+	78:   "Improper Neutralization of Special Elements used in an OS Command ('OS Command Injection')",
+	79:   "Improper Neutralization of Input During Web Page Generation ('Cross-site Scripting')",
+	80:   "Improper Neutralization of Script-Related HTML Tags in a Web Page (Basic XSS)",
+	89:   "Improper Neutralization of Special Elements used in an SQL Command ('SQL Injection')",
+	90:   "Improper Neutralization of Special Elements used in an LDAP Query ('LDAP Injection')",
+	91:   "XML Injection (aka Blind XPath Injection)",
+	93:   "Improper Neutralization of CRLF Sequences ('CRLF Injection')",
+	94:   "Improper Control of Generation of Code ('Code Injection')",
+	95:   "Improper Neutralization of Directives in Dynamically Evaluated Code ('Eval Injection')",
+	113:  "Improper Neutralization of CRLF Sequences in HTTP Headers ('HTTP Request/Response Splitting')",
+	117:  "Improper Output Neutralization for Logs",
+	119:  "Improper Restriction of Operations within the Bounds of a Memory Buffer",
+	120:  "Buffer Copy without Checking Size of Input ('Classic Buffer Overflow')",
+	134:  "Use of Externally-Controlled Format String",
+	170:  "Improper Null Termination",
+	190:  "Integer Overflow or Wraparound",
+	200:  "Exposure of Sensitive Information to an Unauthorized Actor",
+	201:  "Insertion of Sensitive Information Into Sent Data",
+	209:  "Generation of Error Message Containing Sensitive Information",
+	223:  "Omission of Security-relevant Information",
+	244:  "Improper Clearing of Heap Memory Before Release ('Heap Inspection')",
+	250:  "Execution with Unnecessary Privileges",
+	256:  "Plaintext Storage of a Password",
+	259:  "Use of Hard-coded Password",
+	261:  "Weak Encoding for Password",
+	284:  "Improper Access Control",
+	285:  "Improper Authorization",
+	287:  "Improper Authentication",
+	295:  "Improper Certificate Validation",
+	297:  "Improper Validation of Certificate with Host Mismatch",
+	311:  "Missing Encryption of Sensitive Data",
+	312:  "Cleartext Storage of Sensitive Information",
+	313:  "Cleartext Storage in a File or on Disk",
+	316:  "Cleartext Storage of Sensitive Information in Memory",
+	319:  "Cleartext Transmission of Sensitive Information",
+	321:  "Use of Hard-coded Cryptographic Key",
+	326:  "Inadequate Encryption Strength",
+	327:  "Use of a Broken or Risky Cryptographic Algorithm",
+	329:  "Generation of Predictable IV with CBC Mode",
+	331:  "Insufficient Entropy",
+	338:  "Use of Cryptographically Weak Pseudo-Random Number Generator (PRNG)",
+	352:  "Cross-Site Request Forgery (CSRF)",
+	359:  "Exposure of Private Personal Information to an Unauthorized Actor",
+	362:  "Concurrent Execution using Shared Resource with Improper Synchronization ('Race Condition')",
+	377:  "Insecure Temporary File",
+	384:  "Session Fixation",
+	400:  "Uncontrolled Resource Consumption",
+	404:  "Improper Resource Shutdown or Release",
+	470:  "Use of Externally-Controlled Input to Select Classes or Code ('Unsafe Reflection')",
+	476:  "NULL Pointer Dereference",
+	501:  "Trust Boundary Violation",
+	502:  "Deserialization of Untrusted Data",
+	521:  "Weak Password Requirements",
+	522:  "Insufficiently Protected Credentials",
+	532:  "Insertion of Sensitive Information into Log File",
+	601:  "URL Redirection to Untrusted Site ('Open Redirect')",
+	611:  "Improper Restriction of XML External Entity Reference",
+	614:  "Sensitive Cookie in HTTPS Session Without 'Secure' Attribute",
+	643:  "Improper Neutralization of Data within XPath Expressions ('XPath Injection')",
+	667:  "Improper Locking",
+	693:  "Protection Mechanism Failure",
+	697:  "Incorrect Comparison",
+	732:  "Incorrect Permission Assignment for Critical Resource",
+	759:  "Use of a One-Way Hash without a Salt",
+	776:  "Improper Restriction of Recursive Entity References in DTDs ('XML Entity Expansion')",
+	798:  "Use of Hard-coded Credentials",
+	829:  "Inclusion of Functionality from Untrusted Control Sphere",
+	915:  "Improperly Controlled Modification of Dynamically-Determined Object Attributes",
+	918:  "Server-Side Request Forgery (SSRF)",
+	1004: "Sensitive Cookie Without 'HttpOnly' Flag",
+	1021: "Improper Restriction of Rendered UI Layers or Frames",
+	1236: "Improper Neutralization of Formula Elements in a CSV File",
+}
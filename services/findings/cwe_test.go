@@ -0,0 +1,76 @@
+package findings
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const mixedCWEFindingsResponse = `{
+	"_embedded": {
+		"findings": [
+			{"issue_id": 1, "scan_type": "STATIC", "finding_details": {"cwe": {"id": 89, "name": "SQL Injection"}}},
+			{"issue_id": 2, "scan_type": "STATIC", "finding_details": {"cwe": {"id": 79, "name": "Cross-site Scripting"}}},
+			{"issue_id": 3, "scan_type": "STATIC", "finding_details": {"cwe": {"id": 89, "name": "SQL Injection"}}},
+			{"issue_id": 4, "scan_type": "STATIC", "finding_details": {"file_path": "src/Main.java"}}
+		]
+	}
+}`
+
+func TestGroupByCWE(t *testing.T) {
+	var result PagedResourceOfFinding
+	if err := json.Unmarshal([]byte(mixedCWEFindingsResponse), &result); err != nil {
+		t.Fatalf("Failed to decode findings: %v", err)
+	}
+
+	groups := GroupByCWE(result.Embedded.Findings)
+	if len(groups) != 3 {
+		t.Fatalf("Expected 3 groups, got %d", len(groups))
+	}
+
+	sqli := groups[89]
+	if len(sqli) != 2 || sqli[0].IssueID != 1 || sqli[1].IssueID != 3 {
+		t.Errorf("Expected issues 1 and 3 under CWE-89, got %+v", sqli)
+	}
+	if len(groups[79]) != 1 || groups[79][0].IssueID != 2 {
+		t.Errorf("Expected issue 2 under CWE-79, got %+v", groups[79])
+	}
+	if len(groups[0]) != 1 || groups[0][0].IssueID != 4 {
+		t.Errorf("Expected issue 4 without a CWE, got %+v", groups[0])
+	}
+
+	if name := FindingCWEName(&sqli[0]); name != "SQL Injection" {
+		t.Errorf("Expected CWE name 'SQL Injection', got %q", name)
+	}
+	if CWEID(&groups[0][0]) != 0 || FindingCWEName(&groups[0][0]) != "" {
+		t.Error("Expected no CWE for a finding without cwe details")
+	}
+}
+
+func TestCWEName(t *testing.T) {
+	tests := []struct {
+		id   int
+		want string
+	}{
+		{79, "Improper Neutralization of Input During Web Page Generation ('Cross-site Scripting')"},
+		{89, "Improper Neutralization of Special Elements used in an SQL Command ('SQL Injection')"},
+		{502, "Deserialization of Untrusted Data"},
+		{798, "Use of Hard-coded Credentials"},
+		{0, ""},
+		{99999, ""},
+	}
+
+	for _, tt := range tests {
+		if got := CWEName(tt.id); got != tt.want {
+			t.Errorf("CWEName(%d) = %q, want %q", tt.id, got, tt.want)
+		}
+	}
+}
+
+func TestFindingCWEName_FallsBackToLookup(t *testing.T) {
+	finding := Finding{FindingDetails: map[string]interface{}{
+		"cwe": map[string]interface{}{"id": float64(502)},
+	}}
+	if name := FindingCWEName(&finding); name != "Deserialization of Untrusted Data" {
+		t.Errorf("Expected the embedded CWE name, got %q", name)
+	}
+}
@@ -0,0 +1,47 @@
+package findings
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/dipsylala/veracode-tui/veracode"
+)
+
+// decodeFindingsPage decodes a page of findings. If the typed decode fails, for example because
+// Veracode changed the type of a field, the findings are decoded one at a time and the malformed
+// ones skipped, so one bad record does not fail the whole list.
+func (s *Service) decodeFindingsPage(body []byte) (*PagedResourceOfFinding, error) {
+	var result PagedResourceOfFinding
+	err := veracode.DecodeJSON(body, &result)
+	if err == nil {
+		return &result, nil
+	}
+
+	var envelope struct {
+		Embedded *struct {
+			Findings json.RawMessage `json:"findings"`
+		} `json:"_embedded"`
+		Page json.RawMessage `json:"page"`
+	}
+	if json.Unmarshal(body, &envelope) != nil {
+		return nil, fmt.Errorf("failed to parse findings response: %w", err)
+	}
+
+	var partial PagedResourceOfFinding
+	skipped := 0
+	if envelope.Embedded != nil {
+		findings, n, elementsErr := veracode.DecodeElements[Finding](envelope.Embedded.Findings)
+		if elementsErr != nil {
+			return nil, fmt.Errorf("failed to parse findings response: %w", err)
+		}
+		partial.Embedded = &EmbeddedFinding{Findings: findings}
+		skipped = n
+	}
+	// Paging is best effort; the list still renders without it
+	if json.Unmarshal(envelope.Page, &partial.Page) != nil {
+		partial.Page = nil
+	}
+
+	veracode.Warnf(s.client, "Findings response did not fully decode, skipped %d malformed findings: %v", skipped, err)
+	return &partial, nil
+}
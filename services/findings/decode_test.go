@@ -0,0 +1,47 @@
+package findings
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestGetFindings_SkipsMalformedFindings(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			return []byte(`{
+				"_embedded": {"findings": [
+					{"issue_id": 1, "description": "valid"},
+					{"issue_id": "two"},
+					{"issue_id": 3, "finding_status": {"first_found_date": "yesterday"}},
+					{"issue_id": 4, "violates_policy": true}
+				]},
+				"page": {"total_elements": 4, "total_pages": 1}
+			}`), nil
+		},
+	}
+
+	result, err := NewService(client).GetFindings("app-guid", nil)
+	if err != nil {
+		t.Fatalf("Expected the valid findings despite malformed ones, got %v", err)
+	}
+
+	findings := result.Embedded.Findings
+	if len(findings) != 2 || findings[0].IssueID != 1 || findings[1].IssueID != 4 {
+		t.Errorf("Expected findings 1 and 4, got %+v", findings)
+	}
+	if result.Page == nil || result.Page.TotalElements != 4 {
+		t.Errorf("Expected the page metadata to be kept, got %+v", result.Page)
+	}
+}
+
+func TestGetFindings_UnparseableResponse(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			return []byte(`not json`), nil
+		},
+	}
+
+	if _, err := NewService(client).GetFindings("app-guid", nil); err == nil {
+		t.Error("Expected an error for a response that is not JSON")
+	}
+}
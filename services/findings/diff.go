@@ -0,0 +1,81 @@
+package findings
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Identity returns a key identifying the same flaw across scan contexts and exports, where issue IDs
+// may differ: CWE, file and line for static findings, CVE and component for SCA findings, and CWE,
+// URL and parameter for dynamic findings. Findings without details fall back to the issue ID.
+func Identity(finding *Finding) string {
+	details, ok := finding.FindingDetails.(map[string]interface{})
+	if !ok {
+		return fmt.Sprintf("ISSUE|%d", finding.IssueID)
+	}
+
+	switch finding.ScanType {
+	case ScanTypeStatic:
+		return fmt.Sprintf("STATIC|%d|%s|%s", CWEID(finding), stringField(details, "file_path"), numberField(details, "file_line_number"))
+	case ScanTypeSCA:
+		cve, _ := details["cve"].(map[string]interface{})
+		return fmt.Sprintf("SCA|%s|%s", stringField(cve, "name"), stringField(details, "component_filename"))
+	case ScanTypeDynamic:
+		return fmt.Sprintf("DYNAMIC|%d|%s|%s", CWEID(finding), stringField(details, "url"), stringField(details, "vulnerable_parameter"))
+	default:
+		return fmt.Sprintf("ISSUE|%d", finding.IssueID)
+	}
+}
+
+// Diff compares two sets of findings by Identity. Added holds findings only in b, removed holds
+// findings only in a, and changed holds the b version of findings present in both whose status,
+// resolution or resolution status differ. Results keep the order of their source slice.
+func Diff(a, b []Finding) (added, removed, changed []Finding) {
+	before := make(map[string]*Finding, len(a))
+	for i := range a {
+		before[Identity(&a[i])] = &a[i]
+	}
+	after := make(map[string]bool, len(b))
+
+	for i := range b {
+		key := Identity(&b[i])
+		after[key] = true
+		previous, ok := before[key]
+		switch {
+		case !ok:
+			added = append(added, b[i])
+		case statusChanged(previous, &b[i]):
+			changed = append(changed, b[i])
+		}
+	}
+
+	for i := range a {
+		if !after[Identity(&a[i])] {
+			removed = append(removed, a[i])
+		}
+	}
+	return added, removed, changed
+}
+
+// statusChanged reports whether two versions of a finding differ in status or resolution
+func statusChanged(a, b *Finding) bool {
+	var statusA, statusB FindingStatus
+	if a.FindingStatus != nil {
+		statusA = *a.FindingStatus
+	}
+	if b.FindingStatus != nil {
+		statusB = *b.FindingStatus
+	}
+	return statusA.Status != statusB.Status ||
+		statusA.Resolution != statusB.Resolution ||
+		statusA.ResolutionStatus != statusB.ResolutionStatus
+}
+
+// numberField returns a numeric value in decoded JSON as a string, or "" if absent
+func numberField(data map[string]interface{}, key string) string {
+	value, ok := data[key].(float64)
+	if !ok {
+		return ""
+	}
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
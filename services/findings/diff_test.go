@@ -0,0 +1,79 @@
+package findings
+
+import "testing"
+
+func staticFinding(issueID int64, cwe int, file string, line int, status Status, resolution ResolutionStatus) Finding {
+	return Finding{
+		IssueID:  issueID,
+		ScanType: ScanTypeStatic,
+		FindingStatus: &FindingStatus{
+			Status:           status,
+			ResolutionStatus: resolution,
+		},
+		FindingDetails: map[string]interface{}{
+			"cwe":              map[string]interface{}{"id": float64(cwe)},
+			"file_path":        file,
+			"file_line_number": float64(line),
+		},
+	}
+}
+
+func scaFinding(issueID int64, cve, component string) Finding {
+	return Finding{
+		IssueID:  issueID,
+		ScanType: ScanTypeSCA,
+		FindingDetails: map[string]interface{}{
+			"cve":                map[string]interface{}{"name": cve},
+			"component_filename": component,
+		},
+	}
+}
+
+func TestDiff(t *testing.T) {
+	policy := []Finding{
+		staticFinding(1, 89, "src/Dao.java", 42, StatusOpen, ResolutionNone),
+		staticFinding(2, 79, "src/View.java", 10, StatusOpen, ResolutionNone),
+		staticFinding(3, 327, "src/Crypto.java", 7, StatusOpen, ResolutionNone),
+		scaFinding(4, "CVE-2021-44228", "log4j-core-2.14.1.jar"),
+	}
+	// Sandbox issue IDs differ, but the same flaws keep their identity
+	sandbox := []Finding{
+		staticFinding(101, 89, "src/Dao.java", 42, StatusOpen, ResolutionNone),
+		staticFinding(102, 79, "src/View.java", 10, StatusOpen, ResolutionProposed),
+		staticFinding(103, 89, "src/Dao.java", 57, StatusOpen, ResolutionNone),
+		scaFinding(104, "CVE-2021-44228", "log4j-core-2.14.1.jar"),
+		scaFinding(105, "CVE-2021-44228", "log4j-core-2.15.0.jar"),
+	}
+
+	added, removed, changed := Diff(policy, sandbox)
+
+	if len(added) != 2 || added[0].IssueID != 103 || added[1].IssueID != 105 {
+		t.Errorf("Expected issues 103 and 105 added, got %+v", added)
+	}
+	if len(removed) != 1 || removed[0].IssueID != 3 {
+		t.Errorf("Expected issue 3 removed, got %+v", removed)
+	}
+	if len(changed) != 1 || changed[0].IssueID != 102 {
+		t.Errorf("Expected issue 102 changed, got %+v", changed)
+	}
+}
+
+func TestDiff_Identical(t *testing.T) {
+	findings := []Finding{
+		staticFinding(1, 89, "src/Dao.java", 42, StatusOpen, ResolutionNone),
+		{IssueID: 2, ScanType: ScanTypeStatic},
+	}
+
+	added, removed, changed := Diff(findings, findings)
+	if len(added) != 0 || len(removed) != 0 || len(changed) != 0 {
+		t.Errorf("Expected no differences, got %d added, %d removed, %d changed", len(added), len(removed), len(changed))
+	}
+}
+
+func TestIdentity_StatusDoesNotAffectIdentity(t *testing.T) {
+	open := staticFinding(1, 89, "src/Dao.java", 42, StatusOpen, ResolutionNone)
+	closed := staticFinding(2, 89, "src/Dao.java", 42, StatusClosed, ResolutionApproved)
+	if Identity(&open) != Identity(&closed) {
+		t.Errorf("Expected matching identities, got %q and %q", Identity(&open), Identity(&closed))
+	}
+}
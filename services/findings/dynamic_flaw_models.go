@@ -0,0 +1,44 @@
+package findings
+
+// DynamicFlawInfo represents the detailed request/response information for a dynamic flaw
+type DynamicFlawInfo struct {
+	IssueSummary    *IssueSummary       `json:"issue_summary,omitempty"`
+	DynamicFlawInfo *DynamicFlawDetails `json:"dynamic_flaw_info,omitempty"`
+}
+
+// DynamicFlawDetails contains the HTTP exchange that demonstrated the flaw
+type DynamicFlawDetails struct {
+	Request  *DynamicFlawRequest  `json:"request,omitempty"`
+	Response *DynamicFlawResponse `json:"response,omitempty"`
+	Plugin   *DynamicFlawPlugin   `json:"plugin,omitempty"`
+}
+
+// DynamicFlawRequest represents the HTTP request sent by the scanner
+type DynamicFlawRequest struct {
+	Protocol     string       `json:"protocol,omitempty"`
+	Host         string       `json:"host,omitempty"`
+	Method       string       `json:"method,omitempty"`
+	URL          string       `json:"url,omitempty"`
+	AttackVector string       `json:"attack_vector,omitempty"`
+	Headers      []HTTPHeader `json:"headers,omitempty"`
+	Body         string       `json:"body,omitempty"`
+}
+
+// DynamicFlawResponse represents the HTTP response received by the scanner
+type DynamicFlawResponse struct {
+	Headers []HTTPHeader `json:"headers,omitempty"`
+	Body    string       `json:"body,omitempty"`
+}
+
+// DynamicFlawPlugin identifies the scanner plugin that detected the flaw
+type DynamicFlawPlugin struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// HTTPHeader represents a single HTTP header name/value pair
+type HTTPHeader struct {
+	Name  string `json:"name,omitempty"`
+	Value string `json:"value,omitempty"`
+}
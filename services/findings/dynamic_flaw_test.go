@@ -0,0 +1,137 @@
+package findings
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/dipsylala/veracode-tui/veracode"
+)
+
+// MockHTTPClient is a mock implementation of HTTPClient for testing
+type MockHTTPClient struct {
+	DoRequestWithQueryParamsFunc func(method, urlPath string, params url.Values) ([]byte, error)
+}
+
+func (m *MockHTTPClient) DoRequestWithQueryParams(method, urlPath string, params url.Values) ([]byte, error) {
+	if m.DoRequestWithQueryParamsFunc != nil {
+		return m.DoRequestWithQueryParamsFunc(method, urlPath, params)
+	}
+	return []byte("{}"), nil
+}
+
+const dynamicFlawInfoResponse = `{
+	"issue_summary": {"app_guid": "app-guid", "name": "Cross-Site Scripting", "issue_id": 42},
+	"dynamic_flaw_info": {
+		"request": {
+			"protocol": "https",
+			"host": "example.com",
+			"method": "POST",
+			"url": "https://example.com/login",
+			"attack_vector": "username",
+			"headers": [{"name": "Content-Type", "value": "application/x-www-form-urlencoded"}],
+			"body": "username=<script>alert(1)</script>"
+		},
+		"response": {
+			"headers": [{"name": "Content-Type", "value": "text/html"}],
+			"body": "<html><script>alert(1)</script></html>"
+		},
+		"plugin": {"id": "80001", "name": "Reflected XSS"}
+	}
+}`
+
+func TestGetDynamicFlawInfo_Success(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			if method != "GET" {
+				t.Errorf("Expected GET method, got %s", method)
+			}
+			if urlPath != "/appsec/v2/applications/app-guid/findings/42/dynamic_flaw_info" {
+				t.Errorf("Expected correct URL path, got %s", urlPath)
+			}
+			if params.Get("context") != "sandbox-guid" {
+				t.Errorf("Expected context parameter to be sandbox-guid, got %s", params.Get("context"))
+			}
+			return []byte(dynamicFlawInfoResponse), nil
+		},
+	}
+
+	service := NewService(client)
+	info, err := service.GetDynamicFlawInfo("app-guid", 42, "sandbox-guid")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if info.DynamicFlawInfo == nil || info.DynamicFlawInfo.Request == nil {
+		t.Fatal("Expected request details to be parsed")
+	}
+	request := info.DynamicFlawInfo.Request
+	if request.Method != "POST" {
+		t.Errorf("Expected method POST, got %s", request.Method)
+	}
+	if request.URL != "https://example.com/login" {
+		t.Errorf("Expected URL to be parsed, got %s", request.URL)
+	}
+	if request.AttackVector != "username" {
+		t.Errorf("Expected attack vector 'username', got %s", request.AttackVector)
+	}
+	if info.DynamicFlawInfo.Response == nil || info.DynamicFlawInfo.Response.Body == "" {
+		t.Error("Expected response body to be parsed")
+	}
+	if info.DynamicFlawInfo.Plugin == nil || info.DynamicFlawInfo.Plugin.Name != "Reflected XSS" {
+		t.Error("Expected plugin info to be parsed")
+	}
+}
+
+func TestGetDynamicFlawInfo_RetriesWithoutContextOn404(t *testing.T) {
+	calls := 0
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			calls++
+			if params.Get("context") != "" {
+				return nil, &veracode.HTTPError{StatusCode: 404, Status: "404 Not Found", Body: []byte("Build does not have dynamic flaws")}
+			}
+			return []byte(dynamicFlawInfoResponse), nil
+		},
+	}
+
+	service := NewService(client)
+	info, err := service.GetDynamicFlawInfo("app-guid", 42, "sandbox-guid")
+	if err != nil {
+		t.Fatalf("Expected fallback without context to succeed, got %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 requests, got %d", calls)
+	}
+	if info.IssueSummary == nil || info.IssueSummary.IssueID != 42 {
+		t.Error("Expected issue summary to be parsed")
+	}
+}
+
+func TestGetDynamicFlawInfo_NoRetryWithoutContext(t *testing.T) {
+	calls := 0
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			calls++
+			return nil, &veracode.HTTPError{StatusCode: 404, Status: "404 Not Found"}
+		},
+	}
+
+	service := NewService(client)
+	if _, err := service.GetDynamicFlawInfo("app-guid", 42, ""); err == nil {
+		t.Fatal("Expected error for 404 without context, got nil")
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 request, got %d", calls)
+	}
+}
+
+func TestGetDynamicFlawInfo_MissingParameters(t *testing.T) {
+	service := NewService(&MockHTTPClient{})
+
+	if _, err := service.GetDynamicFlawInfo("", 42, ""); err == nil {
+		t.Error("Expected error for missing applicationGUID, got nil")
+	}
+	if _, err := service.GetDynamicFlawInfo("app-guid", 0, ""); err == nil {
+		t.Error("Expected error for missing issueID, got nil")
+	}
+}
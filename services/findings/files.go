@@ -0,0 +1,73 @@
+package findings
+
+import "sort"
+
+// UnknownFile is the FileGroup path of static findings that do not report a source file
+const UnknownFile = "Unknown"
+
+// FileGroup groups the static findings reported in one source file
+type FileGroup struct {
+	FilePath string     // Path from the finding details, or UnknownFile
+	Findings []*Finding // The grouped findings ordered by line number, pointing into the input slice
+}
+
+// GroupByFile groups static findings by source file path, ordered by path with UnknownFile last.
+// Findings that are not static findings are ignored.
+func GroupByFile(findings []Finding) []FileGroup {
+	groups := []FileGroup{}
+	index := make(map[string]int)
+
+	for i := range findings {
+		finding := &findings[i]
+		if finding.ScanType != ScanTypeStatic {
+			continue
+		}
+
+		path := FilePath(finding)
+		if path == "" {
+			path = UnknownFile
+		}
+		pos, ok := index[path]
+		if !ok {
+			pos = len(groups)
+			index[path] = pos
+			groups = append(groups, FileGroup{FilePath: path})
+		}
+		groups[pos].Findings = append(groups[pos].Findings, finding)
+	}
+
+	for i := range groups {
+		list := groups[i].Findings
+		sort.SliceStable(list, func(a, b int) bool {
+			if la, lb := LineNumber(list[a]), LineNumber(list[b]); la != lb {
+				return la < lb
+			}
+			return list[a].IssueID < list[b].IssueID
+		})
+	}
+	sort.Slice(groups, func(a, b int) bool {
+		if (groups[a].FilePath == UnknownFile) != (groups[b].FilePath == UnknownFile) {
+			return groups[b].FilePath == UnknownFile
+		}
+		return groups[a].FilePath < groups[b].FilePath
+	})
+
+	return groups
+}
+
+// FilePath returns the source file of a static finding, falling back to the file name when the
+// details have no path. It returns "" if the finding reports neither.
+func FilePath(finding *Finding) string {
+	details, _ := finding.FindingDetails.(map[string]interface{})
+	if path := stringField(details, "file_path"); path != "" {
+		return path
+	}
+	return stringField(details, "file_name")
+}
+
+// LineNumber returns the line of a static finding in its source file, or 0 if it has none
+func LineNumber(finding *Finding) int {
+	details, _ := finding.FindingDetails.(map[string]interface{})
+	line, _ := details["file_line_number"].(float64)
+	return int(line)
+}
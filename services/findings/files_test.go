@@ -0,0 +1,64 @@
+package findings
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const mixedFileFindingsResponse = `{
+	"_embedded": {
+		"findings": [
+			{"issue_id": 1, "scan_type": "STATIC", "finding_details": {"file_path": "src/b/Orders.java", "file_line_number": 120}},
+			{"issue_id": 2, "scan_type": "STATIC", "finding_details": {"file_path": "src/a/Login.java", "file_line_number": 42}},
+			{"issue_id": 3, "scan_type": "STATIC", "finding_details": {"file_path": "src/b/Orders.java", "file_line_number": 15}},
+			{"issue_id": 4, "scan_type": "STATIC", "finding_details": {"cwe": {"id": 89}}},
+			{"issue_id": 5, "scan_type": "STATIC", "finding_details": {"file_name": "web.config"}},
+			{"issue_id": 6, "scan_type": "DYNAMIC", "finding_details": {"url": "https://example.com/login"}},
+			{"issue_id": 7, "scan_type": "STATIC", "finding_details": {"file_path": "src/b/Orders.java", "file_line_number": 15}}
+		]
+	}
+}`
+
+func TestGroupByFile(t *testing.T) {
+	var result PagedResourceOfFinding
+	if err := json.Unmarshal([]byte(mixedFileFindingsResponse), &result); err != nil {
+		t.Fatalf("Failed to decode findings: %v", err)
+	}
+
+	groups := GroupByFile(result.Embedded.Findings)
+
+	want := []struct {
+		path string
+		ids  []int64
+	}{
+		{"src/a/Login.java", []int64{2}},
+		{"src/b/Orders.java", []int64{3, 7, 1}},
+		{"web.config", []int64{5}},
+		{UnknownFile, []int64{4}},
+	}
+	if len(groups) != len(want) {
+		t.Fatalf("Expected %d groups, got %d", len(want), len(groups))
+	}
+	for i, w := range want {
+		group := groups[i]
+		if group.FilePath != w.path {
+			t.Errorf("Group %d: expected path %q, got %q", i, w.path, group.FilePath)
+			continue
+		}
+		if len(group.Findings) != len(w.ids) {
+			t.Errorf("%s: expected %d findings, got %d", w.path, len(w.ids), len(group.Findings))
+			continue
+		}
+		for j, id := range w.ids {
+			if group.Findings[j].IssueID != id {
+				t.Errorf("%s: expected issue %d at position %d, got %d", w.path, id, j, group.Findings[j].IssueID)
+			}
+		}
+	}
+}
+
+func TestGroupByFile_Empty(t *testing.T) {
+	if groups := GroupByFile(nil); len(groups) != 0 {
+		t.Errorf("Expected no groups, got %d", len(groups))
+	}
+}
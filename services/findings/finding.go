@@ -0,0 +1,67 @@
+package findings
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrFindingNotFound is returned by GetFinding when no finding has the requested issue ID
+var ErrFindingNotFound = errors.New("finding not found")
+
+// GetFinding retrieves a single finding by issue ID in a context (empty for policy). The Findings API
+// has no by-ID endpoint, so the findings are listed a page at a time until the issue is found; static
+// and dynamic findings are searched first, with their annotations, then SCA findings. It returns an
+// error wrapping ErrFindingNotFound when no finding has the issue ID.
+func (s *Service) GetFinding(applicationGUID string, issueID int64, context string) (*Finding, error) {
+	if applicationGUID == "" {
+		return nil, fmt.Errorf("applicationGUID is required")
+	}
+	if issueID == 0 {
+		return nil, fmt.Errorf("issueID is required")
+	}
+
+	searches := []GetFindingsOptions{
+		{
+			Context:            context,
+			ScanType:           []string{string(ScanTypeStatic), string(ScanTypeDynamic)},
+			IncludeAnnotations: true,
+		},
+		{
+			Context:  context,
+			ScanType: []string{string(ScanTypeSCA)},
+		},
+	}
+	for i := range searches {
+		finding, err := s.findFinding(applicationGUID, issueID, &searches[i])
+		if err != nil || finding != nil {
+			return finding, err
+		}
+	}
+
+	return nil, fmt.Errorf("issue %d: %w", issueID, ErrFindingNotFound)
+}
+
+// findFinding pages through the findings matching opts until one has issueID, returning nil when
+// none does
+func (s *Service) findFinding(applicationGUID string, issueID int64, opts *GetFindingsOptions) (*Finding, error) {
+	pageOpts := *opts
+	pageOpts.Size = findingsPageSize
+	for page := 0; ; page++ {
+		pageOpts.Page = page
+		result, err := s.GetFindings(applicationGUID, &pageOpts)
+		if err != nil {
+			return nil, err
+		}
+		if result.Embedded == nil || len(result.Embedded.Findings) == 0 {
+			return nil, nil
+		}
+		for i := range result.Embedded.Findings {
+			if result.Embedded.Findings[i].IssueID == issueID {
+				return &result.Embedded.Findings[i], nil
+			}
+		}
+		if result.Page == nil || int64(page+1) >= result.Page.TotalPages {
+			return nil, nil
+		}
+	}
+}
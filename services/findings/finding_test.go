@@ -0,0 +1,95 @@
+package findings
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+)
+
+func TestGetFinding_FindsIssueOnLaterPage(t *testing.T) {
+	var requests []url.Values
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			requests = append(requests, params)
+			if params.Get("page") == "" {
+				return []byte(`{"_embedded":{"findings":[{"issue_id":1},{"issue_id":2}]},"page":{"total_elements":4,"total_pages":2}}`), nil
+			}
+			return []byte(`{"_embedded":{"findings":[{"issue_id":3,"description":"target"},{"issue_id":4}]},"page":{"number":1,"total_elements":4,"total_pages":2}}`), nil
+		},
+	}
+	service := NewService(client)
+
+	finding, err := service.GetFinding("app-guid", 3, "sandbox-guid")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if finding.IssueID != 3 || finding.Description != "target" {
+		t.Errorf("Expected issue 3, got %+v", finding)
+	}
+	if len(requests) != 2 {
+		t.Fatalf("Expected the search to stop once the issue was found, got %d requests", len(requests))
+	}
+	first := requests[0]
+	if first.Get("context") != "sandbox-guid" || first.Get("include_annot") != "true" {
+		t.Errorf("Expected the sandbox context with annotations, got %v", first)
+	}
+	if len(first["scan_type"]) != 2 {
+		t.Errorf("Expected static and dynamic findings to be searched first, got %v", first["scan_type"])
+	}
+}
+
+func TestGetFinding_SearchesSCAFindings(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			if params.Get("scan_type") == string(ScanTypeSCA) {
+				if params.Has("include_annot") {
+					t.Errorf("Expected no annotations for SCA findings, got %v", params)
+				}
+				return []byte(`{"_embedded":{"findings":[{"issue_id":9}]},"page":{"total_pages":1}}`), nil
+			}
+			return []byte(`{"_embedded":{"findings":[{"issue_id":1}]},"page":{"total_pages":1}}`), nil
+		},
+	}
+	service := NewService(client)
+
+	finding, err := service.GetFinding("app-guid", 9, "")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if finding.IssueID != 9 {
+		t.Errorf("Expected issue 9, got %+v", finding)
+	}
+}
+
+func TestGetFinding_NotFound(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			return []byte(`{"_embedded":{"findings":[{"issue_id":1}]},"page":{"total_pages":1}}`), nil
+		},
+	}
+	service := NewService(client)
+
+	finding, err := service.GetFinding("app-guid", 42, "")
+	if !errors.Is(err, ErrFindingNotFound) {
+		t.Fatalf("Expected ErrFindingNotFound, got %v", err)
+	}
+	if finding != nil {
+		t.Errorf("Expected no finding, got %+v", finding)
+	}
+}
+
+func TestGetFinding_ReturnsRequestErrors(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			return nil, errors.New("boom")
+		},
+	}
+	service := NewService(client)
+
+	if _, err := service.GetFinding("app-guid", 1, ""); err == nil || errors.Is(err, ErrFindingNotFound) {
+		t.Errorf("Expected the request error, got %v", err)
+	}
+	if _, err := service.GetFinding("", 1, ""); err == nil {
+		t.Error("Expected an error for a missing application GUID")
+	}
+}
@@ -0,0 +1,65 @@
+//go:build ignore
+
+// gen_cwe_names generates cwe_names_gen.go from cwe_names.csv. Run it with go generate after
+// editing the CSV.
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+)
+
+func main() {
+	file, err := os.Open("cwe_names.csv")
+	if err != nil {
+		log.Fatalf("Failed to open CWE list: %v", err)
+	}
+	defer file.Close()
+
+	records, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		log.Fatalf("Failed to read CWE list: %v", err)
+	}
+
+	names := make(map[int]string)
+	for _, record := range records[1:] { // Skip the header row
+		id, err := strconv.Atoi(record[0])
+		if err != nil {
+			log.Fatalf("Invalid CWE id %q: %v", record[0], err)
+		}
+		if _, ok := names[id]; ok {
+			log.Fatalf("Duplicate CWE id %d", id)
+		}
+		names[id] = record[1]
+	}
+
+	ids := make([]int, 0, len(names))
+	for id := range names {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen_cwe_names.go from cwe_names.csv; DO NOT EDIT.\n\n")
+	buf.WriteString("package findings\n\n")
+	buf.WriteString("// cweNames maps CWE ids to their MITRE titles\n")
+	buf.WriteString("var cweNames = map[int]string{\n")
+	for _, id := range ids {
+		fmt.Fprintf(&buf, "\t%d: %s,\n", id, strconv.Quote(names[id]))
+	}
+	buf.WriteString("}\n")
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("Failed to format generated code: %v", err)
+	}
+	if err := os.WriteFile("cwe_names_gen.go", source, 0o644); err != nil {
+		log.Fatalf("Failed to write cwe_names_gen.go: %v", err)
+	}
+}
@@ -0,0 +1,16 @@
+package findings
+
+import "time"
+
+// GracePeriodRemaining returns the time left before the finding's policy grace period expires,
+// which is negative once it has expired. The boolean is false when no grace period applies:
+// the finding has no expiry date, does not violate policy, or is closed.
+func GracePeriodRemaining(f Finding, now time.Time) (time.Duration, bool) {
+	if f.GracePeriodExpiresDate == nil || !f.ViolatesPolicy {
+		return 0, false
+	}
+	if f.FindingStatus != nil && f.FindingStatus.Status == StatusClosed {
+		return 0, false
+	}
+	return f.GracePeriodExpiresDate.Sub(now), true
+}
@@ -0,0 +1,32 @@
+package findings
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGracePeriodRemaining(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	expires := now.Add(72 * time.Hour)
+	expired := now.Add(-24 * time.Hour)
+
+	tests := []struct {
+		name    string
+		finding Finding
+		want    time.Duration
+		applies bool
+	}{
+		{"no expiry date", Finding{ViolatesPolicy: true}, 0, false},
+		{"does not violate policy", Finding{GracePeriodExpiresDate: &expires}, 0, false},
+		{"closed", Finding{ViolatesPolicy: true, GracePeriodExpiresDate: &expires, FindingStatus: &FindingStatus{Status: StatusClosed}}, 0, false},
+		{"open", Finding{ViolatesPolicy: true, GracePeriodExpiresDate: &expires, FindingStatus: &FindingStatus{Status: StatusOpen}}, 72 * time.Hour, true},
+		{"expired", Finding{ViolatesPolicy: true, GracePeriodExpiresDate: &expired}, -24 * time.Hour, true},
+	}
+
+	for _, tt := range tests {
+		got, applies := GracePeriodRemaining(tt.finding, now)
+		if got != tt.want || applies != tt.applies {
+			t.Errorf("%s: GracePeriodRemaining() = %v, %v; want %v, %v", tt.name, got, applies, tt.want, tt.applies)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package findings
+
+// IsMitigable reports whether a mitigation is worth proposing for the finding: it violates policy
+// and has no mitigation in progress or accepted, i.e. its resolution status is NONE or REJECTED.
+// A finding without a status is not mitigable.
+func IsMitigable(f *Finding) bool {
+	if !f.ViolatesPolicy || f.FindingStatus == nil {
+		return false
+	}
+	switch f.FindingStatus.ResolutionStatus {
+	case ResolutionNone, ResolutionRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+// FilterMitigable returns the findings for which a mitigation is worth proposing, in their original order
+func FilterMitigable(list []Finding) []Finding {
+	filtered := make([]Finding, 0, len(list))
+	for i := range list {
+		if IsMitigable(&list[i]) {
+			filtered = append(filtered, list[i])
+		}
+	}
+	return filtered
+}
+
+// HasPendingProposal reports whether the finding has a mitigation proposal awaiting review, which can
+// be canceled, i.e. its resolution status is PROPOSED or PENDING. A nil finding or one without a
+// status has none.
+func HasPendingProposal(f *Finding) bool {
+	if f == nil || f.FindingStatus == nil {
+		return false
+	}
+	switch f.FindingStatus.ResolutionStatus {
+	case ResolutionProposed, ResolutionPending:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,73 @@
+package findings
+
+import "testing"
+
+func TestIsMitigable(t *testing.T) {
+	want := map[ResolutionStatus]bool{
+		ResolutionNone:       true,
+		ResolutionRejected:   true,
+		ResolutionApproved:   false,
+		ResolutionProposed:   false,
+		ResolutionPending:    false,
+		ResolutionCanceled:   false,
+		ResolutionAccepted:   false,
+		ResolutionUnresolved: false,
+		"":                   false,
+	}
+
+	for status, mitigable := range want {
+		t.Run(string(status)+" violating policy", func(t *testing.T) {
+			finding := Finding{ViolatesPolicy: true, FindingStatus: &FindingStatus{ResolutionStatus: status}}
+			if got := IsMitigable(&finding); got != mitigable {
+				t.Errorf("IsMitigable() = %v, want %v", got, mitigable)
+			}
+		})
+		t.Run(string(status)+" not violating policy", func(t *testing.T) {
+			finding := Finding{FindingStatus: &FindingStatus{ResolutionStatus: status}}
+			if IsMitigable(&finding) {
+				t.Error("Expected a finding that does not violate policy not to be mitigable")
+			}
+		})
+	}
+
+	if IsMitigable(&Finding{ViolatesPolicy: true}) {
+		t.Error("Expected a finding without a status not to be mitigable")
+	}
+}
+
+func TestFilterMitigable(t *testing.T) {
+	list := []Finding{
+		{IssueID: 1, ViolatesPolicy: true, FindingStatus: &FindingStatus{ResolutionStatus: ResolutionNone}},
+		{IssueID: 2, ViolatesPolicy: true, FindingStatus: &FindingStatus{ResolutionStatus: ResolutionProposed}},
+		{IssueID: 3, ViolatesPolicy: true},
+		{IssueID: 4, ViolatesPolicy: true, FindingStatus: &FindingStatus{ResolutionStatus: ResolutionRejected}},
+	}
+
+	got := FilterMitigable(list)
+	if len(got) != 2 || got[0].IssueID != 1 || got[1].IssueID != 4 {
+		t.Errorf("Expected findings 1 and 4, got %+v", got)
+	}
+}
+
+func TestHasPendingProposal(t *testing.T) {
+	want := map[ResolutionStatus]bool{
+		ResolutionProposed: true,
+		ResolutionPending:  true,
+		ResolutionNone:     false,
+		ResolutionApproved: false,
+		ResolutionRejected: false,
+		ResolutionCanceled: false,
+		ResolutionAccepted: false,
+		"":                 false,
+	}
+
+	for status, pending := range want {
+		finding := Finding{FindingStatus: &FindingStatus{ResolutionStatus: status}}
+		if got := HasPendingProposal(&finding); got != pending {
+			t.Errorf("HasPendingProposal(%q) = %v, want %v", status, got, pending)
+		}
+	}
+	if HasPendingProposal(&Finding{}) || HasPendingProposal(nil) {
+		t.Error("Expected a finding without a status to have no pending proposal")
+	}
+}
@@ -0,0 +1,18 @@
+package findings
+
+// IsNew reports whether the finding was first reported by the latest scan. A finding without
+// a status is not new.
+func IsNew(f *Finding) bool {
+	return f.FindingStatus != nil && f.FindingStatus.New
+}
+
+// FilterNew returns the findings first reported by the latest scan, in their original order
+func FilterNew(list []Finding) []Finding {
+	filtered := make([]Finding, 0, len(list))
+	for i := range list {
+		if IsNew(&list[i]) {
+			filtered = append(filtered, list[i])
+		}
+	}
+	return filtered
+}
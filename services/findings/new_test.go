@@ -0,0 +1,41 @@
+package findings
+
+import "testing"
+
+func TestIsNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		finding Finding
+		want    bool
+	}{
+		{"nil status", Finding{}, false},
+		{"not new", Finding{FindingStatus: &FindingStatus{Status: StatusOpen}}, false},
+		{"new", Finding{FindingStatus: &FindingStatus{Status: StatusOpen, New: true}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNew(&tt.finding); got != tt.want {
+				t.Errorf("IsNew() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterNew(t *testing.T) {
+	list := []Finding{
+		{IssueID: 1, FindingStatus: &FindingStatus{New: true}},
+		{IssueID: 2},
+		{IssueID: 3, FindingStatus: &FindingStatus{New: false}},
+		{IssueID: 4, FindingStatus: &FindingStatus{New: true}},
+	}
+
+	got := FilterNew(list)
+	if len(got) != 2 || got[0].IssueID != 1 || got[1].IssueID != 4 {
+		t.Errorf("Expected findings 1 and 4, got %+v", got)
+	}
+
+	if got := FilterNew(nil); len(got) != 0 {
+		t.Errorf("Expected no findings from a nil list, got %d", len(got))
+	}
+}
@@ -0,0 +1,48 @@
+package findings
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestBuildFindingsQueryParams_Paging(t *testing.T) {
+	tests := []struct {
+		name     string
+		page     int
+		size     int
+		wantPage string
+		wantSize string
+	}{
+		{"unset", 0, 0, "", ""},
+		{"first page omitted", 0, 100, "", "100"},
+		{"later page", 2, 100, "2", "100"},
+		{"max size", 0, MaxPageSize, "", "500"},
+		{"oversized size capped", 0, MaxPageSize + 1, "", "500"},
+		{"negative size omitted", 0, -1, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params, err := buildFindingsQueryParams(&GetFindingsOptions{Page: tt.page, Size: tt.size})
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if params.Get("page") != tt.wantPage || params.Get("size") != tt.wantSize {
+				t.Errorf("Expected page %q size %q, got %q", tt.wantPage, tt.wantSize, params.Encode())
+			}
+		})
+	}
+}
+
+func TestGetFindings_NegativePage(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			t.Error("Expected no request for a negative page")
+			return []byte("{}"), nil
+		},
+	}
+
+	if _, err := NewService(client).GetFindings("app-guid", &GetFindingsOptions{Page: -1}); err == nil {
+		t.Fatal("Expected an error for a negative page, got nil")
+	}
+}
@@ -0,0 +1,34 @@
+package findings
+
+// criticalityWeights multiplies a finding's severity by the business criticality of its
+// application, so flaws in critical applications rank first:
+//
+//	Criticality  Weight
+//	VERY_HIGH    5
+//	HIGH         4
+//	MEDIUM       3
+//	LOW          2
+//	VERY_LOW     1
+//
+// An application without a known criticality is weighted as MEDIUM. For example a medium flaw
+// (3) in a VERY_HIGH application scores 15, above a high flaw (4) in a LOW application at 8.
+var criticalityWeights = map[string]int{
+	"VERY_HIGH": 5,
+	"HIGH":      4,
+	"MEDIUM":    3,
+	"LOW":       2,
+	"VERY_LOW":  1,
+}
+
+// defaultCriticalityWeight is the weight of an unknown or missing business criticality
+const defaultCriticalityWeight = 3
+
+// PriorityScore combines a finding's severity (0-5) with the business criticality of its
+// application, e.g. "VERY_HIGH", into a score from 0 to 25 where higher means fix sooner
+func PriorityScore(severity int, criticality string) int {
+	weight, ok := criticalityWeights[criticality]
+	if !ok {
+		weight = defaultCriticalityWeight
+	}
+	return severity * weight
+}
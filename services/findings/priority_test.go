@@ -0,0 +1,32 @@
+package findings
+
+import "testing"
+
+func TestPriorityScore_Matrix(t *testing.T) {
+	criticalities := []string{"VERY_LOW", "LOW", "MEDIUM", "HIGH", "VERY_HIGH"}
+	for weight, criticality := range criticalities {
+		for severity := SeverityInformational; severity <= SeverityVeryHigh; severity++ {
+			want := severity * (weight + 1)
+			if got := PriorityScore(severity, criticality); got != want {
+				t.Errorf("PriorityScore(%d, %q) = %d, want %d", severity, criticality, got, want)
+			}
+		}
+	}
+}
+
+func TestPriorityScore_UnknownCriticalityIsMedium(t *testing.T) {
+	for _, criticality := range []string{"", "CRITICAL"} {
+		if got, want := PriorityScore(SeverityHigh, criticality), PriorityScore(SeverityHigh, "MEDIUM"); got != want {
+			t.Errorf("PriorityScore(4, %q) = %d, want %d", criticality, got, want)
+		}
+	}
+}
+
+func TestPriorityScore_CriticalityOutranksSeverity(t *testing.T) {
+	if PriorityScore(SeverityMedium, "VERY_HIGH") <= PriorityScore(SeverityHigh, "LOW") {
+		t.Error("Expected a medium flaw in a very high criticality application to rank above a high flaw in a low one")
+	}
+	if PriorityScore(SeverityInformational, "VERY_HIGH") != 0 {
+		t.Error("Expected informational findings to score 0 whatever the criticality")
+	}
+}
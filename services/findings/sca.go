@@ -0,0 +1,85 @@
+package findings
+
+// SCAComponent groups the SCA findings reported against one version of a third-party component
+type SCAComponent struct {
+	Name            string             // Component file name, e.g. "log4j-core-2.14.1.jar"
+	Version         string             // Affected component version
+	Vulnerabilities []SCAVulnerability // One entry per CVE finding, in input order
+	Findings        []*Finding         // The grouped findings, pointing into the input slice
+	SeverityCounts  map[int]int        // Number of findings per severity level
+	MaxSeverity     int
+	ViolatesPolicy  bool // True if any grouped finding violates policy
+}
+
+// SCAVulnerability summarises one CVE reported against a component
+type SCAVulnerability struct {
+	IssueID  int64
+	CVE      string
+	Href     string
+	CVSS     float64 // CVSS v3 base score when present, otherwise v2
+	Severity int
+}
+
+// GroupSCAByComponent aggregates SCA findings by component name and version, in order of first appearance.
+// Findings that are not SCA findings are ignored.
+func GroupSCAByComponent(findings []Finding) []SCAComponent {
+	components := []SCAComponent{}
+	index := make(map[string]int)
+
+	for i := range findings {
+		finding := &findings[i]
+		if finding.ScanType != ScanTypeSCA {
+			continue
+		}
+
+		details, _ := finding.FindingDetails.(map[string]interface{})
+		name := stringField(details, "component_filename")
+		version := stringField(details, "version")
+
+		key := name + "|" + version
+		pos, ok := index[key]
+		if !ok {
+			pos = len(components)
+			index[key] = pos
+			components = append(components, SCAComponent{
+				Name:           name,
+				Version:        version,
+				SeverityCounts: make(map[int]int),
+			})
+		}
+		comp := &components[pos]
+
+		vuln := scaVulnerability(finding, details)
+		comp.Vulnerabilities = append(comp.Vulnerabilities, vuln)
+		comp.Findings = append(comp.Findings, finding)
+		comp.SeverityCounts[vuln.Severity]++
+		if vuln.Severity > comp.MaxSeverity {
+			comp.MaxSeverity = vuln.Severity
+		}
+		if finding.ViolatesPolicy {
+			comp.ViolatesPolicy = true
+		}
+	}
+
+	return components
+}
+
+// scaVulnerability extracts the CVE details from decoded SCA finding details
+func scaVulnerability(finding *Finding, details map[string]interface{}) SCAVulnerability {
+	vuln := SCAVulnerability{IssueID: finding.IssueID, Severity: FindingSeverity(finding)}
+	vuln.CVSS, _ = FindingCVSS(finding)
+
+	cve, ok := details["cve"].(map[string]interface{})
+	if !ok {
+		return vuln
+	}
+	vuln.CVE = stringField(cve, "name")
+	vuln.Href = stringField(cve, "href")
+	return vuln
+}
+
+// stringField returns the string value of key in decoded JSON, or "" if absent
+func stringField(data map[string]interface{}, key string) string {
+	value, _ := data[key].(string)
+	return value
+}
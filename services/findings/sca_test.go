@@ -0,0 +1,91 @@
+package findings
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const scaFindingsResponse = `{
+	"_embedded": {
+		"findings": [
+			{
+				"issue_id": 1,
+				"scan_type": "SCA",
+				"violates_policy": false,
+				"finding_details": {
+					"component_filename": "log4j-core-2.14.1.jar",
+					"version": "2.14.1",
+					"severity": 3,
+					"cve": {"name": "CVE-2021-45046", "cvss": 5.1, "href": "https://nvd.nist.gov/vuln/detail/CVE-2021-45046"}
+				}
+			},
+			{
+				"issue_id": 2,
+				"scan_type": "SCA",
+				"violates_policy": true,
+				"finding_details": {
+					"component_filename": "log4j-core-2.14.1.jar",
+					"version": "2.14.1",
+					"severity": 5,
+					"cve": {"name": "CVE-2021-44228", "cvss": 9.3, "cvss3": {"score": 10.0, "severity": "Critical"}}
+				}
+			},
+			{
+				"issue_id": 3,
+				"scan_type": "SCA",
+				"finding_details": {
+					"component_filename": "jackson-databind-2.9.8.jar",
+					"version": "2.9.8",
+					"severity": 4,
+					"cve": {"name": "CVE-2019-12384", "cvss": 5.9}
+				}
+			}
+		]
+	}
+}`
+
+func TestGroupSCAByComponent(t *testing.T) {
+	var result PagedResourceOfFinding
+	if err := json.Unmarshal([]byte(scaFindingsResponse), &result); err != nil {
+		t.Fatalf("Failed to decode findings: %v", err)
+	}
+
+	components := GroupSCAByComponent(result.Embedded.Findings)
+	if len(components) != 2 {
+		t.Fatalf("Expected 2 components, got %d", len(components))
+	}
+
+	log4j := components[0]
+	if log4j.Name != "log4j-core-2.14.1.jar" || log4j.Version != "2.14.1" {
+		t.Errorf("Unexpected first component %s %s", log4j.Name, log4j.Version)
+	}
+	if len(log4j.Vulnerabilities) != 2 || len(log4j.Findings) != 2 {
+		t.Fatalf("Expected 2 CVEs under log4j, got %d", len(log4j.Vulnerabilities))
+	}
+	if log4j.Vulnerabilities[0].CVE != "CVE-2021-45046" || log4j.Vulnerabilities[0].CVSS != 5.1 {
+		t.Errorf("Unexpected first CVE %+v", log4j.Vulnerabilities[0])
+	}
+	if log4j.Vulnerabilities[1].CVE != "CVE-2021-44228" || log4j.Vulnerabilities[1].CVSS != 10.0 {
+		t.Errorf("Expected CVSS v3 score to take precedence, got %+v", log4j.Vulnerabilities[1])
+	}
+	if log4j.MaxSeverity != SeverityVeryHigh || log4j.SeverityCounts[SeverityMedium] != 1 || log4j.SeverityCounts[SeverityVeryHigh] != 1 {
+		t.Errorf("Unexpected severity summary %d %v", log4j.MaxSeverity, log4j.SeverityCounts)
+	}
+	if !log4j.ViolatesPolicy {
+		t.Error("Expected log4j to violate policy")
+	}
+	if log4j.Findings[1] != &result.Embedded.Findings[1] {
+		t.Error("Expected grouped findings to point into the input slice")
+	}
+
+	if components[1].ViolatesPolicy || len(components[1].Vulnerabilities) != 1 {
+		t.Errorf("Unexpected second component %+v", components[1])
+	}
+}
+
+func TestGroupSCAByComponent_IgnoresNonSCA(t *testing.T) {
+	components := GroupSCAByComponent([]Finding{{IssueID: 1, ScanType: ScanTypeStatic}})
+	if len(components) != 0 {
+		t.Errorf("Expected no components, got %d", len(components))
+	}
+}
@@ -1,10 +1,13 @@
 package findings
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strconv"
+
+	"github.com/dipsylala/veracode-tui/veracode"
 )
 
 const (
@@ -39,57 +42,180 @@ type GetFindingsOptions struct {
 	Page               int      // Page number
 }
 
+// SetSeverityFilter filters on severity (1-5), matching it exactly through Severity or, when
+// andAbove is set, matching it and every higher severity through SeverityGTE. A severity of 0
+// clears both.
+func (o *GetFindingsOptions) SetSeverityFilter(severity int, andAbove bool) {
+	o.Severity = 0
+	o.SeverityGTE = 0
+	if severity <= 0 {
+		return
+	}
+	if andAbove {
+		o.SeverityGTE = severity
+	} else {
+		o.Severity = severity
+	}
+}
+
+// buildFindingsQueryParams builds URL query parameters from options, rejecting a negative page
+// and capping the size at MaxPageSize
+func buildFindingsQueryParams(opts *GetFindingsOptions) (url.Values, error) {
+	if opts == nil {
+		return url.Values{}, nil
+	}
+	if opts.Page < 0 {
+		return nil, fmt.Errorf("page must not be negative, got %d", opts.Page)
+	}
+
+	params := url.Values{}
+	if opts.Context != "" {
+		params.Add("context", opts.Context)
+	}
+	for _, scanType := range opts.ScanType {
+		params.Add("scan_type", scanType)
+	}
+	if opts.Severity > 0 {
+		params.Add("severity", strconv.Itoa(opts.Severity))
+	}
+	if opts.SeverityGTE > 0 {
+		params.Add("severity_gte", strconv.Itoa(opts.SeverityGTE))
+	}
+	if opts.ViolatesPolicy != nil {
+		params.Add("violates_policy", strconv.FormatBool(*opts.ViolatesPolicy))
+	}
+	if opts.IncludeAnnotations {
+		params.Add("include_annot", "true")
+	}
+	if opts.Size > 0 {
+		params.Add("size", strconv.Itoa(min(opts.Size, MaxPageSize)))
+	}
+	if opts.Page > 0 {
+		params.Add("page", strconv.Itoa(opts.Page))
+	}
+
+	return params, nil
+}
+
 // GetFindings retrieves findings for an application
 func (s *Service) GetFindings(applicationGUID string, opts *GetFindingsOptions) (*PagedResourceOfFinding, error) {
 	if applicationGUID == "" {
 		return nil, fmt.Errorf("applicationGUID is required")
 	}
 
-	params := url.Values{}
+	params, err := buildFindingsQueryParams(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	urlPath := fmt.Sprintf("%s/%s/findings", findingsBasePath, applicationGUID)
+	body, err := s.client.DoRequestWithQueryParams("GET", urlPath, params)
+	if err != nil {
+		return nil, err
+	}
 
+	return s.decodeFindingsPage(body)
+}
+
+// MaxPageSize is the largest page size the Findings API accepts. Larger sizes are capped.
+const MaxPageSize = 500
+
+// findingsPageSize is the page size GetAllFindings uses when the options do not set one
+const findingsPageSize = MaxPageSize
+
+// ProgressFunc is called by GetAllFindings after each page with the number of findings fetched so
+// far and the total reported by the API. It runs on the goroutine doing the fetching.
+type ProgressFunc func(fetched, total int)
+
+// report calls the progress callback, if any, taking the total from the page metadata and falling
+// back to fetched when the API does not report one
+func (p ProgressFunc) report(fetched int, page *PageMetadata) {
+	if p == nil {
+		return
+	}
+	total := fetched
+	if page != nil && int(page.TotalElements) > total {
+		total = int(page.TotalElements)
+	}
+	p(fetched, total)
+}
+
+// GetAllFindings retrieves every page of findings matching opts, ignoring opts.Page. An error on any
+// page discards the findings loaded so far. progress may be nil.
+func (s *Service) GetAllFindings(applicationGUID string, opts *GetFindingsOptions, progress ProgressFunc) ([]Finding, error) {
+	pageOpts := GetFindingsOptions{}
 	if opts != nil {
-		if opts.Context != "" {
-			params.Add("context", opts.Context)
-		}
-		for _, scanType := range opts.ScanType {
-			params.Add("scan_type", scanType)
-		}
-		if opts.Severity > 0 {
-			params.Add("severity", strconv.Itoa(opts.Severity))
-		}
-		if opts.SeverityGTE > 0 {
-			params.Add("severity_gte", strconv.Itoa(opts.SeverityGTE))
-		}
-		if opts.ViolatesPolicy != nil {
-			params.Add("violates_policy", strconv.FormatBool(*opts.ViolatesPolicy))
-		}
-		if opts.IncludeAnnotations {
-			params.Add("include_annot", "true")
+		pageOpts = *opts
+	}
+	if pageOpts.Size <= 0 {
+		pageOpts.Size = findingsPageSize
+	}
+
+	all := []Finding{}
+	for page := 0; ; page++ {
+		pageOpts.Page = page
+		result, err := s.GetFindings(applicationGUID, &pageOpts)
+		if err != nil {
+			return nil, err
 		}
-		if opts.Size > 0 {
-			params.Add("size", strconv.Itoa(opts.Size))
+		if result.Embedded == nil || len(result.Embedded.Findings) == 0 {
+			return all, nil
 		}
-		if opts.Page > 0 {
-			params.Add("page", strconv.Itoa(opts.Page))
+		all = append(all, result.Embedded.Findings...)
+		progress.report(len(all), result.Page)
+		if result.Page == nil || int64(page+1) >= result.Page.TotalPages {
+			return all, nil
 		}
 	}
+}
+
+// getFlawInfo requests a static_flaw_info or dynamic_flaw_info path, qualified by context when set.
+//
+// NOTE: API bug - these endpoints can return 404 when the context parameter is provided for
+// sandbox findings ("Build does not have static flaws"), even though the Swaggerhub API
+// definition documents the parameter as supported. The flaw data is the same regardless of
+// context (same flaw ID), so a 404 on the context-qualified request is retried once without it.
+func (s *Service) getFlawInfo(urlPath, context string) ([]byte, error) {
+	params := url.Values{}
+	if context != "" {
+		params.Add("context", context)
+	}
 
-	urlPath := fmt.Sprintf("%s/%s/findings", findingsBasePath, applicationGUID)
 	body, err := s.client.DoRequestWithQueryParams("GET", urlPath, params)
+
+	var httpErr *veracode.HTTPError
+	if err != nil && context != "" && errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+		body, err = s.client.DoRequestWithQueryParams("GET", urlPath, url.Values{})
+	}
+	return body, err
+}
+
+// GetStaticFlawInfo retrieves detailed data path information for a static flaw. If the
+// context-qualified request returns 404, it is retried once without the context.
+func (s *Service) GetStaticFlawInfo(applicationGUID string, issueID int64, context string) (*StaticFlawInfo, error) {
+	if applicationGUID == "" {
+		return nil, fmt.Errorf("applicationGUID is required")
+	}
+	if issueID == 0 {
+		return nil, fmt.Errorf("issueID is required")
+	}
+
+	urlPath := fmt.Sprintf("%s/%s/findings/%d/static_flaw_info", findingsBasePath, applicationGUID, issueID)
+	body, err := s.getFlawInfo(urlPath, context)
 	if err != nil {
 		return nil, err
 	}
 
-	var result PagedResourceOfFinding
-	if err := json.Unmarshal(body, &result); err != nil {
-		return nil, fmt.Errorf("failed to parse findings response: %w", err)
+	var result StaticFlawInfo
+	if err := veracode.DecodeJSON(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse static flaw info response: %w", err)
 	}
 
 	return &result, nil
 }
 
-// GetStaticFlawInfo retrieves detailed data path information for a static flaw
-func (s *Service) GetStaticFlawInfo(applicationGUID string, issueID int64, context string) (*StaticFlawInfo, error) {
+// GetDynamicFlawInfo retrieves the request/response details for a dynamic flaw
+func (s *Service) GetDynamicFlawInfo(applicationGUID string, issueID int64, context string) (*DynamicFlawInfo, error) {
 	if applicationGUID == "" {
 		return nil, fmt.Errorf("applicationGUID is required")
 	}
@@ -97,20 +223,15 @@ func (s *Service) GetStaticFlawInfo(applicationGUID string, issueID int64, conte
 		return nil, fmt.Errorf("issueID is required")
 	}
 
-	params := url.Values{}
-	if context != "" {
-		params.Add("context", context)
-	}
-
-	urlPath := fmt.Sprintf("%s/%s/findings/%d/static_flaw_info", findingsBasePath, applicationGUID, issueID)
-	body, err := s.client.DoRequestWithQueryParams("GET", urlPath, params)
+	urlPath := fmt.Sprintf("%s/%s/findings/%d/dynamic_flaw_info", findingsBasePath, applicationGUID, issueID)
+	body, err := s.getFlawInfo(urlPath, context)
 	if err != nil {
 		return nil, err
 	}
 
-	var result StaticFlawInfo
-	if err := json.Unmarshal(body, &result); err != nil {
-		return nil, fmt.Errorf("failed to parse static flaw info response: %w", err)
+	var result DynamicFlawInfo
+	if err := veracode.DecodeJSON(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse dynamic flaw info response: %w", err)
 	}
 
 	return &result, nil
@@ -312,7 +312,7 @@ func TestGetFindings(t *testing.T) {
 	// Issue: When fetching data paths for a finding in a sandbox context, the API returns:
 	//   HTTP 404: "Build does not have static flaws"
 	//
-	// Workaround: Omit the 'context' parameter when calling static_flaw_info endpoint.
+	// Workaround: GetStaticFlawInfo retries once without the 'context' parameter on 404.
 	// The endpoint returns the correct data without the context filter.
 	//
 	// Test case uses:
@@ -0,0 +1,51 @@
+package findings
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestSetSeverityFilter_QueryParams(t *testing.T) {
+	tests := []struct {
+		name     string
+		severity int
+		andAbove bool
+		wantKey  string
+		otherKey string
+	}{
+		{"and above", 4, true, "severity_gte", "severity"},
+		{"exact", 4, false, "severity", "severity_gte"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got url.Values
+			client := &MockHTTPClient{
+				DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+					got = params
+					return []byte("{}"), nil
+				},
+			}
+
+			opts := &GetFindingsOptions{}
+			opts.SetSeverityFilter(tt.severity, tt.andAbove)
+			if _, err := NewService(client).GetFindings("app-guid", opts); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if got.Get(tt.wantKey) != "4" {
+				t.Errorf("Expected %s=4, got %q", tt.wantKey, got.Encode())
+			}
+			if got.Has(tt.otherKey) {
+				t.Errorf("Expected no %s parameter, got %q", tt.otherKey, got.Encode())
+			}
+		})
+	}
+}
+
+func TestSetSeverityFilter_ZeroClears(t *testing.T) {
+	opts := &GetFindingsOptions{Severity: 3, SeverityGTE: 2}
+	opts.SetSeverityFilter(0, true)
+	if opts.Severity != 0 || opts.SeverityGTE != 0 {
+		t.Errorf("Expected both severity fields cleared, got %+v", opts)
+	}
+}
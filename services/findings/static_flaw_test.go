@@ -0,0 +1,58 @@
+package findings
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/dipsylala/veracode-tui/veracode"
+)
+
+const staticFlawInfoResponse = `{
+	"issue_summary": {"app_guid": "app-guid", "name": "SQL Injection", "issue_id": 134},
+	"data_paths": [
+		{"module_name": "app.jar", "steps": 2, "function_name": "query", "line_number": 27}
+	]
+}`
+
+func TestGetStaticFlawInfo_RetriesWithoutContextOn404(t *testing.T) {
+	var contexts []string
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			contexts = append(contexts, params.Get("context"))
+			if params.Get("context") != "" {
+				return nil, &veracode.HTTPError{StatusCode: 404, Status: "404 Not Found", Body: []byte("Build does not have static flaws")}
+			}
+			return []byte(staticFlawInfoResponse), nil
+		},
+	}
+
+	service := NewService(client)
+	info, err := service.GetStaticFlawInfo("app-guid", 134, "sandbox-guid")
+	if err != nil {
+		t.Fatalf("Expected fallback without context to succeed, got %v", err)
+	}
+	if len(contexts) != 2 || contexts[0] != "sandbox-guid" || contexts[1] != "" {
+		t.Errorf("Expected a context request then one without, got %q", contexts)
+	}
+	if len(info.DataPaths) != 1 || info.DataPaths[0].FunctionName != "query" {
+		t.Error("Expected data paths to be parsed")
+	}
+}
+
+func TestGetStaticFlawInfo_NoRetryOnOtherErrors(t *testing.T) {
+	calls := 0
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			calls++
+			return nil, &veracode.HTTPError{StatusCode: 500, Status: "500 Internal Server Error"}
+		},
+	}
+
+	service := NewService(client)
+	if _, err := service.GetStaticFlawInfo("app-guid", 134, "sandbox-guid"); err == nil {
+		t.Fatal("Expected error for 500, got nil")
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 request, got %d", calls)
+	}
+}
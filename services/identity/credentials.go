@@ -0,0 +1,22 @@
+package identity
+
+import "time"
+
+// DaysUntilExpiry returns the number of whole days from now until the credentials expire, which is
+// negative once they have expired. It returns false when no expiry date is known.
+func (c *APICredentials) DaysUntilExpiry(now time.Time) (int, bool) {
+	if c == nil || c.ExpirationTS.IsZero() {
+		return 0, false
+	}
+	remaining := c.ExpirationTS.Sub(now)
+	days := int(remaining / (24 * time.Hour))
+	if remaining < 0 && remaining%(24*time.Hour) != 0 {
+		days-- // Round towards the past, so credentials that expired an hour ago are -1 days away
+	}
+	return days, true
+}
+
+// IsRevoked reports whether the credentials have been revoked
+func (c *APICredentials) IsRevoked() bool {
+	return c != nil && !c.RevocationTS.IsZero()
+}
@@ -0,0 +1,54 @@
+package identity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDaysUntilExpiry(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		expiration time.Time
+		want       int
+	}{
+		{"far future", now.AddDate(0, 6, 0), 183},
+		{"within a fortnight", now.Add(10*24*time.Hour + time.Hour), 10},
+		{"later today", now.Add(3 * time.Hour), 0},
+		{"expired an hour ago", now.Add(-time.Hour), -1},
+		{"expired last month", now.AddDate(0, -1, 0), -31},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			creds := &APICredentials{ExpirationTS: tt.expiration}
+			days, ok := creds.DaysUntilExpiry(now)
+			if !ok {
+				t.Fatal("Expected an expiry date to be known")
+			}
+			if days != tt.want {
+				t.Errorf("DaysUntilExpiry() = %d, want %d", days, tt.want)
+			}
+		})
+	}
+}
+
+func TestDaysUntilExpiry_Unknown(t *testing.T) {
+	if _, ok := (&APICredentials{}).DaysUntilExpiry(time.Now()); ok {
+		t.Error("Expected no expiry for credentials without an expiration date")
+	}
+	var creds *APICredentials
+	if _, ok := creds.DaysUntilExpiry(time.Now()); ok {
+		t.Error("Expected no expiry for nil credentials")
+	}
+}
+
+func TestIsRevoked(t *testing.T) {
+	if (&APICredentials{}).IsRevoked() {
+		t.Error("Expected credentials without a revocation date not to be revoked")
+	}
+	if !(&APICredentials{RevocationTS: time.Now()}).IsRevoked() {
+		t.Error("Expected credentials with a revocation date to be revoked")
+	}
+}
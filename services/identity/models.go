@@ -33,3 +33,43 @@ type APICredentials struct {
 	RevocationUser string    `json:"revocation_user,omitempty"`
 	UserID         string    `json:"user_id,omitempty"`
 }
+
+// PageMetadata contains pagination information
+type PageMetadata struct {
+	Number        int `json:"number"`
+	Size          int `json:"size"`
+	TotalElements int `json:"total_elements"`
+	TotalPages    int `json:"total_pages"`
+}
+
+// Team represents a team in the organization
+type Team struct {
+	TeamID       string        `json:"team_id"`
+	TeamLegacyID int           `json:"team_legacy_id,omitempty"`
+	TeamName     string        `json:"team_name"`
+	BusinessUnit *BusinessUnit `json:"business_unit,omitempty"`
+}
+
+// PagedResourceOfTeam represents a paged response of teams
+type PagedResourceOfTeam struct {
+	Embedded *struct {
+		Teams []Team `json:"teams"`
+	} `json:"_embedded,omitempty"`
+	Page *PageMetadata `json:"page,omitempty"`
+}
+
+// BusinessUnit represents a business unit in the organization
+type BusinessUnit struct {
+	BUID       string `json:"bu_id"`
+	BULegacyID int    `json:"bu_legacy_id,omitempty"`
+	BUName     string `json:"bu_name"`
+	IsDefault  bool   `json:"is_default,omitempty"`
+}
+
+// PagedResourceOfBusinessUnit represents a paged response of business units
+type PagedResourceOfBusinessUnit struct {
+	Embedded *struct {
+		BusinessUnits []BusinessUnit `json:"business_units"`
+	} `json:"_embedded,omitempty"`
+	Page *PageMetadata `json:"page,omitempty"`
+}
@@ -5,21 +5,32 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
-
-	"github.com/dipsylala/veracode-tui/veracode"
+	"strconv"
 )
 
 // Service provides access to the Veracode Identity API
 type Service struct {
-	client *veracode.Client
+	client HTTPClient
+}
+
+// HTTPClient interface for making HTTP requests
+type HTTPClient interface {
+	DoRequestWithQueryParams(method, urlPath string, params url.Values) ([]byte, error)
+	HealthCheck() error
 }
 
-func NewService(client *veracode.Client) *Service {
+func NewService(client HTTPClient) *Service {
 	return &Service{
 		client: client,
 	}
 }
 
+// HealthCheck verifies that the Veracode authentication services are reachable
+// and that the configured credentials are accepted
+func (s *Service) HealthCheck() error {
+	return s.client.HealthCheck()
+}
+
 // GetPrincipal retrieves the current API user's principal information
 func (s *Service) GetPrincipal(ctx context.Context) (*Principal, error) {
 	body, err := s.client.DoRequestWithQueryParams("GET", "/api/authn/v2/principal", url.Values{})
@@ -35,6 +46,26 @@ func (s *Service) GetPrincipal(ctx context.Context) (*Principal, error) {
 	return &principal, nil
 }
 
+// Validate checks the configured credentials without the TUI: it runs the health check and then
+// looks up the API user, returning the principal on success. The error names the step that failed.
+func (s *Service) Validate(ctx context.Context) (*Principal, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if err := s.HealthCheck(); err != nil {
+		return nil, fmt.Errorf("health check failed: %w", err)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	principal, err := s.GetPrincipal(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("health check passed, but looking up the API user failed: %w", err)
+	}
+	return principal, nil
+}
+
 // GetAPICredentials retrieves the current user's API credentials (without the secret)
 func (s *Service) GetAPICredentials(ctx context.Context) (*APICredentials, error) {
 	body, err := s.client.DoRequestWithQueryParams("GET", "/api/authn/v2/api_credentials", url.Values{})
@@ -49,3 +80,68 @@ func (s *Service) GetAPICredentials(ctx context.Context) (*APICredentials, error
 
 	return &creds, nil
 }
+
+// identityPageSize is the page size used when listing teams and business units
+const identityPageSize = 100
+
+// GetTeams retrieves all teams in the organization
+func (s *Service) GetTeams(ctx context.Context) ([]Team, error) {
+	teams := []Team{}
+	params := url.Values{}
+	params.Add("all_for_org", "true")
+
+	err := s.getAllPages("/api/authn/v2/teams", params, func(body []byte) (*PageMetadata, error) {
+		var result PagedResourceOfTeam
+		if err := json.Unmarshal(body, &result); err != nil {
+			return nil, err
+		}
+		if result.Embedded != nil {
+			teams = append(teams, result.Embedded.Teams...)
+		}
+		return result.Page, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return teams, nil
+}
+
+// GetBusinessUnits retrieves all business units in the organization
+func (s *Service) GetBusinessUnits(ctx context.Context) ([]BusinessUnit, error) {
+	units := []BusinessUnit{}
+	err := s.getAllPages("/api/authn/v2/business_units", url.Values{}, func(body []byte) (*PageMetadata, error) {
+		var result PagedResourceOfBusinessUnit
+		if err := json.Unmarshal(body, &result); err != nil {
+			return nil, err
+		}
+		if result.Embedded != nil {
+			units = append(units, result.Embedded.BusinessUnits...)
+		}
+		return result.Page, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return units, nil
+}
+
+// getAllPages requests every page of a paged identity endpoint, passing each response body to decode
+func (s *Service) getAllPages(urlPath string, params url.Values, decode func(body []byte) (*PageMetadata, error)) error {
+	for page := 0; ; page++ {
+		params.Set("page", strconv.Itoa(page))
+		params.Set("size", strconv.Itoa(identityPageSize))
+
+		body, err := s.client.DoRequestWithQueryParams("GET", urlPath, params)
+		if err != nil {
+			return fmt.Errorf("executing request: %w", err)
+		}
+
+		pageInfo, err := decode(body)
+		if err != nil {
+			return fmt.Errorf("decoding response: %w", err)
+		}
+		if pageInfo == nil || page >= pageInfo.TotalPages-1 {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package identity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// MockHTTPClient is a mock implementation of HTTPClient for testing
+type MockHTTPClient struct {
+	DoRequestWithQueryParamsFunc func(method, urlPath string, params url.Values) ([]byte, error)
+	HealthCheckFunc              func() error
+}
+
+func (m *MockHTTPClient) DoRequestWithQueryParams(method, urlPath string, params url.Values) ([]byte, error) {
+	if m.DoRequestWithQueryParamsFunc != nil {
+		return m.DoRequestWithQueryParamsFunc(method, urlPath, params)
+	}
+	return []byte("{}"), nil
+}
+
+func (m *MockHTTPClient) HealthCheck() error {
+	if m.HealthCheckFunc != nil {
+		return m.HealthCheckFunc()
+	}
+	return nil
+}
+
+func TestGetPrincipal_Success(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			if method != "GET" {
+				t.Errorf("Expected GET method, got %s", method)
+			}
+			if urlPath != "/api/authn/v2/principal" {
+				t.Errorf("Expected principal URL path, got %s", urlPath)
+			}
+			return []byte(`{"username": "jdoe", "organizationName": "Example Corp", "userId": 42, "roles": ["Security Lead"]}`), nil
+		},
+	}
+
+	service := NewService(client)
+	principal, err := service.GetPrincipal(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if principal.Username != "jdoe" || principal.OrganizationName != "Example Corp" || principal.UserID != 42 {
+		t.Errorf("Unexpected principal %+v", principal)
+	}
+	if len(principal.Roles) != 1 || principal.Roles[0] != "Security Lead" {
+		t.Errorf("Expected roles to be decoded, got %v", principal.Roles)
+	}
+}
+
+func TestGetPrincipal_DecodeError(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			return []byte(`not json`), nil
+		},
+	}
+
+	service := NewService(client)
+	if _, err := service.GetPrincipal(context.Background()); err == nil {
+		t.Error("Expected decode error")
+	}
+}
+
+func TestGetPrincipal_RequestError(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			return nil, fmt.Errorf("HTTP 401: unauthorized")
+		},
+	}
+
+	service := NewService(client)
+	if _, err := service.GetPrincipal(context.Background()); err == nil {
+		t.Error("Expected request error")
+	}
+}
+
+func TestGetAPICredentials_Success(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			if urlPath != "/api/authn/v2/api_credentials" {
+				t.Errorf("Expected api_credentials URL path, got %s", urlPath)
+			}
+			return []byte(`{"api_id": "abc123", "expiration_ts": "2026-12-31T23:59:59Z"}`), nil
+		},
+	}
+
+	service := NewService(client)
+	creds, err := service.GetAPICredentials(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if creds.APIID != "abc123" {
+		t.Errorf("Expected api_id abc123, got %s", creds.APIID)
+	}
+	if creds.ExpirationTS.Year() != 2026 {
+		t.Errorf("Expected expiration to be decoded, got %v", creds.ExpirationTS)
+	}
+}
+
+func TestGetAPICredentials_DecodeError(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			return []byte(`{"api_id": 123}`), nil
+		},
+	}
+
+	service := NewService(client)
+	if _, err := service.GetAPICredentials(context.Background()); err == nil {
+		t.Error("Expected decode error")
+	}
+}
+
+func TestHealthCheck_DelegatesToClient(t *testing.T) {
+	client := &MockHTTPClient{
+		HealthCheckFunc: func() error {
+			return fmt.Errorf("service unavailable")
+		},
+	}
+
+	service := NewService(client)
+	if err := service.HealthCheck(); err == nil {
+		t.Error("Expected health check error from client")
+	}
+}
+
+func TestValidate_Success(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			return []byte(`{"email": "jdoe@example.com", "organizationName": "Example Corp"}`), nil
+		},
+	}
+
+	service := NewService(client)
+	principal, err := service.Validate(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if principal.Email != "jdoe@example.com" || principal.OrganizationName != "Example Corp" {
+		t.Errorf("Unexpected principal %+v", principal)
+	}
+}
+
+func TestValidate_ReportsFailedStep(t *testing.T) {
+	principalRequested := false
+	client := &MockHTTPClient{
+		HealthCheckFunc: func() error {
+			return fmt.Errorf("service unavailable")
+		},
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			principalRequested = true
+			return []byte(`{}`), nil
+		},
+	}
+
+	_, err := NewService(client).Validate(context.Background())
+	if err == nil || !strings.HasPrefix(err.Error(), "health check failed") {
+		t.Errorf("Expected a health check error, got %v", err)
+	}
+	if principalRequested {
+		t.Error("Expected the principal not to be requested after a failed health check")
+	}
+
+	client = &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			return nil, fmt.Errorf("forbidden")
+		},
+	}
+	_, err = NewService(client).Validate(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "looking up the API user failed") {
+		t.Errorf("Expected a principal lookup error, got %v", err)
+	}
+}
+
+func TestValidate_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := NewService(&MockHTTPClient{}).Validate(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
@@ -0,0 +1,90 @@
+package identity
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+)
+
+func TestGetTeams_FollowsPages(t *testing.T) {
+	responses := map[string]string{
+		"0": `{"_embedded": {"teams": [{"team_id": "t-1", "team_name": "Payments", "business_unit": {"bu_id": "bu-1", "bu_name": "Finance"}}]}, "page": {"number": 0, "total_pages": 2, "total_elements": 2}}`,
+		"1": `{"_embedded": {"teams": [{"team_id": "t-2", "team_name": "Platform"}]}, "page": {"number": 1, "total_pages": 2, "total_elements": 2}}`,
+	}
+	calls := 0
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			calls++
+			if urlPath != "/api/authn/v2/teams" {
+				t.Errorf("Expected teams URL path, got %s", urlPath)
+			}
+			if params.Get("all_for_org") != "true" {
+				t.Errorf("Expected all_for_org=true, got %s", params.Get("all_for_org"))
+			}
+			return []byte(responses[params.Get("page")]), nil
+		},
+	}
+
+	teams, err := NewService(client).GetTeams(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 requests, got %d", calls)
+	}
+	if len(teams) != 2 || teams[0].TeamName != "Payments" || teams[1].TeamName != "Platform" {
+		t.Fatalf("Unexpected teams %+v", teams)
+	}
+	if teams[0].BusinessUnit == nil || teams[0].BusinessUnit.BUName != "Finance" {
+		t.Errorf("Expected business unit to be decoded, got %+v", teams[0].BusinessUnit)
+	}
+}
+
+func TestGetTeams_Errors(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			return nil, fmt.Errorf("HTTP 403: forbidden")
+		},
+	}
+	if _, err := NewService(client).GetTeams(context.Background()); err == nil {
+		t.Error("Expected request error")
+	}
+
+	client.DoRequestWithQueryParamsFunc = func(method, urlPath string, params url.Values) ([]byte, error) {
+		return []byte(`{"_embedded": {"teams": "oops"}}`), nil
+	}
+	if _, err := NewService(client).GetTeams(context.Background()); err == nil {
+		t.Error("Expected decode error")
+	}
+}
+
+func TestGetBusinessUnits_Success(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			if urlPath != "/api/authn/v2/business_units" {
+				t.Errorf("Expected business_units URL path, got %s", urlPath)
+			}
+			return []byte(`{"_embedded": {"business_units": [{"bu_id": "bu-1", "bu_name": "Finance", "is_default": true}]}, "page": {"total_pages": 1}}`), nil
+		},
+	}
+
+	units, err := NewService(client).GetBusinessUnits(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(units) != 1 || units[0].BUName != "Finance" || !units[0].IsDefault {
+		t.Errorf("Unexpected business units %+v", units)
+	}
+}
+
+func TestGetBusinessUnits_DecodeError(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			return []byte(`not json`), nil
+		},
+	}
+	if _, err := NewService(client).GetBusinessUnits(context.Background()); err == nil {
+		t.Error("Expected decode error")
+	}
+}
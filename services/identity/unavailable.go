@@ -0,0 +1,34 @@
+package identity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dipsylala/veracode-tui/veracode"
+)
+
+// UnavailableMessage describes why an identity lookup failed, for display in place of the identity
+// details. A lack of permission, usually a missing identity role, is told apart from the identity
+// service failing or being unreachable.
+func UnavailableMessage(err error) string {
+	if veracode.IsForbidden(err) {
+		return "Identity unavailable - the API credentials lack the identity role"
+	}
+	var httpErr *veracode.HTTPError
+	if errors.As(err, &httpErr) {
+		return fmt.Sprintf("Identity unavailable - the identity service returned HTTP %d", httpErr.StatusCode)
+	}
+	return "Identity unavailable - could not reach the identity service"
+}
+
+// TryGetPrincipal looks up the API user for callers that can carry on without it. On failure the
+// error is logged as a warning and the principal is nil, with UnavailableMessage describing why.
+func (s *Service) TryGetPrincipal(ctx context.Context) (principal *Principal, unavailable string) {
+	principal, err := s.GetPrincipal(ctx)
+	if err != nil {
+		veracode.Warnf(s.client, "Identity unavailable, continuing without the API user: %v", err)
+		return nil, UnavailableMessage(err)
+	}
+	return principal, ""
+}
@@ -0,0 +1,58 @@
+package identity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/dipsylala/veracode-tui/veracode"
+)
+
+func TestTryGetPrincipal(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		wantMessage string
+	}{
+		{"permission denied", &veracode.HTTPError{StatusCode: 403, Status: "403 Forbidden"}, "lack the identity role"},
+		{"server error", &veracode.HTTPError{StatusCode: 503, Status: "503 Service Unavailable"}, "returned HTTP 503"},
+		{"connectivity", errors.New("dial tcp: connection refused"), "could not reach the identity service"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &MockHTTPClient{
+				DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+					return nil, fmt.Errorf("request failed: %w", tt.err)
+				},
+			}
+
+			principal, unavailable := NewService(client).TryGetPrincipal(context.Background())
+			if principal != nil {
+				t.Errorf("Expected no principal, got %+v", principal)
+			}
+			if !strings.Contains(unavailable, tt.wantMessage) {
+				t.Errorf("Expected message containing %q, got %q", tt.wantMessage, unavailable)
+			}
+		})
+	}
+}
+
+func TestTryGetPrincipal_Success(t *testing.T) {
+	client := &MockHTTPClient{
+		DoRequestWithQueryParamsFunc: func(method, urlPath string, params url.Values) ([]byte, error) {
+			return []byte(`{"username": "jdoe"}`), nil
+		},
+	}
+
+	principal, unavailable := NewService(client).TryGetPrincipal(context.Background())
+	if principal == nil || principal.Username != "jdoe" {
+		t.Errorf("Expected principal jdoe, got %+v", principal)
+	}
+	if unavailable != "" {
+		t.Errorf("Expected no unavailable message, got %q", unavailable)
+	}
+}
@@ -0,0 +1,95 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dipsylala/veracode-tui/services/findings"
+	"github.com/rivo/tview"
+)
+
+// timelineCommentLimit is the number of characters of a comment shown before it is truncated
+const timelineCommentLimit = 120
+
+// renderAnnotationTimeline renders annotations oldest first, one entry per line as
+// "date — user — ACTION: comment". Long comments are truncated unless the timeline is expanded.
+func (ui *UI) renderAnnotationTimeline(annotations []findings.Annotation) string {
+	sorted := make([]findings.Annotation, len(annotations))
+	copy(sorted, annotations)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		dateI, dateJ := annotationDate(&sorted[i]), annotationDate(&sorted[j])
+		if dateI == nil || dateJ == nil {
+			return dateI != nil
+		}
+		return dateI.Before(*dateJ)
+	})
+
+	var sb strings.Builder
+	truncated := false
+	for i := range sorted {
+		annotation := &sorted[i]
+
+		date := "unknown date"
+		if created := annotationDate(annotation); created != nil {
+			date = created.Format("2006-01-02 15:04")
+		}
+
+		user := annotation.UserName
+		if user == "" {
+			user = annotation.User
+		}
+		if user == "" {
+			user = "unknown user"
+		}
+
+		comment := annotation.Comment
+		if !ui.annotationTimelineExpanded && len([]rune(comment)) > timelineCommentLimit {
+			comment = string([]rune(comment)[:timelineCommentLimit]) + "…"
+			truncated = true
+		}
+
+		sb.WriteString(fmt.Sprintf("[%s]%s[-] — [white]%s[-] — [%s]%s[-]", ui.theme.SecondaryText, date, tview.Escape(user),
+			ui.annotationActionColor(annotation.Action), valueOrDash(annotation.Action)))
+		if comment != "" {
+			sb.WriteString(fmt.Sprintf(": [white]%s[-]", tview.Escape(comment)))
+		}
+		sb.WriteString("\n")
+	}
+
+	if truncated {
+		sb.WriteString(fmt.Sprintf("\n[%s]Press x to show full comments[-]\n", ui.theme.SecondaryText))
+	}
+	return sb.String()
+}
+
+// annotationDate returns when an annotation was made, preferring Created from the API over the legacy Date
+func annotationDate(annotation *findings.Annotation) *time.Time {
+	if annotation.Created != nil {
+		return annotation.Created
+	}
+	return annotation.Date
+}
+
+// annotationActionColor colors approvals, rejections and proposed mitigations; comments use the default text color
+func (ui *UI) annotationActionColor(action string) string {
+	switch {
+	case action == "ACCEPTED" || action == "APPROVED":
+		return ui.theme.Approved
+	case action == "REJECTED":
+		return ui.theme.Rejected
+	case ui.isApprovableAction(action):
+		return ui.theme.Pending
+	default:
+		return "white"
+	}
+}
+
+// toggleAnnotationTimeline expands or truncates long comments in the finding's annotation timeline
+func (ui *UI) toggleAnnotationTimeline(finding *findings.Finding) {
+	ui.annotationTimelineExpanded = !ui.annotationTimelineExpanded
+	if ui.findingAnnotationsView != nil {
+		ui.findingAnnotationsView.SetText(ui.buildAnnotationsContent(finding))
+	}
+}
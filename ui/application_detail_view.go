@@ -3,8 +3,10 @@ package ui
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/dipsylala/veracode-tui/services/applications"
+	"github.com/dipsylala/veracode-tui/services/findings"
 	"github.com/dipsylala/veracode-tui/veracode"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -15,23 +17,27 @@ func (ui *UI) showApplicationDetail() {
 	if ui.selectedApp == nil {
 		return
 	}
+	ui.pushRecent(ui.selectedApp)
+	ui.rememberApplicationSelection()
 
 	// Initialize views if first time
 	if ui.appInfoView == nil {
 		ui.initializeApplicationDetailViews()
 	}
 
-	// Reset selection index to policy context
+	// Reset selection index to policy context and stop watching any previous application
 	ui.selectionIndex = -1
+	ui.stopScanWatch()
+	ui.stopContextFindingCounts()
+	ui.detailStatusBar.SetText("")
 
-	// Clear previous sandboxes immediately
-	ui.sandboxes = []applications.Sandbox{}
+	// Clear previous sandboxes and scan details immediately (nil until the sandboxes have loaded)
+	ui.sandboxes = nil
+	ui.scanDetails = nil
+	ui.policyViolations = nil
 
 	// Get application name for title
-	appName := DefaultApplicationName
-	if ui.selectedApp.Profile != nil {
-		appName = ui.selectedApp.Profile.Name
-	}
+	appName := applicationDisplayName(ui.selectedApp)
 
 	// Create title view
 	titleView := tview.NewTextView().
@@ -56,11 +62,12 @@ func (ui *UI) showApplicationDetail() {
 	shortcutsBar := tview.NewTextView().
 		SetDynamicColors(true).
 		SetTextAlign(tview.AlignCenter).
-		SetText(fmt.Sprintf("[%s]↑/↓[-] Navigate  [%s]Enter/Double-click[-] View Findings  [%s]ESC[-] Back  [%s]q[-] Quit",
-			ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info))
+		SetText(fmt.Sprintf("[%s]↑/↓[-] Navigate  [%s]Enter/Double-click[-] View Findings  [%s]S[-] All Sandboxes  [%s]w[-] Watch Scans  [%s]F5/Ctrl-R[-] Refresh  [%s]ESC[-] Back  [%s]q[-] Quit",
+			ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info))
 	shortcutsBar.SetBorder(false)
 
-	ui.detailFlex.AddItem(shortcutsBar, 1, 0, false)
+	ui.detailFlex.AddItem(ui.detailStatusBar, 1, 0, false).
+		AddItem(shortcutsBar, 1, 0, false)
 
 	// Set up input handlers
 	ui.setupApplicationDetailInputHandlers()
@@ -70,32 +77,88 @@ func (ui *UI) showApplicationDetail() {
 
 	// Fetch full application details to get all scans
 	go func() {
-		fullApp, err := ui.appService.GetApplication(ui.selectedApp.GUID)
+		fullApp, err := ui.appService.GetApplicationCached(ui.selectedApp.GUID)
 		if err == nil && fullApp != nil {
 			// Update the selected app with full details
 			ui.selectedApp = fullApp
 
 			// Refresh the views with complete data
-			ui.app.QueueUpdateDraw(func() {
+			ui.queueUpdateDraw(func() {
 				ui.updateApplicationDetailViews()
 			})
 		}
 	}()
 
-	// Load sandboxes for this application
+	// Fetch scan details (GUIDs and published dates) from the scans endpoint
 	go func() {
-		result, err := ui.appService.GetSandboxes(ui.selectedApp.GUID, &applications.GetSandboxesOptions{
+		result, err := ui.appService.GetScans(ui.selectedApp.GUID, &applications.GetScansOptions{
 			Size: 100,
 		})
-		if err == nil && result.Embedded != nil {
-			ui.sandboxes = result.Embedded.Sandboxes
+		if err != nil || result.Embedded == nil {
+			return
+		}
+
+		details := latestScansByType(result.Embedded.Scans)
+		ui.queueUpdateDraw(func() {
+			ui.scanDetails = details
+			ui.updateApplicationDetailViews()
+		})
+	}()
+
+	// Fetch policy-violating findings in the policy context for the compliance summary
+	go func() {
+		appGUID := ui.selectedApp.GUID
+		violatesPolicy := true
+		result, err := ui.findingsService.GetFindings(appGUID, &findings.GetFindingsOptions{
+			ViolatesPolicy: &violatesPolicy,
+			Size:           500,
+		})
+		if err != nil {
+			return
+		}
+
+		violations := []findings.Finding{}
+		if result.Embedded != nil {
+			violations = result.Embedded.Findings
+		}
+		ui.queueUpdateDraw(func() {
+			if ui.selectedApp == nil || ui.selectedApp.GUID != appGUID {
+				return
+			}
+			ui.policyViolations = violations
+			ui.updateApplicationDetailViews()
+		})
+	}()
+
+	// Load every page of sandboxes for this application
+	go func() {
+		appGUID := ui.selectedApp.GUID
+		progressShown := false
+		sandboxes, err := ui.appService.GetAllSandboxes(appGUID, func(fetched, total int) {
+			// Progress is only worth showing while further pages remain
+			if fetched < total {
+				progressShown = true
+				ui.queueUpdateDraw(func() {
+					ui.setSandboxesProgress(appGUID, fmt.Sprintf("[%s]Fetched %d/%d sandboxes...[-]", ui.theme.Pending, fetched, total))
+				})
+			}
+		})
+		if err == nil {
+			ui.sandboxes = sandboxes
 		} else {
 			ui.sandboxes = []applications.Sandbox{}
 		}
 
-		// Refresh the contexts table with sandbox data
-		ui.app.QueueUpdateDraw(func() {
+		// Refresh the contexts table, and the sandbox list if it was opened while loading
+		ui.queueUpdateDraw(func() {
+			if progressShown {
+				ui.setSandboxesProgress(appGUID, "")
+			}
 			ui.updateContextsTable()
+			ui.loadContextFindingCounts()
+			if frontPage, _ := ui.pages.GetFrontPage(); frontPage == sandboxesPageName {
+				ui.showSandboxes()
+			}
 		})
 	}()
 
@@ -142,21 +205,38 @@ func (ui *UI) initializeApplicationDetailViews() {
 	ui.contextsTable.SetSelectedStyle(tcell.StyleDefault.
 		Background(tcell.GetColor(ui.theme.SelectionBackground)).
 		Foreground(tcell.GetColor(ui.theme.SelectionForeground)))
+
+	ui.createDetailStatusBar()
 }
 
 // setupApplicationDetailInputHandlers configures keyboard input for application detail view
 func (ui *UI) setupApplicationDetailInputHandlers() {
 	ui.detailFlex.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if isRefreshKey(event) {
+			ui.refreshApplicationDetail()
+			return nil
+		}
 		switch event.Key() {
 		case tcell.KeyEscape:
-			// Clear selected application when returning to list
+			// Stop any scan watch and count loading and clear selected application when returning to list
+			ui.stopScanWatch()
+			ui.stopContextFindingCounts()
 			ui.selectedApp = nil
 			ui.pages.SwitchToPage("applications")
+			ui.restoreSelection(ui.lastSelectedAppGUID)
 			ui.app.SetFocus(ui.applicationsTable)
 			return nil
 		case tcell.KeyRune:
 			if event.Rune() == 'q' {
-				ui.app.Stop()
+				ui.stop()
+				return nil
+			}
+			if event.Rune() == 'w' {
+				ui.toggleScanWatch()
+				return nil
+			}
+			if event.Rune() == 'S' {
+				ui.showSandboxes()
 				return nil
 			}
 		}
@@ -247,8 +327,8 @@ func (ui *UI) updateApplicationDetailViews() {
 		shortcutsBar := tview.NewTextView().
 			SetDynamicColors(true).
 			SetTextAlign(tview.AlignCenter).
-			SetText(fmt.Sprintf("[%s]↑/↓[-] Navigate  [%s]Enter/Double-click[-] View Findings  [%s]ESC[-] Back  [%s]q[-] Quit",
-				ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info))
+			SetText(fmt.Sprintf("[%s]↑/↓[-] Navigate  [%s]Enter/Double-click[-] View Findings  [%s]S[-] All Sandboxes  [%s]w[-] Watch Scans  [%s]ESC[-] Back  [%s]q[-] Quit",
+				ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info))
 		shortcutsBar.SetBorder(false)
 
 		// Clear and rebuild the detail flex
@@ -262,6 +342,7 @@ func (ui *UI) updateApplicationDetailViews() {
 
 		ui.detailFlex.AddItem(topRow, topRowHeight, 0, false).
 			AddItem(ui.contextsTable, 0, 1, true).
+			AddItem(ui.detailStatusBar, 1, 0, false).
 			AddItem(shortcutsBar, 1, 0, false)
 	}
 
@@ -275,20 +356,14 @@ func (ui *UI) buildApplicationInfoContent() string {
 
 	// Extract data
 	businessUnit := TextNotAvailable
+	if name := app.BusinessUnitName(); name != "" {
+		businessUnit = name
+	}
 	businessCriticality := TextNotAvailable
-	description := ""
-
-	if app.Profile != nil {
-		if app.Profile.BusinessCriticality != "" {
-			businessCriticality = app.Profile.BusinessCriticality
-		}
-		if app.Profile.BusinessUnit != nil {
-			businessUnit = app.Profile.BusinessUnit.Name
-		}
-		if app.Profile.Description != "" {
-			description = app.Profile.Description
-		}
+	if criticality := app.BusinessCriticality(); criticality != "" {
+		businessCriticality = criticality
 	}
+	description := app.Description()
 
 	var appInfo strings.Builder
 	appInfo.WriteString(fmt.Sprintf("[%s]GUID:[-] %s\n", ui.theme.Label, app.GUID))
@@ -302,7 +377,9 @@ func (ui *UI) buildApplicationInfoContent() string {
 	appInfo.WriteString(fmt.Sprintf("[%s]Business Criticality:[-] %s\n", ui.theme.Label, businessCriticality))
 
 	if description != "" {
-		appInfo.WriteString(fmt.Sprintf("[%s]Description:[-]\n%s\n", ui.theme.Label, description))
+		// Wrap between words at the panel width once it has been laid out
+		_, _, width, _ := ui.appInfoView.GetInnerRect()
+		appInfo.WriteString(fmt.Sprintf("[%s]Description:[-]\n%s\n", ui.theme.Label, wrap(description, width)))
 	}
 
 	// Additional fields
@@ -342,7 +419,9 @@ func (ui *UI) buildComplianceContent() string {
 	app := ui.selectedApp
 
 	var compliance strings.Builder
-	compliance.WriteString(fmt.Sprintf("[%s]Created:[-] %s\n", ui.theme.Label, app.Created.Format("2006-01-02 15:04")))
+	if app.Created != nil {
+		compliance.WriteString(fmt.Sprintf("[%s]Created:[-] %s\n", ui.theme.Label, app.Created.Format("2006-01-02 15:04")))
+	}
 	if app.Modified != nil {
 		compliance.WriteString(fmt.Sprintf("[%s]Modified:[-] %s\n", ui.theme.Label, app.Modified.Format("2006-01-02 15:04")))
 	}
@@ -352,41 +431,88 @@ func (ui *UI) buildComplianceContent() string {
 	}
 	compliance.WriteString(fmt.Sprintf("[%s]Last Scan:[-] %s\n", ui.theme.Label, lastScan))
 
-	// Policy info
-	if app.Profile != nil && len(app.Profile.Policies) > 0 {
-		for i, policy := range app.Profile.Policies {
-			if i > 0 {
-				compliance.WriteString("\n")
-			}
+	compliance.WriteString(ui.buildComplianceSummary(app, ui.policyViolations))
 
-			// Policy name
-			compliance.WriteString(fmt.Sprintf("[%s]Policy Name:[-] %s", ui.theme.Label, policy.Name))
-			if policy.IsDefault {
-				compliance.WriteString(fmt.Sprintf(" [%s](Default)[-]", ui.theme.Info))
-			}
-			compliance.WriteString("\n")
-
-			// Policy compliance status with color coding
-			status := policy.PolicyComplianceStatus
-			var statusColor string
-			switch status {
-			case "PASSED", "PASS":
-				statusColor = ui.theme.PolicyPass
-			case "DID_NOT_PASS", "FAIL":
-				statusColor = ui.theme.PolicyFail
-			case "CONDITIONAL_PASS":
-				statusColor = ui.theme.Warning
-			default:
-				statusColor = ui.theme.SecondaryText
-			}
-			compliance.WriteString(fmt.Sprintf("[%s]Policy Compliance:[-] [%s]%s[-]\n", ui.theme.Label, statusColor, status))
+	return compliance.String()
+}
+
+// buildComplianceSummary builds the policy compliance summary for app: each policy's name and
+// compliance status, followed by the number of open policy-violating findings and the earliest
+// grace period expiry among them. A nil findings slice means findings have not been loaded yet.
+func (ui *UI) buildComplianceSummary(app *applications.Application, findingsList []findings.Finding) string {
+	var summary strings.Builder
+
+	policies := app.Policies()
+	if len(policies) == 0 {
+		summary.WriteString(fmt.Sprintf("[%s]Policy Name:[-] %s\n", ui.theme.Label, TextNotAvailable))
+		summary.WriteString(fmt.Sprintf("[%s]Policy Compliance:[-] No policy scans found\n", ui.theme.Label))
+		return summary.String()
+	}
+
+	for i, policy := range policies {
+		if i > 0 {
+			summary.WriteString("\n")
 		}
-	} else {
-		compliance.WriteString(fmt.Sprintf("[%s]Policy Name:[-] %s\n", ui.theme.Label, TextNotAvailable))
-		compliance.WriteString(fmt.Sprintf("[%s]Policy Compliance:[-] No policy scans found\n", ui.theme.Label))
+
+		summary.WriteString(fmt.Sprintf("[%s]Policy Name:[-] %s", ui.theme.Label, policy.Name))
+		if policy.IsDefault {
+			summary.WriteString(fmt.Sprintf(" [%s](Default)[-]", ui.theme.Info))
+		}
+		summary.WriteString("\n")
+
+		status := policy.PolicyComplianceStatus
+		summary.WriteString(fmt.Sprintf("[%s]Policy Compliance:[-] [%s]%s[-]\n", ui.theme.Label, ui.policyStatusColor(status), status))
 	}
 
-	return compliance.String()
+	if findingsList == nil {
+		summary.WriteString(fmt.Sprintf("[%s]Violating Findings:[-] [%s]Not loaded[-]\n", ui.theme.Label, ui.theme.SecondaryText))
+		return summary.String()
+	}
+
+	violations := 0
+	var earliestGrace *time.Time
+	for i := range findingsList {
+		finding := &findingsList[i]
+		if !finding.ViolatesPolicy || !isOpenFinding(finding) {
+			continue
+		}
+		violations++
+		if finding.GracePeriodExpiresDate != nil && (earliestGrace == nil || finding.GracePeriodExpiresDate.Before(*earliestGrace)) {
+			earliestGrace = finding.GracePeriodExpiresDate
+		}
+	}
+
+	violationColor := ui.theme.PolicyPass
+	if violations > 0 {
+		violationColor = ui.theme.PolicyFail
+	}
+	summary.WriteString(fmt.Sprintf("[%s]Violating Findings:[-] [%s]%d open[-]\n", ui.theme.Label, violationColor, violations))
+	if earliestGrace != nil {
+		summary.WriteString(fmt.Sprintf("[%s]Earliest Grace Period Expiry:[-] %s\n", ui.theme.Label, earliestGrace.Format("2006-01-02")))
+	}
+
+	return summary.String()
+}
+
+// policyStatusColor returns the theme color for a policy compliance status
+func (ui *UI) policyStatusColor(status string) string {
+	switch status {
+	case "PASSED", "PASS":
+		return ui.theme.PolicyPass
+	case "DID_NOT_PASS", "FAIL":
+		return ui.theme.PolicyFail
+	default:
+		return ui.theme.PolicyNeutral
+	}
+}
+
+// isOpenFinding reports whether a finding is still open and not covered by an approved mitigation
+func isOpenFinding(finding *findings.Finding) bool {
+	if finding.FindingStatus == nil {
+		return true
+	}
+	return finding.FindingStatus.Status != findings.StatusClosed &&
+		finding.FindingStatus.ResolutionStatus != findings.ResolutionApproved
 }
 
 // buildRecentScansContent builds the recent scans content string with hyperlinks
@@ -403,10 +529,19 @@ func (ui *UI) buildRecentScansContent() string {
 				published = scan.ModifiedDate.Format("2006-01-02 15:04")
 			}
 
+			// Prefer the published date and GUID from the scans endpoint when loaded
+			detail, hasDetail := ui.scanDetails[scan.ScanType]
+			if hasDetail && detail.PublishedDate != nil {
+				published = detail.PublishedDate.Format("2006-01-02 15:04")
+			}
+
 			// Scan type as header
 			scans.WriteString(fmt.Sprintf("[%s]%s[-]\n", ui.theme.Label, scan.ScanType))
 			scans.WriteString(fmt.Sprintf("  Status: %s\n", scan.Status))
 			scans.WriteString(fmt.Sprintf("  Published: %s\n", published))
+			if hasDetail && detail.GUID != "" {
+				scans.WriteString(fmt.Sprintf("  GUID: %s\n", detail.GUID))
+			}
 
 			// Add hyperlink to scan if URL is available
 			if scan.ScanURL != "" {
@@ -425,6 +560,21 @@ func (ui *UI) buildRecentScansContent() string {
 	return scans.String()
 }
 
+// latestScansByType keeps the most recently published scan for each scan type
+func latestScansByType(scans []applications.ApplicationScan) map[string]applications.ApplicationScan {
+	latest := make(map[string]applications.ApplicationScan)
+	for _, scan := range scans {
+		if scan.ScanType == "" || scan.PublishedDate == nil {
+			continue
+		}
+		existing, ok := latest[scan.ScanType]
+		if !ok || existing.PublishedDate.Before(*scan.PublishedDate) {
+			latest[scan.ScanType] = scan
+		}
+	}
+	return latest
+}
+
 // updateContextsTable updates the scan contexts table
 func (ui *UI) updateContextsTable() {
 	if ui.contextsTable == nil || ui.selectedApp == nil {
@@ -443,6 +593,7 @@ func (ui *UI) updateContextsTable() {
 		{"Created", 12},
 		{"Modified", 12},
 		{"Auto-Recreate", 13},
+		{"Findings", 8},
 	}
 
 	for col, header := range headers {
@@ -460,6 +611,7 @@ func (ui *UI) updateContextsTable() {
 	ui.contextsTable.SetCell(1, 2, tview.NewTableCell("-").SetExpansion(1))
 	ui.contextsTable.SetCell(1, 3, tview.NewTableCell("-").SetExpansion(1))
 	ui.contextsTable.SetCell(1, 4, tview.NewTableCell("-").SetExpansion(1))
+	ui.contextsTable.SetCell(1, contextCountsColumn, ui.contextFindingCountCell(ui.selectedApp.GUID, ""))
 
 	// Sandbox rows
 	if len(ui.sandboxes) > 0 {
@@ -482,7 +634,10 @@ func (ui *UI) updateContextsTable() {
 				autoRecreate = "[yellow]Yes[-]"
 			}
 			ui.contextsTable.SetCell(rowNum, 4, tview.NewTableCell(autoRecreate).SetExpansion(1))
+			ui.contextsTable.SetCell(rowNum, contextCountsColumn, ui.contextFindingCountCell(ui.selectedApp.GUID, sandbox.GUID))
 		}
+	} else if ui.sandboxes != nil {
+		ui.contextsTable.SetCell(2, 0, ui.emptyStateCell(emptySandboxesMessage).SetAlign(tview.AlignLeft))
 	}
 
 	// Select the policy row by default
@@ -0,0 +1,135 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dipsylala/veracode-tui/services/applications"
+	"github.com/dipsylala/veracode-tui/veracode"
+	"github.com/rivo/tview"
+)
+
+const (
+	// DefaultWatchInterval is how often a watched application is polled for scan progress
+	DefaultWatchInterval = 30 * time.Second
+
+	// MinWatchInterval is the shortest poll interval accepted, to limit API load
+	MinWatchInterval = 5 * time.Second
+)
+
+// SetWatchInterval sets how often the scan watch polls the application, clamped to at least
+// MinWatchInterval. Zero keeps DefaultWatchInterval.
+func (ui *UI) SetWatchInterval(interval time.Duration) {
+	if interval == 0 {
+		return
+	}
+	ui.watchInterval = max(interval, MinWatchInterval)
+}
+
+// createDetailStatusBar creates the status line of the application detail view
+func (ui *UI) createDetailStatusBar() {
+	ui.detailStatusBar = tview.NewTextView().
+		SetDynamicColors(true).
+		SetTextAlign(tview.AlignLeft)
+	ui.detailStatusBar.SetBorder(false)
+}
+
+// toggleScanWatch starts polling the selected application until its scans finish, or stops a running watch
+func (ui *UI) toggleScanWatch() {
+	if ui.watchCancel != nil {
+		ui.stopScanWatch()
+		ui.detailStatusBar.SetText(fmt.Sprintf("[%s]Stopped watching scans[-]", ui.theme.SecondaryText))
+		return
+	}
+	if ui.selectedApp == nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(ui.ctx)
+	ui.watchCancel = cancel
+	ui.detailStatusBar.SetText(fmt.Sprintf("[%s]Checking scan status...[-]", ui.theme.Pending))
+	go ui.runScanWatch(ctx, ui.selectedApp.GUID)
+}
+
+// stopScanWatch cancels a running scan watch. It must be called on the UI goroutine.
+func (ui *UI) stopScanWatch() {
+	if ui.watchCancel != nil {
+		ui.watchCancel()
+		ui.watchCancel = nil
+	}
+}
+
+// runScanWatch polls the application every watch interval, refreshing the detail view, until every
+// scan has reached a terminal status, the watch is cancelled or the application exits
+func (ui *UI) runScanWatch(ctx context.Context, appGUID string) {
+	for {
+		// Discard this application's cached detail so each poll sees the current scan status,
+		// leaving other cached responses in place
+		ui.appService.InvalidateApplication(appGUID)
+		app, err := ui.appService.GetApplication(appGUID)
+
+		if err == nil {
+			if status, finished := scansFinished(app); finished {
+				ui.queueUpdateDraw(func() {
+					if ctx.Err() != nil {
+						return
+					}
+					ui.stopScanWatch()
+					ui.selectedApp = app
+					ui.updateApplicationDetailViews()
+					ui.detailStatusBar.SetText(fmt.Sprintf("[%s]✓ Scans finished: %s[-]", ui.theme.Success, status))
+				})
+				return
+			}
+		}
+
+		waiting := fmt.Sprintf("[%s]Watching scans - next check in", ui.theme.Pending)
+		if err != nil {
+			waiting = fmt.Sprintf("[%s]Error checking scans: %s - retrying in", ui.theme.Error, tview.Escape(veracode.UserMessage(err, "Application")))
+		} else {
+			ui.queueUpdateDraw(func() {
+				if ctx.Err() == nil {
+					ui.selectedApp = app
+					ui.updateApplicationDetailViews()
+				}
+			})
+		}
+
+		for remaining := ui.watchInterval; remaining > 0; remaining -= time.Second {
+			ui.queueUpdateDraw(func() {
+				if ctx.Err() == nil {
+					ui.detailStatusBar.SetText(fmt.Sprintf("%s %ds[-]  [%s]w[-] Stop", waiting, int(remaining.Seconds()), ui.theme.Info))
+				}
+			})
+			select {
+			case <-ctx.Done():
+				return
+			case <-ui.stopped:
+				return
+			case <-time.After(min(time.Second, remaining)):
+			}
+		}
+	}
+}
+
+// scansFinished reports whether every scan of app has reached a terminal status, with a summary
+// of the statuses. An application without scans counts as finished.
+func scansFinished(app *applications.Application) (string, bool) {
+	if len(app.Scans) == 0 {
+		return "no scans", true
+	}
+
+	summary := ""
+	for i := range app.Scans {
+		status := applications.ScanStatus(&app.Scans[i])
+		if !applications.IsTerminalScanStatus(status) {
+			return "", false
+		}
+		if summary != "" {
+			summary += ", "
+		}
+		summary += fmt.Sprintf("%s %s", app.Scans[i].ScanType, status)
+	}
+	return summary, true
+}
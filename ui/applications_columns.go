@@ -0,0 +1,139 @@
+package ui
+
+import (
+	"time"
+
+	"github.com/dipsylala/veracode-tui/services/applications"
+	"github.com/dipsylala/veracode-tui/services/findings"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// maxApplicationNameLength is the longest application name shown, including the ellipsis of a truncated name
+const maxApplicationNameLength = 40
+
+// noSort marks an applications table column that cannot be sorted by clicking its header
+const noSort SortColumn = -1
+
+// applicationColumn describes one column of the applications table
+type applicationColumn struct {
+	header   string
+	cell     func(app *applications.Application) *tview.TableCell
+	sortBy   SortColumn // Sorted by a header click, or noSort
+	priority int        // Lower values stay visible longest when the table is too narrow
+}
+
+// applicationColumns returns the applications table columns. The sortable columns come first, in
+// SortColumn order, followed by the optional business columns when enabled. When the terminal is
+// too narrow, columns are hidden from the highest priority value down: Created, Business Unit,
+// Last Modified, Criticality, Last Scan, Scan Status and finally Policy Status. The name is always shown.
+func (ui *UI) applicationColumns() []applicationColumn {
+	columns := []applicationColumn{
+		{ui.applicationColumnHeader(SortByName), ui.applicationNameCell, SortByName, 0},
+		{ui.applicationColumnHeader(SortByCreated), func(app *applications.Application) *tview.TableCell {
+			return dateCell(app.Created)
+		}, SortByCreated, 7},
+		{ui.applicationColumnHeader(SortByModified), func(app *applications.Application) *tview.TableCell {
+			return dateCell(app.Modified)
+		}, SortByModified, 5},
+		{ui.applicationColumnHeader(SortByLastScan), func(app *applications.Application) *tview.TableCell {
+			return dateCell(app.LastCompletedScanDate)
+		}, SortByLastScan, 3},
+		{ui.applicationColumnHeader(SortByPolicyStatus), ui.policyStatusCell, SortByPolicyStatus, 1},
+		{ui.applicationColumnHeader(SortByScanStatus), ui.scanStatusCell, SortByScanStatus, 2},
+	}
+
+	if ui.showBusinessColumns {
+		columns = append(columns,
+			applicationColumn{"Business Unit", ui.businessUnitCell, noSort, 6},
+			applicationColumn{"Criticality", ui.businessCriticalityCell, noSort, 4})
+	}
+	return columns
+}
+
+// toggleBusinessColumns shows or hides the business unit and criticality columns for the rest of the session
+func (ui *UI) toggleBusinessColumns() {
+	ui.showBusinessColumns = !ui.showBusinessColumns
+	row, _ := ui.applicationsTable.GetSelection()
+	ui.renderApplicationsTable()
+	if row > 0 {
+		ui.applicationsTable.Select(row, 0)
+	}
+}
+
+// applicationDisplayName returns the application's name, or DefaultApplicationName when it has
+// no profile or name
+func applicationDisplayName(app *applications.Application) string {
+	if name := app.Name(); name != "" {
+		return name
+	}
+	return DefaultApplicationName
+}
+
+func (ui *UI) applicationNameCell(app *applications.Application) *tview.TableCell {
+	appName := app.Name()
+	if appName == "" {
+		appName = "Unknown"
+	}
+	return tview.NewTableCell(truncateRunes(appName, maxApplicationNameLength))
+}
+
+// dateCell formats an optional date as yyyy-MM-dd
+func dateCell(date *time.Time) *tview.TableCell {
+	if date == nil {
+		return tview.NewTableCell(TextNotAvailable)
+	}
+	return tview.NewTableCell(date.Format("2006-01-02"))
+}
+
+func (ui *UI) policyStatusCell(app *applications.Application) *tview.TableCell {
+	policyStatus := TextNotAvailable
+	if status := app.PolicyComplianceStatus(); status != "" {
+		policyStatus = status
+	}
+	return tview.NewTableCell(policyStatus)
+}
+
+func (ui *UI) scanStatusCell(app *applications.Application) *tview.TableCell {
+	scanStatus := TextNotAvailable
+	if len(app.Scans) > 0 {
+		scanStatus = app.Scans[0].Status
+	}
+	return tview.NewTableCell(scanStatus)
+}
+
+func (ui *UI) businessUnitCell(app *applications.Application) *tview.TableCell {
+	businessUnit := TextNotAvailable
+	if name := app.BusinessUnitName(); name != "" {
+		businessUnit = name
+	}
+	return tview.NewTableCell(businessUnit)
+}
+
+// businessCriticalityCell shows the business criticality, colored like the equivalent finding severity
+func (ui *UI) businessCriticalityCell(app *applications.Application) *tview.TableCell {
+	criticality := app.BusinessCriticality()
+	if criticality == "" {
+		return tview.NewTableCell(TextNotAvailable)
+	}
+	return tview.NewTableCell(criticality).
+		SetTextColor(tcell.GetColor(ui.severityColor(criticalitySeverity(criticality))))
+}
+
+// criticalitySeverity maps a business criticality level to the severity scale used for colors
+func criticalitySeverity(criticality string) int {
+	switch criticality {
+	case "VERY_HIGH":
+		return findings.SeverityVeryHigh
+	case "HIGH":
+		return findings.SeverityHigh
+	case "MEDIUM":
+		return findings.SeverityMedium
+	case "LOW":
+		return findings.SeverityLow
+	case "VERY_LOW":
+		return findings.SeverityVeryLow
+	default:
+		return findings.SeverityInformational
+	}
+}
@@ -0,0 +1,38 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/dipsylala/veracode-tui/services/applications"
+)
+
+func TestApplicationDisplayName(t *testing.T) {
+	tests := []struct {
+		name string
+		app  *applications.Application
+		want string
+	}{
+		{"nil application", nil, DefaultApplicationName},
+		{"no profile", &applications.Application{GUID: "app-guid"}, DefaultApplicationName},
+		{"empty name", &applications.Application{Profile: &applications.ApplicationProfile{}}, DefaultApplicationName},
+		{"named", &applications.Application{Profile: &applications.ApplicationProfile{Name: "My App"}}, "My App"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := applicationDisplayName(tt.app); got != tt.want {
+				t.Errorf("applicationDisplayName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplicationSortText_WithoutProfile(t *testing.T) {
+	app := &applications.Application{GUID: "app-guid"}
+
+	for _, column := range []SortColumn{SortByName, SortByPolicyStatus, SortByScanStatus} {
+		if got := applicationSortText(app, column); got != "" {
+			t.Errorf("applicationSortText(%v) = %q, want empty", column, got)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// newDateFilterInput creates an input field for a yyyy-MM-dd date filter
+func (ui *UI) newDateFilterInput() *tview.InputField {
+	return tview.NewInputField().
+		SetFieldWidth(0).
+		SetPlaceholder("yyyy-MM-dd").
+		SetFieldBackgroundColor(tcell.GetColor(ui.theme.Separator))
+}
+
+// wrapDateFilterInput wraps a date input in a bordered container and wires it to value.
+// The date is validated when the user presses Enter or leaves the field; invalid dates and
+// ranges are reported in the status bar and not applied.
+func (ui *UI) wrapDateFilterInput(input *tview.InputField, title string, value *string) *tview.Flex {
+	container := tview.NewFlex().
+		AddItem(input, 0, 1, false)
+	container.SetBorder(true).
+		SetTitle(title).
+		SetTitleAlign(tview.AlignLeft).
+		SetBorderColor(tcell.GetColor(ui.theme.Border)).
+		SetBorderPadding(0, 0, 1, 1)
+
+	input.SetDoneFunc(func(key tcell.Key) {
+		if key == tcell.KeyEnter {
+			// Keep focus on the input field so user can correct an error
+			if !ui.applyDateFilter(input.GetText(), value) {
+				return
+			}
+			ui.app.SetFocus(ui.applicationsTable)
+			ui.triggerApplicationsSearch()
+		} else if key == tcell.KeyEscape {
+			ui.app.SetFocus(ui.applicationsTable)
+		}
+	})
+	input.SetFocusFunc(func() {
+		container.SetBorderColor(tcell.GetColor(ui.theme.BorderFocused))
+	})
+	input.SetBlurFunc(func() {
+		container.SetBorderColor(tcell.GetColor(ui.theme.Border))
+		// Validate and trigger search when field loses focus
+		if ui.applyDateFilter(input.GetText(), value) {
+			ui.triggerApplicationsSearch()
+		}
+	})
+
+	return container
+}
+
+// applyDateFilter validates dateText and stores it in value, reporting whether it was applied.
+// The modified after/before pair must form a valid range.
+func (ui *UI) applyDateFilter(dateText string, value *string) bool {
+	if dateText != "" && !ui.isValidDate(dateText) {
+		ui.statusBar.SetText(fmt.Sprintf("[%s]Invalid date format. Please use yyyy-MM-dd (e.g., 2025-12-17)[-]", ui.theme.Error))
+		return false
+	}
+
+	after, before := ui.modifiedAfterFilterValue, ui.modifiedBeforeFilterValue
+	validate := ui.validateModifiedBefore
+	if value == &ui.modifiedAfterFilterValue {
+		after = dateText
+		validate = ui.validateModifiedAfter
+	} else {
+		before = dateText
+	}
+	if dateText != "" {
+		if err := validate(dateText, time.Now()); err != nil {
+			ui.statusBar.SetText(fmt.Sprintf("[%s]%s[-]", ui.theme.Warning, tview.Escape(err.Error())))
+			return false
+		}
+	}
+	// yyyy-MM-dd dates order correctly as strings
+	if after != "" && before != "" && before < after {
+		ui.statusBar.SetText(fmt.Sprintf("[%s]Modified Before (%s) must not be earlier than Modified After (%s)[-]", ui.theme.Error, before, after))
+		return false
+	}
+
+	*value = dateText
+	return true
+}
+
+// validateModifiedAfter checks a Modified After date, which must be a yyyy-MM-dd date no later than
+// today. A later date would silently match no applications.
+func (ui *UI) validateModifiedAfter(dateStr string, now time.Time) error {
+	return validateFilterDate("Modified After", dateStr, now)
+}
+
+// validateModifiedBefore checks a Modified Before date, which must be a yyyy-MM-dd date no later
+// than today
+func (ui *UI) validateModifiedBefore(dateStr string, now time.Time) error {
+	return validateFilterDate("Modified Before", dateStr, now)
+}
+
+// validateFilterDate checks that dateStr is a yyyy-MM-dd date no later than the date of now, in
+// now's time zone
+func validateFilterDate(name, dateStr string, now time.Time) error {
+	date, err := time.ParseInLocation("2006-01-02", dateStr, now.Location())
+	if err != nil {
+		return fmt.Errorf("%s must be a date in yyyy-MM-dd format, e.g. 2025-12-17", name)
+	}
+	year, month, day := now.Date()
+	if date.After(time.Date(year, month, day, 0, 0, 0, 0, now.Location())) {
+		return fmt.Errorf("%s (%s) is in the future, so no applications would match", name, dateStr)
+	}
+	return nil
+}
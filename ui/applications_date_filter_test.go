@@ -0,0 +1,43 @@
+package ui
+
+import (
+	"testing"
+	"time"
+)
+
+func TestValidateModifiedDates(t *testing.T) {
+	now := time.Date(2025, 6, 15, 23, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		date    string
+		wantErr bool
+	}{
+		{"past", "2024-01-31", false},
+		{"yesterday", "2025-06-14", false},
+		{"today", "2025-06-15", false},
+		{"tomorrow", "2025-06-16", true},
+		{"far future", "2030-01-01", true},
+		{"invalid format", "15/06/2025", true},
+	}
+
+	ui := &UI{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ui.validateModifiedAfter(tt.date, now); (err != nil) != tt.wantErr {
+				t.Errorf("validateModifiedAfter(%q) error = %v, wantErr %v", tt.date, err, tt.wantErr)
+			}
+			if err := ui.validateModifiedBefore(tt.date, now); (err != nil) != tt.wantErr {
+				t.Errorf("validateModifiedBefore(%q) error = %v, wantErr %v", tt.date, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateModifiedAfter_UsesLocalDate(t *testing.T) {
+	// Just after midnight in UTC+10 it is already the 16th locally, while still the 15th in UTC
+	zone := time.FixedZone("UTC+10", 10*60*60)
+	now := time.Date(2025, 6, 16, 0, 30, 0, 0, zone)
+	if err := (&UI{}).validateModifiedAfter("2025-06-16", now); err != nil {
+		t.Errorf("Expected the local date to count as today, got %v", err)
+	}
+}
@@ -2,10 +2,10 @@ package ui
 
 import (
 	"fmt"
-	"sort"
 	"time"
 
 	"github.com/dipsylala/veracode-tui/services/applications"
+	"github.com/dipsylala/veracode-tui/veracode"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 )
@@ -16,14 +16,16 @@ func (ui *UI) setupApplicationsView() {
 	headerWidget := ui.createHeaderWidget()
 	filtersWidget := ui.createFiltersWidget()
 	applicationsWidget := ui.createApplicationsTableWidget()
-	statusWidget := ui.createStatusBarWidget()
+	statusWidget := tview.NewFlex().
+		AddItem(ui.createSpinnerWidget(), 2, 0, false).
+		AddItem(ui.createStatusBarWidget(), 0, 1, false)
 
 	// Create keyboard shortcuts bar
 	shortcutsBar := tview.NewTextView().
 		SetDynamicColors(true).
 		SetTextAlign(tview.AlignCenter).
-		SetText(fmt.Sprintf("[%s]Enter/Double-click[-] Details  [%s]n/s/t/m/a[-] Filters  [%s]PgDn/PgUp[-] Next/Prev Page  [%s]q/ESC[-] Quit",
-			ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info))
+		SetText(fmt.Sprintf("[%s]Enter/Double-click[-] Details  [%s]n/s/p/t/m/M/g/a[-] Filters  [%s]y[-] Copy GUID  [%s]o[-] Open  [%s]e/E[-] Export Page/All CSV  [%s]c/d[-] Sort/Direction  [%s]b[-] Teams  [%s]D[-] Dashboard  [%s]r[-] Recent  [%s]v[-] Business Columns  [%s]PgDn/PgUp[-] Next/Prev Page  [%s]+/-[-] Page Size  [%s]F5[-] Refresh  [%s]q/ESC[-] Quit",
+			ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info))
 	shortcutsBar.SetBorder(false)
 
 	// Layout: header, filters (with all fields on one line), status bar, table, shortcuts
@@ -50,6 +52,7 @@ func (ui *UI) createHeaderWidget() *tview.TextView {
 		SetTextAlign(tview.AlignLeft).
 		SetDynamicColors(true)
 	header.SetBorder(false)
+	ui.headerView = header
 	return header
 }
 
@@ -138,22 +141,53 @@ func (ui *UI) createFiltersWidget() *tview.Flex {
 		ui.triggerApplicationsSearch()
 	})
 
-	// Scan Type dropdown - matches scan_type query parameter from Swagger spec
-	ui.scanTypeFilter = tview.NewDropDown().
-		SetOptions([]string{"All", "STATIC", "DYNAMIC", "MANUAL"}, nil).
+	// Policy Compliance dropdown - matches policy_compliance query parameter from Swagger spec
+	ui.complianceFilter = tview.NewDropDown().
+		SetOptions(append([]string{"All"}, applications.PolicyComplianceStatuses...), nil).
 		SetCurrentOption(0).
 		SetFieldWidth(0).
 		SetFieldBackgroundColor(tcell.GetColor(ui.theme.Separator))
 
-	ui.scanTypeFilter.SetSelectedFunc(func(text string, index int) {
+	ui.complianceFilter.SetSelectedFunc(func(text string, index int) {
 		if index == 0 {
-			ui.scanTypeFilterValue = ""
+			ui.complianceFilterValue = ""
 		} else {
-			ui.scanTypeFilterValue = text
+			ui.complianceFilterValue = text
 		}
 		ui.triggerApplicationsSearch()
 	})
 
+	ui.complianceFilter.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape {
+			ui.app.SetFocus(ui.applicationsTable)
+			return nil
+		}
+		return event
+	})
+
+	// Wrap policy compliance in container with border
+	complianceContainer := tview.NewFlex().
+		AddItem(ui.complianceFilter, 0, 1, false)
+	complianceContainer.SetBorder(true).
+		SetTitle(" Compliance (p) ").
+		SetTitleAlign(tview.AlignLeft).
+		SetBorderColor(tcell.GetColor(ui.theme.Border)).
+		SetBorderPadding(0, 0, 1, 1)
+
+	ui.complianceFilter.SetFocusFunc(func() {
+		complianceContainer.SetBorderColor(tcell.GetColor(ui.theme.BorderFocused))
+	})
+	ui.complianceFilter.SetBlurFunc(func() {
+		complianceContainer.SetBorderColor(tcell.GetColor(ui.theme.Border))
+	})
+
+	// Scan Type dropdown - matches scan_type query parameter from Swagger spec. Options toggle, so
+	// several scan types can be selected at once
+	ui.scanTypeFilter = tview.NewDropDown().
+		SetFieldWidth(0).
+		SetFieldBackgroundColor(tcell.GetColor(ui.theme.Separator))
+	ui.updateScanTypeFilterOptions()
+
 	ui.scanTypeFilter.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
 		if event.Key() == tcell.KeyEscape {
 			ui.app.SetFocus(ui.applicationsTable)
@@ -180,53 +214,47 @@ func (ui *UI) createFiltersWidget() *tview.Flex {
 		ui.triggerApplicationsSearch()
 	})
 
-	// Modified After input field
-	ui.modifiedAfterInput = tview.NewInputField().
+	// Modified After / Before date range inputs
+	ui.modifiedAfterInput = ui.newDateFilterInput()
+	modifiedAfterContainer := ui.wrapDateFilterInput(ui.modifiedAfterInput, " Modified After (m) ", &ui.modifiedAfterFilterValue)
+	ui.modifiedBeforeInput = ui.newDateFilterInput()
+	modifiedBeforeContainer := ui.wrapDateFilterInput(ui.modifiedBeforeInput, " Modified Before (M) ", &ui.modifiedBeforeFilterValue)
+
+	// Tag input field
+	ui.tagInput = tview.NewInputField().
 		SetFieldWidth(0).
-		SetPlaceholder("yyyy-MM-dd").
 		SetFieldBackgroundColor(tcell.GetColor(ui.theme.Separator))
 
-	ui.modifiedAfterInput.SetDoneFunc(func(key tcell.Key) {
-		if key == tcell.KeyEnter {
-			dateText := ui.modifiedAfterInput.GetText()
-
-			// Validate date format if not empty
-			if dateText != "" && !ui.isValidDate(dateText) {
-				ui.statusBar.SetText("[red]Invalid date format. Please use yyyy-MM-dd (e.g., 2025-12-17)[-]")
-				// Keep focus on the input field so user can correct the error
-				return
-			}
-
-			ui.modifiedAfterFilterValue = dateText
+	ui.tagInput.SetDoneFunc(func(key tcell.Key) {
+		switch key {
+		case tcell.KeyEnter:
+			ui.tagFilterValue = ui.tagInput.GetText()
 			ui.app.SetFocus(ui.applicationsTable)
 			ui.triggerApplicationsSearch()
-		} else if key == tcell.KeyEscape {
+		case tcell.KeyEscape:
 			ui.app.SetFocus(ui.applicationsTable)
 		}
 	})
 
-	// Wrap modified after in container with border
-	modifiedAfterContainer := tview.NewFlex().
-		AddItem(ui.modifiedAfterInput, 0, 1, false)
-	modifiedAfterContainer.SetBorder(true).
-		SetTitle(" Modified After (m) ").
+	// Wrap tag in container with border
+	tagContainer := tview.NewFlex().
+		AddItem(ui.tagInput, 0, 1, false)
+	tagContainer.SetBorder(true).
+		SetTitle(" Tag (g) ").
 		SetTitleAlign(tview.AlignLeft).
 		SetBorderColor(tcell.GetColor(ui.theme.Border)).
 		SetBorderPadding(0, 0, 1, 1)
 
-	ui.modifiedAfterInput.SetFocusFunc(func() {
-		modifiedAfterContainer.SetBorderColor(tcell.GetColor(ui.theme.BorderFocused))
+	ui.tagInput.SetFocusFunc(func() {
+		tagContainer.SetBorderColor(tcell.GetColor(ui.theme.BorderFocused))
 	})
-	ui.modifiedAfterInput.SetBlurFunc(func() {
-		modifiedAfterContainer.SetBorderColor(tcell.GetColor(ui.theme.Border))
-		// Validate and trigger search when field loses focus
-		dateText := ui.modifiedAfterInput.GetText()
-		if dateText != "" && !ui.isValidDate(dateText) {
-			ui.statusBar.SetText("[red]Invalid date format. Please use yyyy-MM-dd (e.g., 2025-12-17)[-]")
-			return
+	ui.tagInput.SetBlurFunc(func() {
+		tagContainer.SetBorderColor(tcell.GetColor(ui.theme.Border))
+		// Trigger search when the tag changes and the field loses focus
+		if tag := ui.tagInput.GetText(); tag != ui.tagFilterValue {
+			ui.tagFilterValue = tag
+			ui.triggerApplicationsSearch()
 		}
-		ui.modifiedAfterFilterValue = dateText
-		ui.triggerApplicationsSearch()
 	})
 
 	// Create horizontal flex with all filters on one line
@@ -234,8 +262,11 @@ func (ui *UI) createFiltersWidget() *tview.Flex {
 		SetDirection(tview.FlexColumn).
 		AddItem(nameContainer, 0, 1, false).
 		AddItem(scanStatusContainer, 0, 1, false).
+		AddItem(complianceContainer, 0, 1, false).
 		AddItem(scanTypeContainer, 0, 1, false).
-		AddItem(modifiedAfterContainer, 0, 1, false)
+		AddItem(modifiedAfterContainer, 0, 1, false).
+		AddItem(modifiedBeforeContainer, 0, 1, false).
+		AddItem(tagContainer, 0, 1, false)
 
 	return container
 }
@@ -247,7 +278,7 @@ func (ui *UI) createApplicationsTableWidget() *tview.Table {
 		SetFixed(1, 0)
 
 	ui.applicationsTable.SetBorder(true).
-		SetTitle(" Applications (a) ").
+		SetTitle(applicationsTableTitle).
 		SetTitleAlign(tview.AlignLeft).
 		SetBorderColor(tcell.GetColor(ui.theme.Border)).
 		SetBorderPadding(0, 0, 1, 1)
@@ -262,6 +293,7 @@ func (ui *UI) createApplicationsTableWidget() *tview.Table {
 	ui.applicationsTable.SetBlurFunc(func() {
 		ui.applicationsTable.SetBorderColor(tcell.GetColor(ui.theme.Border))
 	})
+	ui.watchApplicationsTableWidth()
 
 	return ui.applicationsTable
 }
@@ -286,12 +318,17 @@ func (ui *UI) setupApplicationsInputHandlers(flex *tview.Flex) {
 			return ui.handleTabNavigation(true)
 		}
 
+		if isRefreshKey(event) {
+			ui.refreshApplications()
+			return nil
+		}
+
 		currentFocus := ui.app.GetFocus()
 
 		// Handle global hotkeys (but not when typing in input fields)
 		if event.Key() == tcell.KeyRune {
 			// Don't trigger hotkeys when user is typing in an input field
-			if currentFocus == ui.searchInput || currentFocus == ui.modifiedAfterInput {
+			if currentFocus == ui.searchInput || currentFocus == ui.modifiedAfterInput || currentFocus == ui.modifiedBeforeInput || currentFocus == ui.tagInput {
 				return event
 			}
 
@@ -305,12 +342,21 @@ func (ui *UI) setupApplicationsInputHandlers(flex *tview.Flex) {
 			case 's':
 				ui.app.SetFocus(ui.scanStatusFilter)
 				return nil
+			case 'p':
+				ui.app.SetFocus(ui.complianceFilter)
+				return nil
 			case 't':
 				ui.app.SetFocus(ui.scanTypeFilter)
 				return nil
 			case 'm':
 				ui.app.SetFocus(ui.modifiedAfterInput)
 				return nil
+			case 'M':
+				ui.app.SetFocus(ui.modifiedBeforeInput)
+				return nil
+			case 'g':
+				ui.app.SetFocus(ui.tagInput)
+				return nil
 			}
 		}
 
@@ -332,8 +378,15 @@ func (ui *UI) setupApplicationsInputHandlers(flex *tview.Flex) {
 		return event
 	})
 
-	// Add double-click support
+	// Add double-click support, and header clicks to sort
 	ui.applicationsTable.SetMouseCapture(func(action tview.MouseAction, event *tcell.EventMouse) (tview.MouseAction, *tcell.EventMouse) {
+		if action == tview.MouseLeftClick {
+			row, col := ui.applicationsTable.CellAt(event.Position())
+			if row == 0 && col >= 0 && col < len(ui.applicationsTableCols) && ui.applicationsTableCols[col].sortBy != noSort {
+				ui.setApplicationsSort(ui.applicationsTableCols[col].sortBy)
+				return action, nil
+			}
+		}
 		if action == tview.MouseLeftDoubleClick {
 			row, _ := ui.applicationsTable.GetSelection()
 			if row > 0 && row-1 < len(ui.applications) {
@@ -361,7 +414,7 @@ func (ui *UI) setupApplicationsInputHandlers(flex *tview.Flex) {
 func (ui *UI) handleApplicationsTableInput(event *tcell.EventKey) *tcell.EventKey {
 	switch event.Key() {
 	case tcell.KeyCtrlC, tcell.KeyEscape:
-		ui.app.Stop()
+		ui.stop()
 		return nil
 	case tcell.KeyPgDn:
 		if ui.currentPage < ui.totalPages-1 {
@@ -386,10 +439,12 @@ func (ui *UI) handleApplicationsTableInput(event *tcell.EventKey) *tcell.EventKe
 }
 
 // handleApplicationsTableRune handles rune input for applications table
+//
+//nolint:gocyclo // One case per hotkey, sequential, not nested
 func (ui *UI) handleApplicationsTableRune(r rune) *tcell.EventKey {
 	switch r {
 	case 'q':
-		ui.app.Stop()
+		ui.stop()
 		return nil
 	case 'n':
 		ui.app.SetFocus(ui.searchInput)
@@ -397,15 +452,54 @@ func (ui *UI) handleApplicationsTableRune(r rune) *tcell.EventKey {
 	case 's':
 		ui.app.SetFocus(ui.scanStatusFilter)
 		return nil
+	case 'p':
+		ui.app.SetFocus(ui.complianceFilter)
+		return nil
 	case 't':
 		ui.app.SetFocus(ui.scanTypeFilter)
 		return nil
 	case 'm':
 		ui.app.SetFocus(ui.modifiedAfterInput)
 		return nil
+	case 'g':
+		ui.app.SetFocus(ui.tagInput)
+		return nil
 	case 'a':
 		ui.app.SetFocus(ui.applicationsTable)
 		return nil
+	case 'y':
+		ui.copySelectedApplicationGUID()
+		return nil
+	case 'o':
+		ui.openSelectedApplicationInBrowser()
+		return nil
+	case 'c':
+		ui.cycleApplicationsSortColumn()
+		return nil
+	case 'd':
+		ui.setApplicationsSort(ui.appSortColumn)
+		return nil
+	case 'b':
+		ui.showTeams()
+		return nil
+	case 'D':
+		ui.showDashboard()
+		return nil
+	case 'r':
+		ui.showRecentApplications()
+		return nil
+	case 'v':
+		ui.toggleBusinessColumns()
+		return nil
+	case 'e':
+		ui.exportApplicationsToCSV()
+		return nil
+	case 'E':
+		go ui.exportAllApplicationsToCSV()
+		return nil
+	case '+', '-':
+		ui.stepPageSize(r == '+')
+		return nil
 	}
 	return nil
 }
@@ -421,8 +515,11 @@ func (ui *UI) handleTabNavigation(reverse bool) *tcell.EventKey {
 	focusables := []tview.Primitive{
 		ui.searchInput,
 		ui.scanStatusFilter,
+		ui.complianceFilter,
 		ui.scanTypeFilter,
 		ui.modifiedAfterInput,
+		ui.modifiedBeforeInput,
+		ui.tagInput,
 		ui.applicationsTable,
 	}
 
@@ -458,16 +555,9 @@ func (ui *UI) handleTabNavigation(reverse bool) *tcell.EventKey {
 	return nil
 }
 
-// loadApplications fetches applications from the API
-func (ui *UI) loadApplications() {
-	ui.app.QueueUpdateDraw(func() {
-		ui.statusBar.SetText("[yellow]Loading applications...[-]")
-	})
-
-	opts := &applications.GetApplicationsOptions{
-		Page: ui.currentPage,
-		Size: ui.pageSize,
-	}
+// applicationFilterOptions builds the request options for the applications filters currently set
+func (ui *UI) applicationFilterOptions() *applications.GetApplicationsOptions {
+	opts := &applications.GetApplicationsOptions{}
 
 	// Add search query if present
 	if ui.searchQuery != "" {
@@ -479,9 +569,14 @@ func (ui *UI) loadApplications() {
 		opts.ScanStatus = []string{ui.scanStatusFilterValue}
 	}
 
+	// Add policy compliance filter if present
+	if ui.complianceFilterValue != "" {
+		opts.PolicyCompliance = ui.complianceFilterValue
+	}
+
 	// Add scan type filter if present
-	if ui.scanTypeFilterValue != "" {
-		opts.ScanType = ui.scanTypeFilterValue
+	if len(ui.scanTypeFilterValues) > 0 {
+		opts.ScanTypes = ui.scanTypeFilterValues
 	}
 
 	// Add modified after filter if present
@@ -489,11 +584,47 @@ func (ui *UI) loadApplications() {
 		opts.ModifiedAfter = ui.modifiedAfterFilterValue
 	}
 
+	// Add modified before filter if present
+	if ui.modifiedBeforeFilterValue != "" {
+		opts.ModifiedBefore = ui.modifiedBeforeFilterValue
+	}
+
+	// Add tag filter if present
+	if ui.tagFilterValue != "" {
+		opts.Tag = ui.tagFilterValue
+	}
+
+	// Add team filter if present
+	if ui.teamFilterValue != "" {
+		opts.Team = ui.teamFilterValue
+	}
+
+	return opts
+}
+
+// loadApplications fetches applications from the API
+func (ui *UI) loadApplications() {
+	ui.beginRequest()
+	defer ui.endRequest()
+
+	ui.queueUpdateDraw(func() {
+		ui.statusBar.SetText("[yellow]Loading applications...[-]")
+	})
+
+	opts := ui.applicationFilterOptions()
+	opts.Page = ui.currentPage
+	opts.Size = ui.pageSize
+
 	result, err := ui.appService.GetApplications(opts)
 
+	// The user may have quit while the page was loading
+	if ui.ctx.Err() != nil {
+		return
+	}
+
 	if err != nil {
-		ui.app.QueueUpdateDraw(func() {
-			ui.statusBar.SetText(fmt.Sprintf("[red]Error: %v[-]", err))
+		ui.queueUpdateDraw(func() {
+			ui.statusBar.SetText(fmt.Sprintf("[red]Error: %s[-]", tview.Escape(veracode.UserMessage(err, "Applications"))))
 		})
 		return
 	}
@@ -505,16 +636,8 @@ func (ui *UI) loadApplications() {
 	} else {
 		ui.applications = result.Embedded.Applications
 
-		// Sort by Modified date descending (most recent first)
-		sort.Slice(ui.applications, func(i, j int) bool {
-			if ui.applications[i].Modified == nil {
-				return false
-			}
-			if ui.applications[j].Modified == nil {
-				return true
-			}
-			return ui.applications[i].Modified.After(*ui.applications[j].Modified)
-		})
+		// Sort the page by the chosen column (Last Modified, most recent first, by default)
+		ui.sortApplications(ui.applications, ui.appSortColumn, ui.appSortAsc)
 
 		if result.Page != nil {
 			ui.totalPages = int(result.Page.TotalPages)
@@ -522,24 +645,17 @@ func (ui *UI) loadApplications() {
 		}
 	}
 
-	ui.app.QueueUpdateDraw(func() {
+	ui.queueUpdateDraw(func() {
 		ui.renderApplicationsTable()
 		ui.updateStatusBar()
+		ui.prefetchVisibleApplications()
 	})
 }
 
 func (ui *UI) renderApplicationsTable() {
 	ui.applicationsTable.Clear()
 
-	// Add header row
-	headers := []string{"Application Name", "Created", "Last Modified", "Last Scan", "Policy Status", "Scan Status"}
-	for col, header := range headers {
-		cell := tview.NewTableCell(header).
-			SetTextColor(tcell.GetColor(ui.theme.ColumnHeader)).
-			SetAttributes(tcell.AttrBold).
-			SetSelectable(false)
-		ui.applicationsTable.SetCell(0, col, cell)
-	}
+	columns := ui.applicationColumns()
 
 	// Use filtered apps if search is active
 	appsToShow := ui.filteredApps
@@ -547,59 +663,39 @@ func (ui *UI) renderApplicationsTable() {
 		appsToShow = ui.applications
 	}
 
-	// Add application rows
-	for row, app := range appsToShow {
-		rowNum := row + 1
-
-		// Application name
-		appName := "Unknown"
-		if app.Profile != nil {
-			appName = app.Profile.Name
-		}
-		if len(appName) > 40 {
-			appName = appName[:40] + "..."
-		}
-		ui.applicationsTable.SetCell(rowNum, 0, tview.NewTableCell(appName))
-
-		// Created date
-		created := TextNotAvailable
-		if app.Created != nil {
-			created = app.Created.Format("2006-01-02")
-		}
-		ui.applicationsTable.SetCell(rowNum, 1, tview.NewTableCell(created))
-
-		// Last modified
-		lastModified := TextNotAvailable
-		if app.Modified != nil {
-			lastModified = app.Modified.Format("2006-01-02")
+	// Build every cell first so the columns that fit can be chosen from their widths
+	rows := make([][]*tview.TableCell, len(appsToShow))
+	for row := range appsToShow {
+		rows[row] = make([]*tview.TableCell, len(columns))
+		for col, column := range columns {
+			rows[row][col] = column.cell(&appsToShow[row])
 		}
-		ui.applicationsTable.SetCell(rowNum, 2, tview.NewTableCell(lastModified))
-
-		// Last completed scan date
-		lastScan := TextNotAvailable
-		if app.LastCompletedScanDate != nil {
-			lastScan = app.LastCompletedScanDate.Format("2006-01-02")
-		}
-		ui.applicationsTable.SetCell(rowNum, 3, tview.NewTableCell(lastScan))
+	}
+	visible := ui.fitApplicationColumns(columns, rows)
 
-		// Policy status
-		policyStatus := TextNotAvailable
-		if app.Profile != nil && len(app.Profile.Policies) > 0 {
-			policyStatus = app.Profile.Policies[0].PolicyComplianceStatus
-		}
-		ui.applicationsTable.SetCell(rowNum, 4, tview.NewTableCell(policyStatus))
+	// Add header row
+	ui.applicationsTableCols = ui.applicationsTableCols[:0]
+	for col, index := range visible {
+		ui.applicationsTableCols = append(ui.applicationsTableCols, columns[index])
+		cell := tview.NewTableCell(columns[index].header).
+			SetTextColor(tcell.GetColor(ui.theme.ColumnHeader)).
+			SetAttributes(tcell.AttrBold).
+			SetSelectable(false)
+		ui.applicationsTable.SetCell(0, col, cell)
+	}
 
-		// Scan status
-		scanStatus := TextNotAvailable
-		if len(app.Scans) > 0 {
-			scanStatus = app.Scans[0].Status
+	// Add application rows
+	for row, cells := range rows {
+		for col, index := range visible {
+			ui.applicationsTable.SetCell(row+1, col, cells[index])
 		}
-		ui.applicationsTable.SetCell(rowNum, 5, tview.NewTableCell(scanStatus))
 	}
 
-	// Select first data row if available
+	// Select the application last opened, or the first data row, if available
 	if len(appsToShow) > 0 {
-		ui.applicationsTable.Select(1, 0)
+		ui.restoreSelection(ui.lastSelectedAppGUID)
+	} else {
+		ui.applicationsTable.SetCell(1, 0, ui.emptyStateCell(emptyApplicationsMessage(ui.hasApplicationFilters())))
 	}
 }
 
@@ -613,6 +709,9 @@ func (ui *UI) updateStatusBar() {
 	if ui.totalPages > 1 {
 		statusText += fmt.Sprintf(" • Page %d/%d (Total: %d)", ui.currentPage+1, ui.totalPages, ui.totalApps)
 	}
+	if ui.teamFilterValue != "" {
+		statusText += fmt.Sprintf(" • Team: %s", tview.Escape(ui.teamFilterValue))
+	}
 	ui.statusBar.SetText(statusText)
 }
 
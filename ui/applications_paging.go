@@ -0,0 +1,67 @@
+package ui
+
+import "fmt"
+
+const (
+	// DefaultPageSize is the number of applications requested per page unless configured otherwise
+	DefaultPageSize = 100
+
+	// MinPageSize and MaxPageSize bound the applications page size
+	MinPageSize = 10
+	MaxPageSize = 500
+)
+
+// pageSizeSteps are the sizes '+' and '-' step through on the applications list
+var pageSizeSteps = []int{10, 25, 50, 100, 200, 500}
+
+// clampPageSize limits size to the supported range, using the default when it is not set
+func clampPageSize(size int) int {
+	switch {
+	case size <= 0:
+		return DefaultPageSize
+	case size < MinPageSize:
+		return MinPageSize
+	case size > MaxPageSize:
+		return MaxPageSize
+	default:
+		return size
+	}
+}
+
+// SetPageSize sets the number of applications requested per page, clamped to MinPageSize..MaxPageSize.
+// A size of 0 selects DefaultPageSize. It should be called before Run.
+func (ui *UI) SetPageSize(size int) {
+	ui.pageSize = clampPageSize(size)
+}
+
+// nextPageSize returns the step above or below size, or size itself at the end of the range
+func nextPageSize(size int, larger bool) int {
+	if larger {
+		for _, step := range pageSizeSteps {
+			if step > size {
+				return step
+			}
+		}
+		return size
+	}
+	for i := len(pageSizeSteps) - 1; i >= 0; i-- {
+		if pageSizeSteps[i] < size {
+			return pageSizeSteps[i]
+		}
+	}
+	return size
+}
+
+// stepPageSize changes the applications page size to the next larger or smaller step and re-fetches.
+// The new page is chosen so that the first application of the current page is still included.
+func (ui *UI) stepPageSize(larger bool) {
+	size := nextPageSize(ui.pageSize, larger)
+	if size == ui.pageSize {
+		return
+	}
+
+	ui.currentPage = ui.currentPage * ui.pageSize / size
+	ui.pageSize = size
+	ui.statusBar.SetText(fmt.Sprintf("[%s]Page size set to %d, reloading...[-]", ui.theme.Pending, size))
+	go ui.loadApplications()
+}
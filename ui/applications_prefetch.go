@@ -0,0 +1,63 @@
+package ui
+
+import (
+	"context"
+)
+
+const (
+	// prefetchWorkers is the number of application details fetched concurrently when prefetching
+	prefetchWorkers = 4
+
+	// prefetchFallbackRows is how many rows are prefetched before the table has been drawn
+	prefetchFallbackRows = 25
+)
+
+// SetPrefetchDetails enables fetching the details of the visible applications in the background,
+// so opening an application does not wait for the API. It is off by default to limit API load.
+func (ui *UI) SetPrefetchDetails(enabled bool) {
+	ui.prefetchDetails = enabled
+}
+
+// prefetchVisibleApplications starts loading the details of the applications visible in the table,
+// cancelling any prefetch still running for a previous page. It must be called on the UI goroutine.
+func (ui *UI) prefetchVisibleApplications() {
+	if ui.prefetchCancel != nil {
+		ui.prefetchCancel()
+		ui.prefetchCancel = nil
+	}
+	if !ui.prefetchDetails {
+		return
+	}
+
+	guids := ui.visibleApplicationGUIDs()
+	if len(guids) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(ui.ctx)
+	ui.prefetchCancel = cancel
+	go ui.appService.PrefetchApplications(ctx, guids, prefetchWorkers)
+}
+
+// visibleApplicationGUIDs returns the GUIDs of the application rows currently on screen
+func (ui *UI) visibleApplicationGUIDs() []string {
+	apps := ui.filteredApps
+	if apps == nil {
+		apps = ui.applications
+	}
+
+	rowOffset, _ := ui.applicationsTable.GetOffset()
+	_, _, _, height := ui.applicationsTable.GetInnerRect()
+	rows := height - 1 // header row
+	if rows <= 0 {
+		rows = prefetchFallbackRows
+	}
+
+	start := min(rowOffset, len(apps))
+	end := min(start+rows, len(apps))
+	guids := make([]string, 0, end-start)
+	for _, app := range apps[start:end] {
+		guids = append(guids, app.GUID)
+	}
+	return guids
+}
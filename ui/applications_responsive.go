@@ -0,0 +1,96 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// applicationsTableTitle is the applications table title when every column fits
+const applicationsTableTitle = " Applications (a) "
+
+// visibleColumns returns the indexes, in display order, of the columns that fit in width. Columns are
+// dropped from the highest priority value down until the remaining widths, plus a one-cell gap
+// between columns, fit. The column with the lowest priority value is always kept. A width of zero
+// or less, as before the table is first laid out, keeps every column.
+func visibleColumns(widths, priorities []int, width int) []int {
+	visible := make([]int, len(widths))
+	for i := range widths {
+		visible[i] = i
+	}
+	if width <= 0 {
+		return visible
+	}
+
+	for len(visible) > 1 {
+		total := len(visible) - 1
+		for _, i := range visible {
+			total += widths[i]
+		}
+		if total <= width {
+			break
+		}
+
+		// Drop the least important column still shown
+		drop := 0
+		for j, i := range visible {
+			if priorities[i] > priorities[visible[drop]] {
+				drop = j
+			}
+		}
+		visible = slices.Delete(visible, drop, drop+1)
+	}
+	return visible
+}
+
+// watchApplicationsTableWidth re-renders the applications table whenever it is drawn at a different
+// width, so the visible columns follow terminal resizes
+func (ui *UI) watchApplicationsTableWidth() {
+	ui.applicationsTable.SetDrawFunc(func(_ tcell.Screen, _, _, _, _ int) (int, int, int, int) {
+		// The box's rect was just set for this frame, so the inner rect reflects the current layout
+		x, y, width, height := ui.applicationsTable.GetInnerRect()
+		if width != ui.applicationsTableWidth {
+			ui.applicationsTableWidth = width
+			ui.refitApplicationsTable()
+			// The title was drawn before this callback, so draw again to show the hidden column count
+			go ui.app.Draw()
+		}
+		return x, y, width, height
+	})
+}
+
+// refitApplicationsTable re-renders the applications table for its current width, keeping the
+// selected row and scroll position
+func (ui *UI) refitApplicationsTable() {
+	row, _ := ui.applicationsTable.GetSelection()
+	rowOffset, _ := ui.applicationsTable.GetOffset()
+	ui.renderApplicationsTable()
+	if row > 0 && row < ui.applicationsTable.GetRowCount() {
+		ui.applicationsTable.Select(row, 0)
+		ui.applicationsTable.SetOffset(rowOffset, 0)
+	}
+}
+
+// fitApplicationColumns returns the columns that fit the applications table, measuring each column
+// by its widest cell in rows, and shows how many are hidden in the table title
+func (ui *UI) fitApplicationColumns(columns []applicationColumn, rows [][]*tview.TableCell) []int {
+	widths := make([]int, len(columns))
+	priorities := make([]int, len(columns))
+	for col, column := range columns {
+		widths[col] = tview.TaggedStringWidth(column.header)
+		priorities[col] = column.priority
+		for _, cells := range rows {
+			widths[col] = max(widths[col], tview.TaggedStringWidth(cells[col].Text))
+		}
+	}
+
+	visible := visibleColumns(widths, priorities, ui.applicationsTableWidth)
+	if hidden := len(columns) - len(visible); hidden > 0 {
+		ui.applicationsTable.SetTitle(fmt.Sprintf("%s• %d column(s) hidden, widen the terminal to show ", applicationsTableTitle, hidden))
+	} else {
+		ui.applicationsTable.SetTitle(applicationsTableTitle)
+	}
+	return visible
+}
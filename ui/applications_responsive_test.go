@@ -0,0 +1,33 @@
+package ui
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestVisibleColumns(t *testing.T) {
+	// Name, Created, Modified, Last Scan, Policy Status, Scan Status
+	widths := []int{40, 10, 13, 10, 13, 11}
+	priorities := []int{0, 7, 5, 3, 1, 2}
+
+	tests := []struct {
+		name  string
+		width int
+		want  string
+	}{
+		{"not laid out", 0, "[0 1 2 3 4 5]"},
+		{"everything fits", 102, "[0 1 2 3 4 5]"},
+		{"drops created first", 101, "[0 2 3 4 5]"},
+		{"drops modified next", 80, "[0 3 4 5]"},
+		{"keeps policy status longest", 55, "[0 4]"},
+		{"always keeps the name", 10, "[0]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fmt.Sprint(visibleColumns(widths, priorities, tt.width)); got != tt.want {
+				t.Errorf("visibleColumns(width %d) = %s, want %s", tt.width, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package ui
+
+// rememberApplicationSelection records the application being opened and the applications table row
+// the user was on, so that returning to the list selects it again
+func (ui *UI) rememberApplicationSelection() {
+	if ui.selectedApp == nil || ui.applicationsTable == nil {
+		return
+	}
+	ui.lastSelectedAppRow, _ = ui.applicationsTable.GetSelection()
+	ui.lastSelectedAppGUID = ui.selectedApp.GUID
+}
+
+// restoreSelection selects the application with guid in the applications table. When it is not
+// shown, for example because sorting or filtering moved it off the page, the row nearest the one
+// last remembered is selected instead, which is the first row when nothing was remembered.
+func (ui *UI) restoreSelection(guid string) {
+	appsToShow := ui.filteredApps
+	if appsToShow == nil {
+		appsToShow = ui.applications
+	}
+	if len(appsToShow) == 0 {
+		return
+	}
+
+	if guid != "" {
+		for i := range appsToShow {
+			if appsToShow[i].GUID == guid {
+				ui.applicationsTable.Select(i+1, 0)
+				return
+			}
+		}
+	}
+	ui.applicationsTable.Select(min(max(ui.lastSelectedAppRow, 1), len(appsToShow)), 0)
+}
@@ -0,0 +1,47 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/dipsylala/veracode-tui/services/applications"
+	"github.com/rivo/tview"
+)
+
+func TestRestoreSelection(t *testing.T) {
+	ui := &UI{applicationsTable: tview.NewTable()}
+	ui.applications = []applications.Application{{GUID: "a"}, {GUID: "b"}, {GUID: "c"}, {GUID: "d"}}
+	ui.applicationsTable.Select(3, 0)
+	ui.selectedApp = &ui.applications[2]
+	ui.rememberApplicationSelection()
+
+	// Sorting moved the remembered application to the top
+	ui.applications = []applications.Application{{GUID: "c"}, {GUID: "d"}, {GUID: "a"}, {GUID: "b"}}
+	ui.restoreSelection(ui.lastSelectedAppGUID)
+	if row, _ := ui.applicationsTable.GetSelection(); row != 1 {
+		t.Errorf("Expected the remembered application on row 1 to be selected, got row %d", row)
+	}
+
+	// Filtering removed it, so the row it was on is selected instead
+	ui.applications = []applications.Application{{GUID: "a"}, {GUID: "b"}, {GUID: "d"}}
+	ui.restoreSelection(ui.lastSelectedAppGUID)
+	if row, _ := ui.applicationsTable.GetSelection(); row != 3 {
+		t.Errorf("Expected the nearest row 3 to be selected, got row %d", row)
+	}
+
+	// Fewer applications than the remembered row selects the last one
+	ui.applications = []applications.Application{{GUID: "a"}}
+	ui.restoreSelection(ui.lastSelectedAppGUID)
+	if row, _ := ui.applicationsTable.GetSelection(); row != 1 {
+		t.Errorf("Expected the last row to be selected, got row %d", row)
+	}
+}
+
+func TestRestoreSelection_NothingRemembered(t *testing.T) {
+	ui := &UI{applicationsTable: tview.NewTable()}
+	ui.applications = []applications.Application{{GUID: "a"}, {GUID: "b"}}
+
+	ui.restoreSelection("")
+	if row, _ := ui.applicationsTable.GetSelection(); row != 1 {
+		t.Errorf("Expected the first row to be selected, got row %d", row)
+	}
+}
@@ -0,0 +1,132 @@
+package ui
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dipsylala/veracode-tui/services/applications"
+)
+
+// SortColumn identifies the applications table column used for sorting.
+// Values match the column positions in the table.
+type SortColumn int
+
+// Sortable applications table columns
+const (
+	SortByName SortColumn = iota
+	SortByCreated
+	SortByModified
+	SortByLastScan
+	SortByPolicyStatus
+	SortByScanStatus
+)
+
+// applicationColumnHeaders are the applications table headings, indexed by SortColumn
+var applicationColumnHeaders = []string{"Application Name", "Created", "Last Modified", "Last Scan", "Policy Status", "Scan Status"}
+
+// sortApplications stable-sorts apps in place by column. Applications without a value for
+// the column always sort last, whichever the direction.
+func (ui *UI) sortApplications(apps []applications.Application, column SortColumn, asc bool) {
+	switch column {
+	case SortByCreated, SortByModified, SortByLastScan:
+		sort.SliceStable(apps, func(i, j int) bool {
+			return lessTime(applicationSortTime(&apps[i], column), applicationSortTime(&apps[j], column), asc)
+		})
+	default:
+		sort.SliceStable(apps, func(i, j int) bool {
+			return lessText(applicationSortText(&apps[i], column), applicationSortText(&apps[j], column), asc)
+		})
+	}
+}
+
+// applicationSortTime returns the date shown in a date column
+func applicationSortTime(app *applications.Application, column SortColumn) *time.Time {
+	switch column {
+	case SortByCreated:
+		return app.Created
+	case SortByLastScan:
+		return app.LastCompletedScanDate
+	default:
+		return app.Modified
+	}
+}
+
+// applicationSortText returns the text shown in a text column
+func applicationSortText(app *applications.Application, column SortColumn) string {
+	switch column {
+	case SortByPolicyStatus:
+		return app.PolicyComplianceStatus()
+	case SortByScanStatus:
+		if len(app.Scans) > 0 {
+			return app.Scans[0].Status
+		}
+	default:
+		return app.Name()
+	}
+	return ""
+}
+
+// lessTime orders two optional times, placing nil last
+func lessTime(a, b *time.Time, asc bool) bool {
+	if a == nil || b == nil {
+		return a != nil
+	}
+	if asc {
+		return a.Before(*b)
+	}
+	return a.After(*b)
+}
+
+// lessText orders two strings case-insensitively, placing empty values last
+func lessText(a, b string, asc bool) bool {
+	if a == "" || b == "" {
+		return a != ""
+	}
+	a, b = strings.ToLower(a), strings.ToLower(b)
+	if asc {
+		return a < b
+	}
+	return a > b
+}
+
+// defaultSortAscending returns the initial direction for a newly chosen column:
+// newest first for dates, alphabetical for text
+func defaultSortAscending(column SortColumn) bool {
+	switch column {
+	case SortByCreated, SortByModified, SortByLastScan:
+		return false
+	default:
+		return true
+	}
+}
+
+// setApplicationsSort sorts the loaded applications by column, toggling the direction
+// when the column is already the sort column, and re-renders the table
+func (ui *UI) setApplicationsSort(column SortColumn) {
+	if column == ui.appSortColumn {
+		ui.appSortAsc = !ui.appSortAsc
+	} else {
+		ui.appSortColumn = column
+		ui.appSortAsc = defaultSortAscending(column)
+	}
+	ui.sortApplications(ui.applications, ui.appSortColumn, ui.appSortAsc)
+	ui.renderApplicationsTable()
+}
+
+// cycleApplicationsSortColumn moves the sort to the next column
+func (ui *UI) cycleApplicationsSortColumn() {
+	ui.setApplicationsSort((ui.appSortColumn + 1) % SortColumn(len(applicationColumnHeaders)))
+}
+
+// applicationColumnHeader returns the heading for column, marking the current sort column and direction
+func (ui *UI) applicationColumnHeader(column SortColumn) string {
+	header := applicationColumnHeaders[column]
+	if column != ui.appSortColumn {
+		return header
+	}
+	if ui.appSortAsc {
+		return header + " ▲"
+	}
+	return header + " ▼"
+}
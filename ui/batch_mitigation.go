@@ -0,0 +1,267 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dipsylala/veracode-tui/services/annotations"
+	"github.com/dipsylala/veracode-tui/services/findings"
+	"github.com/dipsylala/veracode-tui/veracode"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const batchMitigationPageName = "batch-mitigation-modal"
+
+// batchAnnotationActions are the actions offered when annotating several findings at once.
+// Approval actions depend on each finding's history, so they are only offered per finding.
+var batchAnnotationActions = []string{"COMMENT", "FP", "APPDESIGN", "OSENV", "NETENV"}
+
+// toggleFindingSelection adds or removes the finding on the given table row from the batch selection
+func (ui *UI) toggleFindingSelection(row int) {
+	if ui.findingsScanFilter == findings.ScanFilterSCA || row < 1 || row-1 >= len(ui.findings) {
+		return
+	}
+
+	finding := &ui.findings[row-1]
+	if ui.selectedIssueIDs[finding.IssueID] {
+		delete(ui.selectedIssueIDs, finding.IssueID)
+	} else {
+		ui.selectedIssueIDs[finding.IssueID] = true
+	}
+
+	ui.renderFindingRow(row, finding)
+	ui.updateSelectionStatus()
+}
+
+// clearFindingSelection empties the batch selection
+func (ui *UI) clearFindingSelection() {
+	ui.selectedIssueIDs = make(map[int64]bool)
+}
+
+// updateSelectionStatus shows how many findings are selected for batch mitigation
+func (ui *UI) updateSelectionStatus() {
+	if len(ui.selectedIssueIDs) == 0 {
+		ui.updateCountsLabel()
+		return
+	}
+	ui.setFindingsStatus(fmt.Sprintf("[%s]%d selected[-] ([%s]m[-] to mitigate)", ui.theme.Info, len(ui.selectedIssueIDs), ui.theme.Info))
+}
+
+// issueIDCellText returns the issue ID column text, marking findings selected for batch mitigation
+func (ui *UI) issueIDCellText(finding *findings.Finding) string {
+	if ui.selectedIssueIDs[finding.IssueID] {
+		return fmt.Sprintf("%s %d", EmojiCheckMark, finding.IssueID)
+	}
+	return fmt.Sprintf("%d", finding.IssueID)
+}
+
+// batchSelectionIDs returns the selected issue IDs in ascending order,
+// falling back to the finding on the current row when nothing is selected
+func (ui *UI) batchSelectionIDs() []int64 {
+	ids := make([]int64, 0, len(ui.selectedIssueIDs))
+	for id := range ui.selectedIssueIDs {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	if len(ids) == 0 {
+		row, _ := ui.findingsTable.GetSelection()
+		if row > 0 && row-1 < len(ui.findings) {
+			ids = append(ids, ui.findings[row-1].IssueID)
+		}
+	}
+	return ids
+}
+
+// showBatchMitigationModal opens a modal that applies one annotation to all selected findings
+func (ui *UI) showBatchMitigationModal() {
+	if ui.findingsScanFilter == findings.ScanFilterSCA {
+		ui.setFindingsStatus(fmt.Sprintf("[%s]Mitigations are not available for SCA findings[-]", ui.theme.Warning))
+		return
+	}
+
+	ids := ui.batchSelectionIDs()
+	if len(ids) == 0 {
+		return
+	}
+
+	actionDropdown := tview.NewDropDown().
+		SetLabel("Action: ").
+		SetOptions(batchAnnotationActions, nil).
+		SetCurrentOption(0).
+		SetLabelColor(tcell.GetColor(ui.theme.Label)).
+		SetFieldTextColor(tcell.GetColor(ui.theme.DropDownText)).
+		SetFieldBackgroundColor(tcell.GetColor(ui.theme.DropDownBackground))
+	actionDropdown.SetListStyles(
+		tcell.StyleDefault.Foreground(tcell.GetColor(ui.theme.DropDownText)).Background(tcell.GetColor(ui.theme.DropDownBackground)),
+		tcell.StyleDefault.Foreground(tcell.GetColor(ui.theme.DropDownSelectedForeground)).Background(tcell.GetColor(ui.theme.DropDownSelectedBackground)))
+	actionDropdown.SetBorder(true).
+		SetBorderColor(tcell.GetColor(ui.theme.Border))
+	actionDropdown.SetFocusFunc(func() {
+		actionDropdown.SetBorderColor(tcell.GetColor(ui.theme.BorderFocused))
+	})
+	actionDropdown.SetBlurFunc(func() {
+		actionDropdown.SetBorderColor(tcell.GetColor(ui.theme.Border))
+	})
+
+	commentTextArea := tview.NewTextArea().
+		SetPlaceholder("Enter your comment here...")
+	commentTextArea.SetBorder(true).
+		SetBorderColor(tcell.GetColor(ui.theme.Border)).
+		SetBorderPadding(0, 0, 1, 1).
+		SetTitle(" Comment Text ").
+		SetTitleAlign(tview.AlignLeft)
+	commentTextArea.SetFocusFunc(func() {
+		commentTextArea.SetBorderColor(tcell.GetColor(ui.theme.BorderFocused))
+	})
+	commentTextArea.SetBlurFunc(func() {
+		commentTextArea.SetBorderColor(tcell.GetColor(ui.theme.Border))
+	})
+
+	issuesView := tview.NewTextView().
+		SetDynamicColors(true).
+		SetWordWrap(true).
+		SetText(annotations.BuildIssueList(ids))
+	issuesView.SetBorder(true).
+		SetTitle(fmt.Sprintf(" %d Selected Findings ", len(ids))).
+		SetTitleAlign(tview.AlignLeft).
+		SetBorderColor(tcell.GetColor(ui.theme.Border)).
+		SetBorderPadding(0, 0, 1, 1)
+
+	statusText := tview.NewTextView().
+		SetDynamicColors(true).
+		SetTextAlign(tview.AlignCenter).
+		SetText(fmt.Sprintf("[%s]Ctrl+S[-] Submit to All  [%s]Tab[-] Navigate  [%s]ESC[-] Close", ui.theme.Info, ui.theme.Info, ui.theme.Info))
+
+	modalContent := tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(ui.createMitigationTargetView(len(ids)), 1, 0, false).
+		AddItem(actionDropdown, 3, 0, false).
+		AddItem(commentTextArea, 6, 0, true).
+		AddItem(issuesView, 0, 1, false).
+		AddItem(statusText, 1, 0, false)
+	modalContent.SetBorder(true).
+		SetTitle(" Batch Mitigation ").
+		SetTitleAlign(tview.AlignCenter).
+		SetBorderColor(tcell.GetColor(ui.theme.BorderFocused))
+
+	focusables := []tview.Primitive{actionDropdown, commentTextArea, issuesView}
+	currentFocus := 1
+	policyConfirmed := false
+
+	modalContent.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEscape:
+			ui.closeBatchMitigationModal()
+			return nil
+		case tcell.KeyTab:
+			currentFocus = (currentFocus + 1) % len(focusables)
+			ui.app.SetFocus(focusables[currentFocus])
+			return nil
+		case tcell.KeyBacktab:
+			currentFocus = (currentFocus - 1 + len(focusables)) % len(focusables)
+			ui.app.SetFocus(focusables[currentFocus])
+			return nil
+		case tcell.KeyCtrlS:
+			if ui.annotationSubmitting {
+				statusText.SetText(fmt.Sprintf("[%s]Already submitting, please wait...[-]", ui.theme.Pending))
+				return nil
+			}
+			comment := commentTextArea.GetText()
+			if strings.TrimSpace(comment) == "" {
+				statusText.SetText(fmt.Sprintf("[%s]Error: Comment cannot be empty[-]  [%s]ESC[-] Close", ui.theme.Error, ui.theme.Info))
+				return nil
+			}
+			_, action := actionDropdown.GetCurrentOption()
+			if !ui.confirmPolicyMitigation(action, &policyConfirmed, statusText) {
+				return nil
+			}
+			statusText.SetText(fmt.Sprintf("[%s]Submitting to %d findings...[-]", ui.theme.Pending, len(ids)))
+			commentTextArea.SetDisabled(true)
+			ui.annotationSubmitting = true
+			go ui.submitBatchAnnotation(ids, comment, action, statusText, commentTextArea)
+			return nil
+		}
+		return event
+	})
+
+	ui.pages.AddPage(batchMitigationPageName, modal(modalContent, 4, 4), true, true)
+	ui.app.SetFocus(commentTextArea)
+}
+
+// closeBatchMitigationModal removes the batch modal and returns to the findings table
+func (ui *UI) closeBatchMitigationModal() {
+	ui.pages.RemovePage(batchMitigationPageName)
+	ui.pages.SwitchToPage("findings")
+	ui.app.SetFocus(ui.findingsTable)
+}
+
+// submitBatchAnnotation submits one annotation for all ids and reports per-finding results
+func (ui *UI) submitBatchAnnotation(ids []int64, comment, action string, statusText *tview.TextView, textArea *tview.TextArea) {
+	opts := &annotations.CreateAnnotationOptions{
+		Context: ui.currentContextGUID(),
+	}
+
+	result, err := ui.annotationsService.CreateBatchAnnotation(ui.selectedApp.GUID, ids, comment, action, opts)
+
+	ui.queueUpdateDraw(func() {
+		ui.annotationSubmitting = false
+		textArea.SetDisabled(false)
+
+		if err != nil {
+			statusText.SetText(fmt.Sprintf("[%s]Error: %s  [%s]ESC[-] Close", ui.theme.Error, tview.Escape(veracode.UserMessage(err, "Finding")), ui.theme.Info))
+			return
+		}
+
+		ui.applyBatchAnnotation(result.Succeeded, comment, action)
+
+		if len(result.Failed) == 0 {
+			statusText.SetText(fmt.Sprintf("[%s]%s Annotated %d findings[-]  [%s]ESC[-] Close", ui.theme.Success, EmojiCheckMark, len(result.Succeeded), ui.theme.Info))
+			textArea.SetText("", true)
+			return
+		}
+
+		failedIDs := make([]int64, 0, len(result.Failed))
+		for id := range result.Failed {
+			failedIDs = append(failedIDs, id)
+		}
+		sort.Slice(failedIDs, func(i, j int) bool { return failedIDs[i] < failedIDs[j] })
+		statusText.SetText(fmt.Sprintf("[%s]Annotated %d; failed: %s (%s)[-]  [%s]ESC[-] Close",
+			ui.theme.Warning, len(result.Succeeded), annotations.BuildIssueList(failedIDs),
+			tview.Escape(veracode.UserMessage(result.Failed[failedIDs[0]], "Finding")), ui.theme.Info))
+	})
+}
+
+// applyBatchAnnotation records the new annotation on each annotated finding in memory,
+// clears them from the selection and refreshes the findings table
+func (ui *UI) applyBatchAnnotation(ids []int64, comment, action string) {
+	userName := "Current User"
+	if ui.principal != nil {
+		userName = ui.principal.Username
+	}
+	now := time.Now()
+
+	annotated := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		annotated[id] = true
+		delete(ui.selectedIssueIDs, id)
+	}
+
+	for i := range ui.allFindings {
+		if annotated[ui.allFindings[i].IssueID] {
+			ui.allFindings[i].Annotations = append(ui.allFindings[i].Annotations, findings.Annotation{
+				Action:   action,
+				Comment:  comment,
+				Created:  &now,
+				UserName: userName,
+			})
+		}
+	}
+
+	ui.findings = ui.filterFindings(ui.allFindings, ui.findingsSearchQuery)
+	ui.renderFindingsTable()
+	ui.updateSelectionStatus()
+}
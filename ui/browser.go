@@ -0,0 +1,131 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/atotto/clipboard"
+	"github.com/dipsylala/veracode-tui/services/applications"
+	"github.com/dipsylala/veracode-tui/veracode"
+	"github.com/rivo/tview"
+)
+
+// openInBrowser opens url in the default browser for the current platform
+func openInBrowser(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	case "darwin":
+		cmd = exec.Command("open", url)
+	default:
+		if os.Getenv("DISPLAY") == "" && os.Getenv("WAYLAND_DISPLAY") == "" {
+			return fmt.Errorf("no graphical display available")
+		}
+		cmd = exec.Command("xdg-open", url)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to launch browser: %w", err)
+	}
+
+	// Reap the opener process without blocking the UI
+	go func() {
+		_ = cmd.Wait()
+	}()
+	return nil
+}
+
+// applicationProfileURL returns the Veracode platform URL for an application's profile page.
+// Falls back to building the URL from the legacy ID when the API did not supply one.
+func applicationProfileURL(app *applications.Application) (string, error) {
+	if app.AppProfileURL != "" {
+		return veracode.BaseWebURL + "auth/index.jsp#" + app.AppProfileURL, nil
+	}
+	if app.LegacyID == 0 {
+		return "", fmt.Errorf("no profile URL or legacy ID available")
+	}
+	return fmt.Sprintf("%sauth/index.jsp#HomeAppProfile:%d:%d", veracode.BaseWebURL, app.OID, app.LegacyID), nil
+}
+
+// applicationResultsURL returns the Veracode platform URL for an application's latest scan results,
+// falling back to its profile page when the API did not supply a results URL. The returned label
+// names the page that will be opened.
+func applicationResultsURL(app *applications.Application) (url, label string, err error) {
+	if app == nil {
+		return "", "", fmt.Errorf("no application selected")
+	}
+	if app.ResultsURL != "" {
+		if strings.HasPrefix(app.ResultsURL, "https://") {
+			return app.ResultsURL, "scan results", nil
+		}
+		return veracode.BaseWebURL + "auth/index.jsp#" + app.ResultsURL, "scan results", nil
+	}
+	profileURL, err := applicationProfileURL(app)
+	if err != nil {
+		return "", "", fmt.Errorf("no results URL, profile URL or legacy ID available")
+	}
+	return profileURL, "application profile", nil
+}
+
+// openApplicationResults opens the selected application's scan results in the default browser,
+// reporting the outcome in statusBar
+func (ui *UI) openApplicationResults(statusBar *tview.TextView) {
+	resultsURL, label, err := applicationResultsURL(ui.selectedApp)
+	if err != nil {
+		ui.showTransientStatus(statusBar, fmt.Sprintf("[%s]Cannot open scan results: %v[-]", ui.theme.Warning, err))
+		return
+	}
+
+	if err := openInBrowser(resultsURL); err != nil {
+		ui.showTransientStatus(statusBar, fmt.Sprintf("[%s]Cannot open browser (%v):[-] %s", ui.theme.Warning, err, tview.Escape(resultsURL)))
+		return
+	}
+	ui.showTransientStatus(statusBar, fmt.Sprintf("[%s]✓ Opened %s in browser[-]", ui.theme.Success, label))
+}
+
+// selectedApplication returns the application for the selected applications table row
+func (ui *UI) selectedApplication() *applications.Application {
+	row, _ := ui.applicationsTable.GetSelection()
+	if row > 0 && row-1 < len(ui.applications) {
+		return &ui.applications[row-1]
+	}
+	return nil
+}
+
+// copySelectedApplicationGUID copies the selected application's GUID to the clipboard
+func (ui *UI) copySelectedApplicationGUID() {
+	app := ui.selectedApplication()
+	if app == nil {
+		return
+	}
+
+	if err := clipboard.WriteAll(app.GUID); err != nil {
+		ui.showTransientStatus(ui.statusBar, fmt.Sprintf("[%s]Clipboard unavailable (%v):[-] %s", ui.theme.Warning, err, tview.Escape(app.GUID)))
+		return
+	}
+	ui.showTransientStatus(ui.statusBar, fmt.Sprintf("[%s]✓ Application GUID copied to clipboard[-]", ui.theme.Success))
+}
+
+// openSelectedApplicationInBrowser opens the selected application's profile page in the default browser
+func (ui *UI) openSelectedApplicationInBrowser() {
+	app := ui.selectedApplication()
+	if app == nil {
+		return
+	}
+
+	profileURL, err := applicationProfileURL(app)
+	if err != nil {
+		ui.showTransientStatus(ui.statusBar, fmt.Sprintf("[%s]Cannot open application: %v[-]", ui.theme.Warning, err))
+		return
+	}
+
+	if err := openInBrowser(profileURL); err != nil {
+		ui.showTransientStatus(ui.statusBar, fmt.Sprintf("[%s]Cannot open browser (%v):[-] %s", ui.theme.Warning, err, tview.Escape(profileURL)))
+		return
+	}
+	ui.showTransientStatus(ui.statusBar, fmt.Sprintf("[%s]✓ Opened application profile in browser[-]", ui.theme.Success))
+}
@@ -0,0 +1,51 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/dipsylala/veracode-tui/services/applications"
+	"github.com/dipsylala/veracode-tui/veracode"
+)
+
+func TestApplicationResultsURL(t *testing.T) {
+	tests := []struct {
+		name      string
+		app       *applications.Application
+		wantURL   string
+		wantLabel string
+		wantErr   bool
+	}{
+		{
+			name:      "results URL fragment",
+			app:       &applications.Application{ResultsURL: "ViewReportsResultSummary:1:2:3", AppProfileURL: "HomeAppProfile:1:2"},
+			wantURL:   veracode.BaseWebURL + "auth/index.jsp#ViewReportsResultSummary:1:2:3",
+			wantLabel: "scan results",
+		},
+		{
+			name:      "absolute results URL",
+			app:       &applications.Application{ResultsURL: "https://example.com/results"},
+			wantURL:   "https://example.com/results",
+			wantLabel: "scan results",
+		},
+		{
+			name:      "falls back to profile URL",
+			app:       &applications.Application{AppProfileURL: "HomeAppProfile:1:2"},
+			wantURL:   veracode.BaseWebURL + "auth/index.jsp#HomeAppProfile:1:2",
+			wantLabel: "application profile",
+		},
+		{name: "nothing openable", app: &applications.Application{GUID: "app-guid"}, wantErr: true},
+		{name: "no application", app: nil, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			url, label, err := applicationResultsURL(tt.app)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("applicationResultsURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if url != tt.wantURL || label != tt.wantLabel {
+				t.Errorf("applicationResultsURL() = %q, %q, want %q, %q", url, label, tt.wantURL, tt.wantLabel)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/dipsylala/veracode-tui/services/annotations"
+	"github.com/dipsylala/veracode-tui/services/findings"
+	"github.com/dipsylala/veracode-tui/veracode"
+	"github.com/rivo/tview"
+)
+
+const (
+	cancelProposalPageName = "cancel-proposal-modal"
+
+	// cancelProposalComment is recorded as the reason on each canceled proposal
+	cancelProposalComment = "Mitigation proposal canceled"
+)
+
+// pendingProposalIDs returns the ids whose loaded finding has a mitigation proposal awaiting review
+func (ui *UI) pendingProposalIDs(ids []int64) []int64 {
+	pending := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		for i := range ui.allFindings {
+			if ui.allFindings[i].IssueID == id {
+				if findings.HasPendingProposal(&ui.allFindings[i]) {
+					pending = append(pending, id)
+				}
+				break
+			}
+		}
+	}
+	return pending
+}
+
+// cancelSelectedProposals asks to cancel the pending mitigation proposals on the selected findings,
+// or the finding on the current row when nothing is selected
+func (ui *UI) cancelSelectedProposals() {
+	if ui.findingsScanFilter == findings.ScanFilterSCA {
+		ui.showTransientStatus(ui.findingsCountsLabel, fmt.Sprintf("[%s]Mitigations are not available for SCA findings[-]", ui.theme.Warning))
+		return
+	}
+
+	ids := ui.batchSelectionIDs()
+	if len(ids) == 0 {
+		return
+	}
+	pending := ui.pendingProposalIDs(ids)
+	if len(pending) == 0 {
+		ui.showTransientStatus(ui.findingsCountsLabel, fmt.Sprintf("[%s]No pending mitigation proposal to cancel[-]", ui.theme.Warning))
+		return
+	}
+
+	ui.confirmCancelProposals(pending, len(ids)-len(pending), ui.findingsCountsLabel)
+}
+
+// cancelFindingProposal asks to cancel the pending mitigation proposal on the finding shown in the
+// finding detail view
+func (ui *UI) cancelFindingProposal(finding *findings.Finding, statusBar *tview.TextView) {
+	if !findings.HasPendingProposal(finding) {
+		ui.showTransientStatus(statusBar, fmt.Sprintf("[%s]This finding has no pending mitigation proposal to cancel[-]", ui.theme.Warning))
+		return
+	}
+	ui.confirmCancelProposals([]int64{finding.IssueID}, 0, statusBar)
+}
+
+// confirmCancelProposals asks for confirmation before canceling the proposals on ids, noting how
+// many chosen findings were skipped for having none. The outcome is shown on statusBar.
+func (ui *UI) confirmCancelProposals(ids []int64, skipped int, statusBar *tview.TextView) {
+	target := fmt.Sprintf("finding %d", ids[0])
+	if len(ids) > 1 {
+		target = fmt.Sprintf("%d findings", len(ids))
+	}
+	where := "the policy scan"
+	if ui.currentContextGUID() != "" {
+		where = fmt.Sprintf("sandbox '%s'", ui.currentContextName())
+	}
+	text := fmt.Sprintf("Cancel the pending mitigation proposal on %s in %s?\n\nVeracode records the cancellation as a rejection, so a new mitigation can be proposed.", target, where)
+	if skipped > 0 {
+		text += fmt.Sprintf("\n\n%d selected findings have no pending proposal and will be skipped.", skipped)
+	}
+
+	previousFocus := ui.app.GetFocus()
+	confirm := tview.NewModal().
+		SetText(text).
+		AddButtons([]string{"Cancel Proposal", "Keep"}).
+		SetDoneFunc(func(_ int, label string) {
+			ui.pages.RemovePage(cancelProposalPageName)
+			ui.app.SetFocus(previousFocus)
+			if label != "Cancel Proposal" {
+				return
+			}
+			if ui.annotationSubmitting {
+				ui.showTransientStatus(statusBar, fmt.Sprintf("[%s]Already submitting, please wait...[-]", ui.theme.Pending))
+				return
+			}
+			ui.annotationSubmitting = true
+			statusBar.SetText(fmt.Sprintf("[%s]Canceling proposals...[-]", ui.theme.Pending))
+			go ui.submitCancelProposals(ids, statusBar)
+		})
+	ui.pages.AddPage(cancelProposalPageName, confirm, true, true)
+	ui.app.SetFocus(confirm)
+}
+
+// submitCancelProposals cancels the proposals on ids, then re-fetches the findings to confirm their
+// resolution status changed and applies the refreshed status to the loaded findings
+func (ui *UI) submitCancelProposals(ids []int64, statusBar *tview.TextView) {
+	appGUID := ui.selectedApp.GUID
+	contextGUID := ui.currentContextGUID()
+
+	result, err := ui.annotationsService.CancelProposals(appGUID, ids, cancelProposalComment,
+		&annotations.CreateAnnotationOptions{Context: contextGUID})
+	if err != nil {
+		ui.queueUpdateDraw(func() {
+			ui.annotationSubmitting = false
+			statusBar.SetText(fmt.Sprintf("[%s]Error: %s[-]", ui.theme.Error, tview.Escape(veracode.UserMessage(err, "Finding"))))
+		})
+		return
+	}
+
+	// Annotating invalidates the application's cached responses, so this sees the new status
+	refreshed, refreshErr := ui.findingsService.GetAllFindings(appGUID, &findings.GetFindingsOptions{
+		Context:            contextGUID,
+		ScanType:           []string{string(findings.ScanTypeStatic), string(findings.ScanTypeDynamic)},
+		IncludeAnnotations: true,
+	}, nil)
+
+	ui.queueUpdateDraw(func() {
+		ui.annotationSubmitting = false
+		for _, id := range result.Succeeded {
+			delete(ui.selectedIssueIDs, id)
+		}
+
+		message := fmt.Sprintf("[%s]%s Canceled %d proposals[-]", ui.theme.Success, EmojiCheckMark, len(result.Succeeded))
+		if len(result.Failed) > 0 {
+			message = fmt.Sprintf("[%s]Canceled %d proposals; %d failed[-]", ui.theme.Warning, len(result.Succeeded), len(result.Failed))
+		}
+
+		if refreshErr != nil {
+			statusBar.SetText(fmt.Sprintf("%s [%s](could not refresh: %s)[-]", message, ui.theme.Warning, tview.Escape(veracode.UserMessage(refreshErr, "Finding"))))
+			return
+		}
+		if stillPending := ui.applyRefreshedProposals(result.Succeeded, refreshed); stillPending > 0 {
+			message = fmt.Sprintf("[%s]Canceled %d proposals, but %d still show as pending[-]", ui.theme.Warning, len(result.Succeeded), stillPending)
+		}
+		statusBar.SetText(message)
+	})
+}
+
+// applyRefreshedProposals copies the refreshed status and annotations of ids onto the loaded
+// findings and redraws them, returning how many still have a pending proposal
+func (ui *UI) applyRefreshedProposals(ids []int64, refreshed []findings.Finding) int {
+	byID := make(map[int64]*findings.Finding, len(refreshed))
+	for i := range refreshed {
+		byID[refreshed[i].IssueID] = &refreshed[i]
+	}
+
+	stillPending := 0
+	for _, id := range ids {
+		fresh, ok := byID[id]
+		if !ok {
+			continue
+		}
+		if findings.HasPendingProposal(fresh) {
+			stillPending++
+		}
+		for i := range ui.allFindings {
+			if ui.allFindings[i].IssueID == id {
+				ui.allFindings[i].FindingStatus = fresh.FindingStatus
+				ui.allFindings[i].Annotations = fresh.Annotations
+			}
+		}
+		if ui.selectedFinding != nil && ui.selectedFinding.IssueID == id {
+			ui.selectedFinding.FindingStatus = fresh.FindingStatus
+			ui.selectedFinding.Annotations = fresh.Annotations
+			if ui.findingAnnotationsView != nil {
+				ui.findingAnnotationsView.SetText(ui.buildAnnotationsContent(ui.selectedFinding))
+			}
+		}
+	}
+
+	ui.findings = ui.filterFindings(ui.allFindings, ui.findingsSearchQuery)
+	ui.renderFindingsTable()
+	return stillPending
+}
@@ -0,0 +1,132 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/atotto/clipboard"
+	"github.com/dipsylala/veracode-tui/services/findings"
+	"github.com/rivo/tview"
+)
+
+// statusMessageDuration is how long a transient message replaces the shortcuts bar
+const statusMessageDuration = 4 * time.Second
+
+// buildFindingSummary builds a plain-text summary of a finding suitable for pasting into a ticket
+func (ui *UI) buildFindingSummary(finding *findings.Finding) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("Issue ID: %d\n", finding.IssueID))
+	sb.WriteString(fmt.Sprintf("Scan Type: %s\n", finding.ScanType))
+
+	severity := ui.getFindingSeverity(finding)
+	sb.WriteString(fmt.Sprintf("Severity: %d - %s\n", severity, findings.SeverityName(severity)))
+
+	if details, ok := finding.FindingDetails.(map[string]interface{}); ok {
+		if cweData, ok := details["cwe"].(map[string]interface{}); ok {
+			cweID := ""
+			if id, ok := cweData["id"].(float64); ok {
+				cweID = fmt.Sprintf("%d", int(id))
+			} else if id, ok := cweData["id"].(string); ok {
+				cweID = id
+			}
+			if name := findings.FindingCWEName(finding); name != "" && cweID != "" {
+				sb.WriteString(fmt.Sprintf("CWE: CWE-%s - %s\n", cweID, name))
+			} else if cweID != "" {
+				sb.WriteString(fmt.Sprintf("CWE: CWE-%s\n", cweID))
+			}
+		}
+
+		if location := summaryLocation(finding.ScanType, details); location != "" {
+			sb.WriteString(fmt.Sprintf("Location: %s\n", location))
+		}
+	}
+
+	sb.WriteString(fmt.Sprintf("Policy: %s\n", policyStatusText(finding)))
+
+	return sb.String()
+}
+
+// summaryLocation returns the most useful location string for the scan type
+func summaryLocation(scanType findings.ScanType, details map[string]interface{}) string {
+	switch scanType {
+	case findings.ScanTypeDynamic:
+		if url, ok := details["url"].(string); ok && url != "" {
+			return url
+		}
+		if url, ok := details["URL"].(string); ok && url != "" {
+			return url
+		}
+	case findings.ScanTypeSCA:
+		component, _ := details["component_filename"].(string)
+		version, _ := details["version"].(string)
+		if component != "" && version != "" {
+			return component + "@" + version
+		}
+		return component
+	default:
+		path, _ := details["file_path"].(string)
+		if path == "" {
+			path, _ = details["file_name"].(string)
+		}
+		if path == "" {
+			return ""
+		}
+		if line, ok := details["file_line_number"].(float64); ok {
+			return fmt.Sprintf("%s:%d", path, int(line))
+		}
+		return path
+	}
+	return ""
+}
+
+// policyStatusText describes how the finding affects policy
+func policyStatusText(finding *findings.Finding) string {
+	if finding.FindingStatus != nil &&
+		(finding.FindingStatus.ResolutionStatus == findings.ResolutionApproved ||
+			finding.FindingStatus.MitigationReviewStatus == findings.ResolutionApproved) {
+		return "Mitigated (Approved)"
+	}
+	if finding.ViolatesPolicy {
+		return "Violates Policy"
+	}
+	return "Does not affect policy"
+}
+
+// copyFindingToClipboard copies the finding summary to the clipboard and reports the result in statusBar.
+// When no clipboard is available (e.g. headless or SSH sessions) the summary is shown in statusBar instead.
+func (ui *UI) copyFindingToClipboard(finding *findings.Finding, statusBar *tview.TextView) {
+	if finding == nil {
+		return
+	}
+
+	ui.copyTextToClipboard(ui.buildFindingSummary(finding), fmt.Sprintf("Finding %d", finding.IssueID), statusBar)
+}
+
+// copyTextToClipboard copies text to the clipboard, reporting "<what> copied" in statusBar. When no
+// clipboard is available the text is shown in statusBar on one line instead.
+func (ui *UI) copyTextToClipboard(text, what string, statusBar *tview.TextView) {
+	if err := clipboard.WriteAll(text); err != nil {
+		ui.warnf("Clipboard unavailable, showing %s in the status bar instead: %v", what, err)
+		oneLine := strings.ReplaceAll(strings.TrimSpace(text), "\n", " | ")
+		ui.showTransientStatus(statusBar, fmt.Sprintf("[%s]Clipboard unavailable (%v):[-] %s", ui.theme.Warning, err, tview.Escape(oneLine)))
+		return
+	}
+
+	ui.showTransientStatus(statusBar, fmt.Sprintf("[%s]✓ %s copied to clipboard[-]", ui.theme.Success, what))
+}
+
+// showTransientStatus temporarily replaces the text of statusBar, restoring it after a short delay
+func (ui *UI) showTransientStatus(statusBar *tview.TextView, message string) {
+	original := statusBar.GetText(false)
+	statusBar.SetText(message)
+
+	time.AfterFunc(statusMessageDuration, func() {
+		ui.queueUpdateDraw(func() {
+			if statusBar.GetText(false) == message {
+				statusBar.SetText(original)
+			}
+		})
+	})
+}
@@ -0,0 +1,71 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dipsylala/veracode-tui/services/applications"
+	"github.com/dipsylala/veracode-tui/services/findings"
+	"github.com/rivo/tview"
+)
+
+// markdownEscaper backslash-escapes the characters Markdown would otherwise treat as formatting
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", "*", `\*`, "_", `\_`, "{", `\{`, "}", `\}`, "[", `\[`, "]", `\]`,
+	"(", `\(`, ")", `\)`, "#", `\#`, "+", `\+`, "-", `\-`, "!", `\!`, "|", `\|`, "<", `\<`, ">", `\>`, "~", `\~`,
+)
+
+// escapeMarkdown escapes s so it renders as literal text in GitHub and Jira Markdown
+func escapeMarkdown(s string) string {
+	return markdownEscaper.Replace(s)
+}
+
+// findingToMarkdown formats a finding as a Markdown block for pasting into a GitHub issue or Jira
+// ticket: a heading with the CWE, the severity, location and policy status, the description and a
+// link to the application's scan results
+func (ui *UI) findingToMarkdown(app *applications.Application, f findings.Finding) string {
+	var sb strings.Builder
+
+	heading := fmt.Sprintf("Issue %d", f.IssueID)
+	if cweID := findings.CWEID(&f); cweID != 0 {
+		heading = fmt.Sprintf("CWE-%d", cweID)
+		if name := findings.FindingCWEName(&f); name != "" {
+			heading += ": " + name
+		}
+		heading += fmt.Sprintf(" (issue %d)", f.IssueID)
+	}
+	sb.WriteString(fmt.Sprintf("### %s\n\n", escapeMarkdown(heading)))
+
+	severity := ui.getFindingSeverity(&f)
+	sb.WriteString(fmt.Sprintf("**Severity:** `%d - %s` | **Scan:** %s | **Policy:** %s\n\n",
+		severity, findings.SeverityName(severity), escapeMarkdown(string(f.ScanType)), escapeMarkdown(policyStatusText(&f))))
+
+	if details, ok := f.FindingDetails.(map[string]interface{}); ok {
+		if location := summaryLocation(f.ScanType, details); location != "" {
+			sb.WriteString(fmt.Sprintf("**Location:** `%s`\n\n", strings.ReplaceAll(location, "`", "'")))
+		}
+	}
+	if name := app.Name(); name != "" {
+		sb.WriteString(fmt.Sprintf("**Application:** %s\n\n", escapeMarkdown(name)))
+	}
+
+	if f.Description != "" {
+		sb.WriteString(escapeMarkdown(findingDescriptionText(&f)))
+		sb.WriteString("\n\n")
+	}
+
+	if resultsURL, label, err := applicationResultsURL(app); err == nil {
+		sb.WriteString(fmt.Sprintf("[View the %s in Veracode](%s)\n", label, resultsURL))
+	}
+
+	return sb.String()
+}
+
+// copyFindingAsMarkdown copies the finding to the clipboard as Markdown, reporting the result in statusBar
+func (ui *UI) copyFindingAsMarkdown(finding *findings.Finding, statusBar *tview.TextView) {
+	if finding == nil {
+		return
+	}
+
+	ui.copyTextToClipboard(ui.findingToMarkdown(ui.selectedApp, *finding), fmt.Sprintf("Finding %d as Markdown", finding.IssueID), statusBar)
+}
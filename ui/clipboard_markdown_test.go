@@ -0,0 +1,62 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dipsylala/veracode-tui/services/applications"
+	"github.com/dipsylala/veracode-tui/services/findings"
+	"github.com/dipsylala/veracode-tui/veracode"
+)
+
+func TestFindingToMarkdown(t *testing.T) {
+	app := &applications.Application{
+		Profile:    &applications.ApplicationProfile{Name: "Payments"},
+		ResultsURL: "ViewReportsResultSummary:1:2:3",
+	}
+	finding := findings.Finding{
+		IssueID:        42,
+		ScanType:       findings.ScanTypeStatic,
+		ViolatesPolicy: true,
+		Description:    "<span>Use *prepared* statements_here [now]</span>",
+		FindingDetails: map[string]interface{}{
+			"severity":         float64(4),
+			"cwe":              map[string]interface{}{"id": float64(89), "name": "SQL Injection"},
+			"file_path":        "src/db/query.go",
+			"file_line_number": float64(17),
+		},
+	}
+
+	got := (&UI{}).findingToMarkdown(app, finding)
+
+	for _, want := range []string{
+		"### CWE\\-89: SQL Injection \\(issue 42\\)\n",
+		"**Severity:** `4 - High`",
+		"**Policy:** Violates Policy",
+		"**Location:** `src/db/query.go:17`",
+		"**Application:** Payments",
+		"Use \\*prepared\\* statements\\_here \\[now\\]",
+		"[View the scan results in Veracode](" + veracode.BaseWebURL + "auth/index.jsp#ViewReportsResultSummary:1:2:3)",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Expected markdown to contain %q, got:\n%s", want, got)
+		}
+	}
+}
+
+func TestFindingToMarkdown_WithoutCWEOrApplication(t *testing.T) {
+	got := (&UI{}).findingToMarkdown(nil, findings.Finding{IssueID: 7, ScanType: findings.ScanTypeDynamic})
+
+	if !strings.HasPrefix(got, "### Issue 7\n") {
+		t.Errorf("Expected the issue ID as the heading, got:\n%s", got)
+	}
+	if strings.Contains(got, "View the") {
+		t.Errorf("Expected no results link without an application, got:\n%s", got)
+	}
+}
+
+func TestEscapeMarkdown(t *testing.T) {
+	if got, want := escapeMarkdown("a_b *c* `d` # e"), "a\\_b \\*c\\* \\`d\\` \\# e"; got != want {
+		t.Errorf("escapeMarkdown() = %q, want %q", got, want)
+	}
+}
@@ -1,52 +1,56 @@
 package ui
 
-// Theme defines the color scheme for the TUI
+// Theme defines the color scheme for the TUI. Field tags name the keys of a theme file.
 type Theme struct {
 	// Text colors
-	DefaultText   string
-	SecondaryText string
-	DimmedText    string
+	DefaultText   string `yaml:"default-text"`
+	SecondaryText string `yaml:"secondary-text"`
+	DimmedText    string `yaml:"dimmed-text"`
 
 	// Label and header colors
-	Label        string
-	ColumnHeader string
-	Separator    string
+	Label        string `yaml:"label"`
+	ColumnHeader string `yaml:"column-header"`
+	Separator    string `yaml:"separator"`
 
 	// Status and severity colors
-	Error   string
-	Warning string
-	Info    string
-	Success string
-	InfoAlt string
+	Error   string `yaml:"error"`
+	Warning string `yaml:"warning"`
+	Info    string `yaml:"info"`
+	Success string `yaml:"success"`
+	InfoAlt string `yaml:"info-alt"`
 
 	// Interactive element colors
-	New      string
-	Approved string
-	Rejected string
-	Pending  string
+	New      string `yaml:"new"`
+	Approved string `yaml:"approved"`
+	Rejected string `yaml:"rejected"`
+	Pending  string `yaml:"pending"`
 
 	// UI component colors
-	Border                     string
-	BorderFocused              string
-	SelectionBackground        string
-	SelectionForeground        string
-	DropDownBackground         string
-	DropDownText               string
-	DropDownSelectedBackground string
-	DropDownSelectedForeground string
+	Border                     string `yaml:"border"`
+	BorderFocused              string `yaml:"border-focused"`
+	SelectionBackground        string `yaml:"selection-background"`
+	SelectionForeground        string `yaml:"selection-foreground"`
+	DropDownBackground         string `yaml:"drop-down-background"`
+	DropDownText               string `yaml:"drop-down-text"`
+	DropDownSelectedBackground string `yaml:"drop-down-selected-background"`
+	DropDownSelectedForeground string `yaml:"drop-down-selected-foreground"`
 
 	// Severity level colors
-	SeverityVeryHigh string
-	SeverityHigh     string
-	SeverityMedium   string
-	SeverityLow      string
-	SeverityVeryLow  string
-	SeverityDefault  string
+	SeverityVeryHigh string `yaml:"severity-very-high"`
+	SeverityHigh     string `yaml:"severity-high"`
+	SeverityMedium   string `yaml:"severity-medium"`
+	SeverityLow      string `yaml:"severity-low"`
+	SeverityVeryLow  string `yaml:"severity-very-low"`
+	SeverityDefault  string `yaml:"severity-default"`
 
 	// Policy compliance colors
-	PolicyPass    string
-	PolicyFail    string
-	PolicyNeutral string
+	PolicyPass    string `yaml:"policy-pass"`
+	PolicyFail    string `yaml:"policy-fail"`
+	PolicyNeutral string `yaml:"policy-neutral"`
+
+	// SeverityLabels shows severity names and symbols alongside the severity color,
+	// so severity is not conveyed by color alone
+	SeverityLabels bool `yaml:"severity-labels"`
 }
 
 //nolint:dupl // Theme functions have structural duplication - each theme defines all color fields
@@ -256,3 +260,110 @@ func MatrixTheme() *Theme {
 		PolicyNeutral: "#00FFFF", // Cyan for neutral
 	}
 }
+
+//nolint:dupl // Theme functions have structural duplication - each theme defines all color fields
+func SolarizedDarkTheme() *Theme {
+	return &Theme{
+		// Text colors - Solarized base tones
+		DefaultText:   "#93A1A1", // base1
+		SecondaryText: "#839496", // base0
+		DimmedText:    "#586E75", // base01
+
+		// Label and header colors
+		Label:        "#839496",
+		ColumnHeader: "#268BD2", // blue
+		Separator:    "#073642", // base02
+
+		// Status and severity colors
+		Error:   "#DC322F", // red
+		Warning: "#CB4B16", // orange
+		Info:    "#B58900", // yellow
+		Success: "#859900", // green
+		InfoAlt: "#2AA198", // cyan
+
+		// Interactive element colors
+		New:      "#B58900",
+		Approved: "#859900",
+		Rejected: "#DC322F",
+		Pending:  "#B58900",
+
+		// UI component colors
+		Border:                     "#586E75",
+		BorderFocused:              "#268BD2",
+		SelectionBackground:        "#073642",
+		SelectionForeground:        "#FDF6E3", // base3
+		DropDownBackground:         "#073642",
+		DropDownText:               "#93A1A1",
+		DropDownSelectedBackground: "#268BD2",
+		DropDownSelectedForeground: "#FDF6E3",
+
+		// Severity level colors
+		SeverityVeryHigh: "#DC322F", // red
+		SeverityHigh:     "#CB4B16", // orange
+		SeverityMedium:   "#B58900", // yellow
+		SeverityLow:      "#2AA198", // cyan
+		SeverityVeryLow:  "#586E75", // base01
+		SeverityDefault:  "#93A1A1",
+
+		// Policy compliance colors
+		PolicyPass:    "#859900",
+		PolicyFail:    "#DC322F",
+		PolicyNeutral: "#839496",
+	}
+}
+
+// HighContrastTheme uses pure white, black and fully saturated colors for the greatest
+// separation from the background, and labels severities so they are not conveyed by color alone
+//
+//nolint:dupl // Theme functions have structural duplication - each theme defines all color fields
+func HighContrastTheme() *Theme {
+	return &Theme{
+		// Text colors - no dimmed grays
+		DefaultText:   "#FFFFFF",
+		SecondaryText: "#FFFFFF",
+		DimmedText:    "#C0C0C0",
+
+		// Label and header colors
+		Label:        "#00FFFF",
+		ColumnHeader: "#FFFF00",
+		Separator:    "#FFFFFF",
+
+		// Status and severity colors
+		Error:   "#FF5555",
+		Warning: "#FFFF00",
+		Info:    "#00FFFF",
+		Success: "#00FF00",
+		InfoAlt: "#FFFFFF",
+
+		// Interactive element colors
+		New:      "#FFFF00",
+		Approved: "#00FF00",
+		Rejected: "#FF5555",
+		Pending:  "#00FFFF",
+
+		// UI component colors - black on yellow selections
+		Border:                     "#FFFFFF",
+		BorderFocused:              "#FFFF00",
+		SelectionBackground:        "#FFFF00",
+		SelectionForeground:        "#000000",
+		DropDownBackground:         "#000000",
+		DropDownText:               "#FFFFFF",
+		DropDownSelectedBackground: "#FFFF00",
+		DropDownSelectedForeground: "#000000",
+
+		// Severity level colors
+		SeverityVeryHigh: "#FF5555",
+		SeverityHigh:     "#FF00FF",
+		SeverityMedium:   "#FFFF00",
+		SeverityLow:      "#00FFFF",
+		SeverityVeryLow:  "#FFFFFF",
+		SeverityDefault:  "#FFFFFF",
+
+		// Policy compliance colors
+		PolicyPass:    "#00FF00",
+		PolicyFail:    "#FF5555",
+		PolicyNeutral: "#FFFFFF",
+
+		SeverityLabels: true,
+	}
+}
@@ -0,0 +1,45 @@
+package ui
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+func TestThemes_AllColorsValid(t *testing.T) {
+	themes := map[string]*Theme{
+		"default":       DefaultTheme(),
+		"monochrome":    MonochromeTheme(),
+		"hotdog":        HotdogTheme(),
+		"matrix":        MatrixTheme(),
+		"solarized":     SolarizedDarkTheme(),
+		"high-contrast": HighContrastTheme(),
+	}
+
+	for name, theme := range themes {
+		t.Run(name, func(t *testing.T) {
+			value := reflect.ValueOf(theme).Elem()
+			for i := 0; i < value.NumField(); i++ {
+				if value.Field(i).Kind() != reflect.String {
+					continue
+				}
+				field := value.Type().Field(i).Name
+				color := value.Field(i).String()
+				if color == "" {
+					t.Errorf("%s is empty", field)
+					continue
+				}
+				if tcell.GetColor(color) == tcell.ColorDefault {
+					t.Errorf("%s: %q is not a valid color", field, color)
+				}
+			}
+		})
+	}
+}
+
+func TestHighContrastTheme_LabelsSeverity(t *testing.T) {
+	if !HighContrastTheme().SeverityLabels {
+		t.Error("Expected the high-contrast theme to label severities")
+	}
+}
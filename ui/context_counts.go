@@ -0,0 +1,125 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const (
+	// contextCountWorkers is the number of context finding counts fetched concurrently
+	contextCountWorkers = 4
+
+	// contextCountsColumn is the column of the contexts table showing the finding counts
+	contextCountsColumn = 5
+
+	// contextCountFailed marks a count that could not be loaded; it is retried the next time the
+	// application is opened
+	contextCountFailed = -1
+)
+
+// contextCountKey identifies the finding count of a context (empty for policy) of an application
+func contextCountKey(appGUID, contextGUID string) string {
+	return appGUID + "/" + contextGUID
+}
+
+// contextFindingCountCell returns the findings column cell for a context, showing a placeholder
+// until its count has loaded
+func (ui *UI) contextFindingCountCell(appGUID, contextGUID string) *tview.TableCell {
+	count, ok := ui.contextCounts[contextCountKey(appGUID, contextGUID)]
+	switch {
+	case !ok:
+		return tview.NewTableCell("…").
+			SetTextColor(tcell.GetColor(ui.theme.SecondaryText)).
+			SetExpansion(1)
+	case count == contextCountFailed:
+		return tview.NewTableCell("-").
+			SetTextColor(tcell.GetColor(ui.theme.SecondaryText)).
+			SetExpansion(1)
+	default:
+		return tview.NewTableCell(fmt.Sprintf("%d", count)).SetExpansion(1)
+	}
+}
+
+// loadContextFindingCounts fetches, in the background, the finding count of the policy context and
+// every sandbox of the selected application that is not already cached for the session, filling in
+// the contexts table as each arrives. It must be called on the UI goroutine once the sandboxes have loaded.
+func (ui *UI) loadContextFindingCounts() {
+	ui.stopContextFindingCounts()
+	if ui.selectedApp == nil {
+		return
+	}
+	if ui.contextCounts == nil {
+		ui.contextCounts = make(map[string]int64)
+	}
+
+	appGUID := ui.selectedApp.GUID
+	contextGUIDs := []string{""}
+	for _, sandbox := range ui.sandboxes {
+		contextGUIDs = append(contextGUIDs, sandbox.GUID)
+	}
+
+	pending := make([]string, 0, len(contextGUIDs))
+	for _, guid := range contextGUIDs {
+		if count, ok := ui.contextCounts[contextCountKey(appGUID, guid)]; !ok || count == contextCountFailed {
+			pending = append(pending, guid)
+		}
+	}
+	if len(pending) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(ui.ctx)
+	ui.contextCountsCancel = cancel
+	go ui.findingsService.CountFindingsByContext(ctx, appGUID, pending, contextCountWorkers,
+		func(contextGUID string, count int64, err error) {
+			if err != nil {
+				count = contextCountFailed
+			}
+			ui.queueUpdateDraw(func() {
+				if ctx.Err() != nil {
+					return
+				}
+				ui.contextCounts[contextCountKey(appGUID, contextGUID)] = count
+				if row := ui.contextRow(contextGUID); row > 0 {
+					ui.contextsTable.SetCell(row, contextCountsColumn, ui.contextFindingCountCell(appGUID, contextGUID))
+				}
+			})
+		})
+}
+
+// stopContextFindingCounts cancels any context finding counts still loading. It must be called on
+// the UI goroutine.
+func (ui *UI) stopContextFindingCounts() {
+	if ui.contextCountsCancel != nil {
+		ui.contextCountsCancel()
+		ui.contextCountsCancel = nil
+	}
+}
+
+// clearContextFindingCounts discards the cached finding counts of an application so they are
+// fetched again
+func (ui *UI) clearContextFindingCounts(appGUID string) {
+	prefix := contextCountKey(appGUID, "")
+	for key := range ui.contextCounts {
+		if strings.HasPrefix(key, prefix) {
+			delete(ui.contextCounts, key)
+		}
+	}
+}
+
+// contextRow returns the contexts table row of a context (empty for policy), or -1 when it is not shown
+func (ui *UI) contextRow(contextGUID string) int {
+	if contextGUID == "" {
+		return 1
+	}
+	for i, sandbox := range ui.sandboxes {
+		if sandbox.GUID == contextGUID {
+			return i + 2
+		}
+	}
+	return -1
+}
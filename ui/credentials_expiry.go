@@ -0,0 +1,61 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dipsylala/veracode-tui/services/identity"
+)
+
+// credentialsExpiryWarningDays is how soon before the API credentials expire the header warns
+const credentialsExpiryWarningDays = 14
+
+// credentialsExpiryMessage describes credentials that are revoked, expired or expire within
+// credentialsExpiryWarningDays of now. It returns "" when there is nothing to warn about, and
+// reports whether the credentials no longer work.
+func credentialsExpiryMessage(creds *identity.APICredentials, now time.Time) (message string, unusable bool) {
+	if creds.IsRevoked() {
+		return fmt.Sprintf("API credentials were revoked on %s", creds.RevocationTS.Local().Format("2006-01-02")), true
+	}
+
+	days, ok := creds.DaysUntilExpiry(now)
+	if !ok {
+		return "", false
+	}
+	expiry := creds.ExpirationTS.Local().Format("2006-01-02")
+	switch {
+	case !creds.ExpirationTS.After(now):
+		return fmt.Sprintf("API credentials expired on %s", expiry), true
+	case days == 0:
+		return fmt.Sprintf("API credentials expire within a day (%s)", expiry), false
+	case days == 1:
+		return fmt.Sprintf("API credentials expire in 1 day (%s)", expiry), false
+	case days <= credentialsExpiryWarningDays:
+		return fmt.Sprintf("API credentials expire in %d days (%s)", days, expiry), false
+	default:
+		return "", false
+	}
+}
+
+// checkCredentialsExpiry fetches the API credentials and shows a warning in the header when they
+// are revoked, expired or expiring soon. Failing to fetch them is not reported, as the startup check
+// has already shown the API is reachable.
+func (ui *UI) checkCredentialsExpiry() {
+	creds, err := ui.identityService.GetAPICredentials(ui.ctx)
+	if err != nil {
+		return
+	}
+
+	message, unusable := credentialsExpiryMessage(creds, time.Now())
+	if message == "" {
+		return
+	}
+	color := ui.theme.Warning
+	if unusable {
+		color = ui.theme.Error
+	}
+	ui.queueUpdateDraw(func() {
+		ui.credentialsWarning = fmt.Sprintf("[%s]⚠ %s[-]", color, message)
+		ui.updateHeader()
+	})
+}
@@ -0,0 +1,42 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dipsylala/veracode-tui/services/identity"
+)
+
+func TestCredentialsExpiryMessage(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		creds        *identity.APICredentials
+		wantContains string
+		wantUnusable bool
+	}{
+		{"no expiry", &identity.APICredentials{}, "", false},
+		{"far future", &identity.APICredentials{ExpirationTS: now.AddDate(0, 3, 0)}, "", false},
+		{"expiring soon", &identity.APICredentials{ExpirationTS: now.Add(5*24*time.Hour + time.Hour)}, "expire in 5 days", false},
+		{"expiring within a day", &identity.APICredentials{ExpirationTS: now.Add(time.Hour)}, "expire within a day", false},
+		{"expired", &identity.APICredentials{ExpirationTS: now.Add(-time.Hour)}, "expired on", true},
+		{"revoked", &identity.APICredentials{ExpirationTS: now.AddDate(1, 0, 0), RevocationTS: now.AddDate(0, 0, -2)}, "revoked on", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message, unusable := credentialsExpiryMessage(tt.creds, now)
+			if tt.wantContains == "" && message != "" {
+				t.Errorf("Expected no warning, got %q", message)
+			}
+			if !strings.Contains(message, tt.wantContains) {
+				t.Errorf("Expected the warning to contain %q, got %q", tt.wantContains, message)
+			}
+			if unusable != tt.wantUnusable {
+				t.Errorf("unusable = %v, want %v", unusable, tt.wantUnusable)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dipsylala/veracode-tui/services/applications"
+	"github.com/dipsylala/veracode-tui/veracode"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const dashboardPageName = "dashboard"
+
+// DashboardStats are the organization-wide rollups shown on the dashboard
+type DashboardStats struct {
+	Total          int
+	ByCompliance   map[string]int // Keyed by policy compliance status; "" counts applications without a policy
+	ByScanStatus   map[string]int // Keyed by latest scan status; "" counts applications without scans
+	ModifiedLast7  int
+	ModifiedLast30 int
+}
+
+// buildDashboard computes the dashboard rollups for apps. The scan status is that of the first
+// embedded scan, as shown in the applications table.
+func (ui *UI) buildDashboard(apps []applications.Application) DashboardStats {
+	now := time.Now()
+	stats := DashboardStats{
+		Total:        len(apps),
+		ByCompliance: make(map[string]int),
+		ByScanStatus: make(map[string]int),
+	}
+	for i := range apps {
+		app := &apps[i]
+		stats.ByCompliance[app.PolicyComplianceStatus()]++
+
+		scanStatus := ""
+		if len(app.Scans) > 0 {
+			scanStatus = app.Scans[0].Status
+		}
+		stats.ByScanStatus[scanStatus]++
+
+		if app.Modified == nil {
+			continue
+		}
+		age := now.Sub(*app.Modified)
+		if age <= 7*24*time.Hour {
+			stats.ModifiedLast7++
+		}
+		if age <= 30*24*time.Hour {
+			stats.ModifiedLast30++
+		}
+	}
+	return stats
+}
+
+// showDashboard opens the dashboard page and loads every application to summarize
+func (ui *UI) showDashboard() {
+	view := tview.NewTextView().
+		SetDynamicColors(true).
+		SetWordWrap(true).
+		SetText(fmt.Sprintf("[%s]Loading applications...[-]", ui.theme.Pending))
+	view.SetBorder(true).
+		SetTitle(" Dashboard ").
+		SetTitleAlign(tview.AlignLeft).
+		SetBorderColor(tcell.GetColor(ui.theme.BorderFocused)).
+		SetBorderPadding(1, 1, 2, 2)
+
+	shortcutsBar := tview.NewTextView().
+		SetDynamicColors(true).
+		SetTextAlign(tview.AlignCenter).
+		SetText(fmt.Sprintf("[%s]ESC/q[-] Back", ui.theme.Info))
+
+	flex := tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(view, 0, 1, true).
+		AddItem(shortcutsBar, 1, 0, false)
+
+	view.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape || event.Rune() == 'q' {
+			ui.closeDashboard()
+			return nil
+		}
+		return event
+	})
+
+	ui.pages.AddAndSwitchToPage(dashboardPageName, flex, true)
+	ui.app.SetFocus(view)
+	go ui.loadDashboard(view)
+}
+
+// loadDashboard fetches every application and renders the rollups into view. If the full fetch
+// fails, the applications on the current page are summarized instead.
+func (ui *UI) loadDashboard(view *tview.TextView) {
+	ui.beginRequest()
+	defer ui.endRequest()
+
+	apps, err := ui.appService.GetAllApplications(nil, func(fetched, total int) {
+		ui.queueUpdateDraw(func() {
+			view.SetText(fmt.Sprintf("[%s]Loading applications... %d of %d[-]", ui.theme.Pending, fetched, total))
+		})
+	})
+
+	ui.queueUpdateDraw(func() {
+		note := ""
+		if err != nil {
+			apps = ui.applications
+			note = fmt.Sprintf("[%s]Could not load all applications (%s); showing the current page only.[-]\n\n",
+				ui.theme.Warning, tview.Escape(veracode.UserMessage(err, "Applications")))
+		}
+		view.SetText(note + ui.renderDashboard(ui.buildDashboard(apps)))
+		view.ScrollToBeginning()
+	})
+}
+
+// renderDashboard formats the rollups as labeled counters colored by status
+func (ui *UI) renderDashboard(stats DashboardStats) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("[%s::b]Applications[::-]\n", ui.theme.ColumnHeader))
+	sb.WriteString(fmt.Sprintf("  [%s]Total:[-] %d\n", ui.theme.Label, stats.Total))
+	sb.WriteString(fmt.Sprintf("  [%s]Modified in the last 7 days:[-] %d\n", ui.theme.Label, stats.ModifiedLast7))
+	sb.WriteString(fmt.Sprintf("  [%s]Modified in the last 30 days:[-] %d\n\n", ui.theme.Label, stats.ModifiedLast30))
+
+	sb.WriteString(fmt.Sprintf("[%s::b]Policy Compliance[::-]\n", ui.theme.ColumnHeader))
+	for _, status := range sortedDashboardKeys(stats.ByCompliance) {
+		label := status
+		if label == "" {
+			label = "No policy"
+		}
+		sb.WriteString(fmt.Sprintf("  [%s]%-24s[-] %d\n", ui.policyStatusColor(status), tview.Escape(label), stats.ByCompliance[status]))
+	}
+
+	sb.WriteString(fmt.Sprintf("\n[%s::b]Scan Status[::-]\n", ui.theme.ColumnHeader))
+	for _, status := range sortedDashboardKeys(stats.ByScanStatus) {
+		label := status
+		if label == "" {
+			label = "No scans"
+		}
+		sb.WriteString(fmt.Sprintf("  [%s]%-24s[-] %d\n", ui.dashboardScanStatusColor(status), tview.Escape(label), stats.ByScanStatus[status]))
+	}
+
+	return sb.String()
+}
+
+// dashboardScanStatusColor colors published scans as successful, failed or canceled scans as
+// errors, and anything still running as pending
+func (ui *UI) dashboardScanStatusColor(status string) string {
+	switch status {
+	case "":
+		return ui.theme.DimmedText
+	case "PUBLISHED", "UNPUBLISHED":
+		return ui.theme.Success
+	case "ANALYSIS_ERRORS", "SCAN_CANCELED", "DELETED":
+		return ui.theme.Error
+	default:
+		return ui.theme.Pending
+	}
+}
+
+// sortedDashboardKeys returns the keys of counts, largest count first, then alphabetically
+func sortedDashboardKeys(counts map[string]int) []string {
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	return keys
+}
+
+// closeDashboard removes the dashboard page and returns to the applications list
+func (ui *UI) closeDashboard() {
+	ui.pages.RemovePage(dashboardPageName)
+	ui.pages.SwitchToPage("applications")
+	ui.app.SetFocus(ui.applicationsTable)
+}
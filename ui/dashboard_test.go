@@ -0,0 +1,66 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dipsylala/veracode-tui/services/applications"
+)
+
+func TestBuildDashboard(t *testing.T) {
+	daysAgo := func(days int) *time.Time {
+		when := time.Now().Add(-time.Duration(days) * 24 * time.Hour)
+		return &when
+	}
+	app := func(compliance, scanStatus string, modified *time.Time) applications.Application {
+		a := applications.Application{Modified: modified}
+		if compliance != "" {
+			a.Profile = &applications.ApplicationProfile{Policies: []applications.AppPolicy{{PolicyComplianceStatus: compliance}}}
+		}
+		if scanStatus != "" {
+			a.Scans = []applications.ApplicationScan{{Status: scanStatus}}
+		}
+		return a
+	}
+	apps := []applications.Application{
+		app("PASSED", "PUBLISHED", daysAgo(1)),
+		app("PASSED", "PUBLISHED", daysAgo(10)),
+		app("DID_NOT_PASS", "SCAN_IN_PROGRESS", daysAgo(6)),
+		app("DID_NOT_PASS", "PUBLISHED", daysAgo(45)),
+		app("", "", nil),
+	}
+
+	stats := (&UI{}).buildDashboard(apps)
+
+	if stats.Total != 5 {
+		t.Errorf("Expected 5 applications, got %d", stats.Total)
+	}
+	wantCompliance := map[string]int{"PASSED": 2, "DID_NOT_PASS": 2, "": 1}
+	for status, want := range wantCompliance {
+		if got := stats.ByCompliance[status]; got != want {
+			t.Errorf("Expected %d applications with compliance %q, got %d", want, status, got)
+		}
+	}
+	wantScans := map[string]int{"PUBLISHED": 3, "SCAN_IN_PROGRESS": 1, "": 1}
+	for status, want := range wantScans {
+		if got := stats.ByScanStatus[status]; got != want {
+			t.Errorf("Expected %d applications with scan status %q, got %d", want, status, got)
+		}
+	}
+	if stats.ModifiedLast7 != 2 {
+		t.Errorf("Expected 2 applications modified in the last 7 days, got %d", stats.ModifiedLast7)
+	}
+	if stats.ModifiedLast30 != 3 {
+		t.Errorf("Expected 3 applications modified in the last 30 days, got %d", stats.ModifiedLast30)
+	}
+}
+
+func TestSortedDashboardKeys(t *testing.T) {
+	got := sortedDashboardKeys(map[string]int{"b": 2, "a": 2, "c": 5, "": 1})
+	want := []string{"c", "a", "b", ""}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Expected %q, got %q", want, got)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/atotto/clipboard"
+	"github.com/dipsylala/veracode-tui/services/findings"
+	"github.com/rivo/tview"
+)
+
+// dataPathText returns a data path as plain text, formatted by renderDataPath so the copy matches
+// what is displayed
+func (ui *UI) dataPathText(dp findings.DataPath) string {
+	return tview.NewTextView().
+		SetDynamicColors(true).
+		SetText(ui.renderDataPath(dp)).
+		GetText(true)
+}
+
+// copyDataPathToClipboard copies the data path shown in the finding detail view. When no clipboard
+// is available the data path is written to a temporary file instead, as a call stack does not fit
+// on the status bar.
+func (ui *UI) copyDataPathToClipboard(statusBar *tview.TextView) {
+	info := ui.currentStaticFlawInfo
+	if info == nil || len(info.DataPaths) == 0 || ui.currentDataPathIndex >= len(info.DataPaths) {
+		ui.showTransientStatus(statusBar, fmt.Sprintf("[%s]No data path to copy[-]", ui.theme.Warning))
+		return
+	}
+	text := ui.dataPathText(info.DataPaths[ui.currentDataPathIndex])
+
+	if err := clipboard.WriteAll(text); err == nil {
+		ui.showTransientStatus(statusBar, fmt.Sprintf("[%s]✓ Data path copied to clipboard[-]", ui.theme.Success))
+		return
+	}
+
+	path, err := writeTempText("veracode-data-path-*.txt", text)
+	if err != nil {
+		ui.showTransientStatus(statusBar, fmt.Sprintf("[%s]Clipboard unavailable and the data path could not be saved: %s[-]", ui.theme.Error, tview.Escape(err.Error())))
+		return
+	}
+	ui.showTransientStatus(statusBar, fmt.Sprintf("[%s]Clipboard unavailable, data path saved to %s[-]", ui.theme.Warning, tview.Escape(path)))
+}
+
+// writeTempText writes text to a new file in the temporary directory, named from pattern as for
+// os.CreateTemp, and returns its path
+func writeTempText(pattern, text string) (string, error) {
+	f, err := os.CreateTemp("", pattern)
+	if err != nil {
+		return "", err
+	}
+	if _, err := f.WriteString(strings.TrimRight(text, "\n") + "\n"); err != nil {
+		_ = f.Close()
+		return "", err
+	}
+	return f.Name(), f.Close()
+}
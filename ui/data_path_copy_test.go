@@ -0,0 +1,62 @@
+package ui
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/dipsylala/veracode-tui/services/findings"
+)
+
+func TestDataPathText(t *testing.T) {
+	ui := &UI{theme: DefaultTheme()}
+	dp := findings.DataPath{
+		ModuleName:   "app.war",
+		Steps:        2,
+		FunctionName: "com.example.Orders.find",
+		LocalPath:    "src/main/java/com/example/Orders.java",
+		LineNumber:   42,
+		Calls: []findings.Call{
+			{DataPath: 1, FunctionName: "getParameter", FilePath: "src/main/java/com/example/Web.java", LineNumber: 10},
+			{DataPath: 2, FunctionName: "query[0]", FileName: "Dao.java", LineNumber: 77},
+		},
+	}
+
+	text := ui.dataPathText(dp)
+
+	for _, want := range []string{
+		"Module: app.war\n",
+		"Function: com.example.Orders.find\n",
+		"Location: src/main/java/com/example/Orders.java:42\n",
+		"└─ Step 2: query[0]\n",
+		"Dao.java:77\n",
+		"└─ Step 1: getParameter\n",
+		"src/main/java/com/example/Web.java:10\n",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Expected %q in the copied data path, got:\n%s", want, text)
+		}
+	}
+	if strings.Index(text, "Step 2") > strings.Index(text, "Step 1") {
+		t.Errorf("Expected the most recent call first, as displayed, got:\n%s", text)
+	}
+	if strings.Contains(text, "[-]") {
+		t.Errorf("Expected no color tags in the copied data path, got:\n%s", text)
+	}
+}
+
+func TestWriteTempText(t *testing.T) {
+	path, err := writeTempText("veracode-test-*.txt", "line one\nline two\n\n")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	defer os.Remove(path)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "line one\nline two\n" {
+		t.Errorf("Expected the text with one trailing newline, got %q", data)
+	}
+}
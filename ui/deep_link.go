@@ -0,0 +1,121 @@
+package ui
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/dipsylala/veracode-tui/services/findings"
+	"github.com/dipsylala/veracode-tui/veracode"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// guidPattern matches the GUIDs Veracode uses to identify applications
+var guidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// deepLinkPageName is the page describing a launch target that could not be opened
+const deepLinkPageName = "deeplink-error"
+
+// SetInitialTarget opens the application with appGUID once the applications list has loaded, and,
+// when issueID is not zero, that finding's detail in the policy scan. Pressing ESC from the target
+// returns through the usual views to the applications list.
+func (ui *UI) SetInitialTarget(appGUID string, issueID int64) {
+	ui.initialAppGUID = appGUID
+	ui.initialIssueID = issueID
+}
+
+// openInitialTarget resolves the launch target set by SetInitialTarget, showing an error page when
+// the application or finding cannot be found. It runs on a background goroutine after the first load.
+func (ui *UI) openInitialTarget() {
+	appGUID, issueID := ui.initialAppGUID, ui.initialIssueID
+	if appGUID == "" {
+		return
+	}
+	ui.initialAppGUID, ui.initialIssueID = "", 0
+
+	if !guidPattern.MatchString(appGUID) {
+		ui.queueUpdateDraw(func() {
+			ui.showDeepLinkError(fmt.Sprintf("%q is not a valid application GUID.", appGUID))
+		})
+		return
+	}
+
+	app, err := ui.appService.GetApplicationCached(appGUID)
+	if err != nil {
+		ui.queueUpdateDraw(func() {
+			ui.showDeepLinkError(fmt.Sprintf("Could not open application %s: %s", appGUID, veracode.UserMessage(err, "Application")))
+		})
+		return
+	}
+
+	ui.queueUpdateDraw(func() {
+		ui.selectedApp = app
+		ui.showApplicationDetail()
+	})
+	if issueID == 0 {
+		return
+	}
+
+	finding, err := ui.findingsService.GetFinding(appGUID, issueID, "")
+	ui.queueUpdateDraw(func() {
+		if ui.selectedApp == nil || ui.selectedApp.GUID != appGUID {
+			return
+		}
+		if err != nil {
+			ui.showDeepLinkError(fmt.Sprintf("Could not open finding %d in the policy scan: %s", issueID, veracode.UserMessage(err, "Finding")))
+			return
+		}
+
+		ui.selectionIndex = -1
+		ui.showFindings()
+		ui.selectedFinding = finding
+		if finding.ScanType == findings.ScanTypeSCA {
+			ui.showSCAFindingDetail()
+		} else {
+			ui.showFindingDetail()
+		}
+	})
+}
+
+// showDeepLinkError displays why the launch target could not be opened, continuing to the view
+// underneath when dismissed
+func (ui *UI) showDeepLinkError(message string) {
+	view := tview.NewTextView().
+		SetDynamicColors(true).
+		SetWordWrap(true).
+		SetText(fmt.Sprintf("[%s::b]Unable to open the requested link[::-]\n\n%s", ui.theme.Error, tview.Escape(message)))
+	view.SetBorder(true).
+		SetTitle(" Link Not Found ").
+		SetTitleAlign(tview.AlignLeft).
+		SetBorderColor(tcell.GetColor(ui.theme.Error)).
+		SetBorderPadding(1, 1, 2, 2)
+
+	shortcutsBar := tview.NewTextView().
+		SetDynamicColors(true).
+		SetTextAlign(tview.AlignCenter).
+		SetText(fmt.Sprintf("[%s]Enter/ESC[-] Continue  [%s]q[-] Quit", ui.theme.Info, ui.theme.Info))
+
+	flex := tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(view, 0, 1, true).
+		AddItem(shortcutsBar, 1, 0, false)
+
+	previousPage, _ := ui.pages.GetFrontPage()
+	previousFocus := ui.app.GetFocus()
+	flex.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch {
+		case event.Key() == tcell.KeyEnter || event.Key() == tcell.KeyEscape:
+			ui.pages.RemovePage(deepLinkPageName)
+			ui.pages.SwitchToPage(previousPage)
+			ui.app.SetFocus(previousFocus)
+			return nil
+		case event.Rune() == 'q' || event.Rune() == 'Q' || event.Key() == tcell.KeyCtrlC:
+			ui.stop()
+			return nil
+		}
+		return event
+	})
+
+	ui.pages.AddAndSwitchToPage(deepLinkPageName, flex, true)
+	ui.app.SetFocus(view)
+}
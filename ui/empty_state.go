@@ -0,0 +1,45 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/dipsylala/veracode-tui/services/findings"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// emptyFindingsMessage describes why the findings table is empty: either nothing was returned
+// for the scan type and context, or the loaded findings are all hidden by the search and CWE filters
+func emptyFindingsMessage(scanFilter findings.ScanFilterType, filtered bool) string {
+	if filtered {
+		return "No findings match your search"
+	}
+	return fmt.Sprintf("No %s findings for this context", scanFilter)
+}
+
+// emptyApplicationsMessage describes why the applications table is empty
+func emptyApplicationsMessage(filtered bool) string {
+	if filtered {
+		return "No applications match your filters"
+	}
+	return "No applications found"
+}
+
+// emptySandboxesMessage is shown under the policy row when the application has no sandboxes
+const emptySandboxesMessage = "No sandboxes for this application"
+
+// emptyStateCell returns a dimmed, centered, non-selectable cell for an empty-state message
+func (ui *UI) emptyStateCell(message string) *tview.TableCell {
+	return tview.NewTableCell(message).
+		SetTextColor(tcell.GetColor(ui.theme.DimmedText)).
+		SetAlign(tview.AlignCenter).
+		SetSelectable(false).
+		SetExpansion(1)
+}
+
+// hasApplicationFilters reports whether any filter narrows the applications list
+func (ui *UI) hasApplicationFilters() bool {
+	return ui.searchQuery != "" || ui.scanStatusFilterValue != "" || ui.complianceFilterValue != "" || len(ui.scanTypeFilterValues) > 0 ||
+		ui.modifiedAfterFilterValue != "" || ui.modifiedBeforeFilterValue != "" ||
+		ui.tagFilterValue != "" || ui.teamFilterValue != ""
+}
@@ -0,0 +1,142 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/dipsylala/veracode-tui/export"
+	"github.com/dipsylala/veracode-tui/services/applications"
+	"github.com/dipsylala/veracode-tui/services/findings"
+	"github.com/dipsylala/veracode-tui/veracode"
+	"github.com/rivo/tview"
+)
+
+// unsafeFileNameChars matches characters that should not appear in exported file names
+var unsafeFileNameChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// exportFileName builds a file name for an export from the application name, a kind and an extension
+func exportFileName(appName, kind, ext string) string {
+	name := strings.Trim(unsafeFileNameChars.ReplaceAllString(appName, "_"), "_")
+	if name == "" {
+		name = "application"
+	}
+	return fmt.Sprintf("%s-%s-%s.%s", name, kind, time.Now().Format("20060102-150405"), ext)
+}
+
+// writeFindingsJSON writes the findings of app to a new JSON file in the working directory and
+// returns its name
+func writeFindingsJSON(app *applications.Application, findingsList []findings.Finding) (string, error) {
+	fileName := exportFileName(applicationDisplayName(app), "findings", "json")
+	f, err := os.Create(fileName)
+	if err != nil {
+		return "", err
+	}
+
+	err = export.ExportFindingsJSON(f, app, findingsList)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return fileName, err
+}
+
+// exportFindingsToJSON fetches every page of findings for the current context and dropdown filters,
+// ignoring the search and other filters applied to the loaded findings, and writes them to a JSON
+// file in the working directory. Runs on a background goroutine.
+func (ui *UI) exportFindingsToJSON() {
+	app := ui.selectedApp
+	appGUID := ui.findingsQueryAppGUID
+	opts := ui.findingsQueryOpts
+	if app == nil || opts == nil {
+		return
+	}
+
+	ui.beginRequest()
+	defer ui.endRequest()
+
+	ui.queueUpdateDraw(func() {
+		ui.setFindingsStatus(fmt.Sprintf("[%s]Exporting all findings...[-]", ui.theme.Pending))
+	})
+
+	all, err := ui.findingsService.GetAllFindings(appGUID, opts, func(fetched, total int) {
+		ui.queueUpdateDraw(func() {
+			ui.setFindingsStatus(fmt.Sprintf("[%s]Exporting all findings... %d of %d[-]", ui.theme.Pending, fetched, total))
+		})
+	})
+	if err != nil {
+		ui.queueUpdateDraw(func() {
+			ui.setFindingsStatus(fmt.Sprintf("[%s]Export failed: %s[-]", ui.theme.Error, tview.Escape(veracode.UserMessage(err, "Application"))))
+		})
+		return
+	}
+
+	fileName, err := writeFindingsJSON(app, all)
+	ui.queueUpdateDraw(func() {
+		if err != nil {
+			ui.setFindingsStatus(fmt.Sprintf("[%s]Export failed: %v[-]", ui.theme.Error, err))
+			return
+		}
+		ui.setFindingsStatus(fmt.Sprintf("[%s]Exported %d findings to %s[-]", ui.theme.Success, len(all), fileName))
+	})
+}
+
+// writeApplicationsCSV writes apps to a new inventory CSV file in the working directory and returns
+// its name
+func writeApplicationsCSV(apps []applications.Application) (string, error) {
+	fileName := exportFileName("applications", "inventory", "csv")
+	f, err := os.Create(fileName)
+	if err != nil {
+		return "", err
+	}
+
+	err = export.ExportApplicationsCSV(f, apps)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return fileName, err
+}
+
+// exportApplicationsToCSV writes the loaded page of applications to a CSV file in the working directory
+func (ui *UI) exportApplicationsToCSV() {
+	fileName, err := writeApplicationsCSV(ui.applications)
+	if err != nil {
+		ui.statusBar.SetText(fmt.Sprintf("[%s]Export failed: %v[-]", ui.theme.Error, err))
+		return
+	}
+
+	ui.statusBar.SetText(fmt.Sprintf("[%s]Exported %d applications to %s[-]", ui.theme.Success, len(ui.applications), fileName))
+}
+
+// exportAllApplicationsToCSV fetches every page of applications matching the current filters and
+// writes them to a CSV file in the working directory. Runs on a background goroutine.
+func (ui *UI) exportAllApplicationsToCSV() {
+	ui.beginRequest()
+	defer ui.endRequest()
+
+	ui.queueUpdateDraw(func() {
+		ui.statusBar.SetText(fmt.Sprintf("[%s]Exporting all applications...[-]", ui.theme.Pending))
+	})
+
+	apps, err := ui.appService.GetAllApplications(ui.applicationFilterOptions(), func(fetched, total int) {
+		ui.queueUpdateDraw(func() {
+			ui.statusBar.SetText(fmt.Sprintf("[%s]Exporting all applications... %d of %d[-]", ui.theme.Pending, fetched, total))
+		})
+	})
+	if err != nil {
+		ui.queueUpdateDraw(func() {
+			ui.statusBar.SetText(fmt.Sprintf("[%s]Export failed: %s[-]", ui.theme.Error, tview.Escape(veracode.UserMessage(err, "Applications"))))
+		})
+		return
+	}
+
+	fileName, err := writeApplicationsCSV(apps)
+	ui.queueUpdateDraw(func() {
+		if err != nil {
+			ui.statusBar.SetText(fmt.Sprintf("[%s]Export failed: %v[-]", ui.theme.Error, err))
+			return
+		}
+		ui.statusBar.SetText(fmt.Sprintf("[%s]Exported %d applications to %s[-]", ui.theme.Success, len(apps), fileName))
+	})
+}
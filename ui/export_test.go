@@ -0,0 +1,56 @@
+package ui
+
+import (
+	"encoding/json"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dipsylala/veracode-tui/export"
+	"github.com/dipsylala/veracode-tui/services/applications"
+	"github.com/dipsylala/veracode-tui/services/findings"
+	"github.com/rivo/tview"
+)
+
+// pagedFindingsClient returns one finding per page, over two pages
+type pagedFindingsClient struct{}
+
+func (c *pagedFindingsClient) DoRequestWithQueryParams(method, urlPath string, params url.Values) ([]byte, error) {
+	issueID := "1"
+	if params.Get("page") == "1" {
+		issueID = "2"
+	}
+	return []byte(`{"_embedded":{"findings":[{"issue_id":` + issueID + `}]},"page":{"total_pages":2,"total_elements":2}}`), nil
+}
+
+func TestExportFindingsToJSON_ExportsEveryPage(t *testing.T) {
+	t.Chdir(t.TempDir())
+	ui := NewUI(nil, findings.NewService(&pagedFindingsClient{}), nil, nil, nil)
+	ui.findingsCountsLabel = tview.NewTextView()
+	runSimulated(t, ui)
+
+	// Only the first page is loaded, and the search hides it
+	ui.selectedApp = &applications.Application{GUID: "app-1"}
+	ui.resetFindingsPaging("app-1", &findings.GetFindingsOptions{Size: findingsPageSize}, &findings.PageMetadata{TotalPages: 2, TotalElements: 2})
+	ui.allFindings = []findings.Finding{{IssueID: 1}}
+	ui.findings = []findings.Finding{}
+
+	ui.exportFindingsToJSON()
+
+	files, err := filepath.Glob("*-findings-*.json")
+	if err != nil || len(files) != 1 {
+		t.Fatalf("Expected one export file, got %v (%v)", files, err)
+	}
+	data, err := os.ReadFile(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc export.FindingsDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("Expected a JSON export, got %v", err)
+	}
+	if len(doc.Findings) != 2 || doc.Findings[0].IssueID != 1 || doc.Findings[1].IssueID != 2 {
+		t.Errorf("Expected the findings of both pages, got %+v", doc.Findings)
+	}
+}
@@ -1,10 +1,7 @@
 package ui
 
 import (
-	"context"
 	"encoding/base64"
-	"encoding/json"
-	"errors"
 	"fmt"
 	"html"
 	"regexp"
@@ -46,10 +43,7 @@ func (ui *UI) showFindingDetail() {
 	}
 
 	// Get application name
-	appName := DefaultApplicationName
-	if ui.selectedApp != nil && ui.selectedApp.Profile != nil {
-		appName = ui.selectedApp.Profile.Name
-	}
+	appName := applicationDisplayName(ui.selectedApp)
 
 	// Create all views
 	views := ui.createFindingDetailViews(appName, contextName)
@@ -95,11 +89,11 @@ func (ui *UI) showFindingDetail() {
 		SetDynamicColors(true).
 		SetTextAlign(tview.AlignCenter)
 	if finding.ScanType == findings.ScanTypeStatic {
-		shortcutsBar.SetText(fmt.Sprintf("[%s]ESC[-] Back  [%s]q[-] Quit  [%s]m[-] Mitigations  [%s]Tab[-] Navigate  [%s]←/→[-] Data Paths",
-			ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info))
+		shortcutsBar.SetText(fmt.Sprintf("[%s]ESC[-] Back  [%s]q[-] Quit  [%s]m[-] Mitigations  [%s]X[-] Cancel Proposal  [%s]c/M[-] Copy Text/Markdown  [%s]x[-] Expand Comments  [%s]J[-] Raw JSON  [%s]o[-] Open Results  [%s]Tab[-] Navigate  [%s]←/→[-] Data Paths  [%s]p[-] Copy Data Path",
+			ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info))
 	} else {
-		shortcutsBar.SetText(fmt.Sprintf("[%s]ESC[-] Back  [%s]q[-] Quit  [%s]m[-] Mitigations  [%s]Tab[-] Navigate",
-			ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info))
+		shortcutsBar.SetText(fmt.Sprintf("[%s]ESC[-] Back  [%s]q[-] Quit  [%s]m[-] Mitigations  [%s]X[-] Cancel Proposal  [%s]c/M[-] Copy Text/Markdown  [%s]x[-] Expand Comments  [%s]J[-] Raw JSON  [%s]o[-] Open Results  [%s]Tab[-] Navigate",
+			ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info))
 	}
 	shortcutsBar.SetBorder(false)
 
@@ -113,7 +107,7 @@ func (ui *UI) showFindingDetail() {
 		AddItem(shortcutsBar, 1, 0, false)
 
 	// Set up input handling
-	mainLayout.SetInputCapture(ui.createFindingDetailInputHandler(finding, views.focusableViews))
+	mainLayout.SetInputCapture(ui.createFindingDetailInputHandler(finding, views.focusableViews, shortcutsBar))
 
 	ui.findingDetailView = mainLayout
 
@@ -126,7 +120,7 @@ func (ui *UI) showFindingDetail() {
 	ui.app.SetFocus(views.descView)
 
 	// Load content asynchronously
-	go ui.loadFindingDetailContent(finding, views)
+	go ui.loadFindingDetailContent(finding, views, ui.findingNeedsAnnotations(finding))
 }
 
 // createFindingDetailViews creates all the views for the finding detail page
@@ -239,17 +233,21 @@ func (ui *UI) createFindingDetailViews(appName, contextName string) *findingDeta
 	return views
 }
 
-// loadFindingDetailContent loads finding detail content asynchronously
-func (ui *UI) loadFindingDetailContent(finding *findings.Finding, views *findingDetailViews) {
+// loadFindingDetailContent loads finding detail content asynchronously. fetchAnnotations fetches
+// the finding's annotations, for findings listed without them.
+func (ui *UI) loadFindingDetailContent(finding *findings.Finding, views *findingDetailViews, fetchAnnotations bool) {
 	// Build all content (this is fast, just string formatting)
 	leftContent := ui.buildBasicInfoContent(finding)
 	rightContent := ui.buildFindingStatusContent(finding)
 	techContent := ui.buildTechnicalDetailsContent(finding)
 	annotContent := ui.buildAnnotationsContent(finding)
+	if fetchAnnotations {
+		annotContent = fmt.Sprintf("[%s]Loading mitigations...[-]", ui.theme.Pending)
+	}
 	descContent := ui.buildDescriptionContent(finding)
 
 	// Update all views
-	ui.app.QueueUpdateDraw(func() {
+	ui.queueUpdateDraw(func() {
 		views.leftView.SetText(leftContent)
 		views.rightView.SetText(rightContent)
 		views.techView.SetText(techContent)
@@ -260,6 +258,10 @@ func (ui *UI) loadFindingDetailContent(finding *findings.Finding, views *finding
 		ui.findingAnnotationsView = views.annotView
 	})
 
+	if fetchAnnotations {
+		go ui.loadFindingAnnotations(ui.selectedApp.GUID, ui.currentContextGUID(), finding, views.annotView)
+	}
+
 	// For STATIC scans, load data paths (this can be slow due to API call)
 	if finding.ScanType == findings.ScanTypeStatic {
 		ui.loadAndDisplayStaticFlawInfo(finding, views.dataPathsView)
@@ -286,23 +288,50 @@ func (ui *UI) setupTechnicalDetailsRow(finding *findings.Finding, techView, data
 }
 
 // createFindingDetailInputHandler creates the input handler for the finding detail view
-func (ui *UI) createFindingDetailInputHandler(finding *findings.Finding, focusableViews []tview.Primitive) func(*tcell.EventKey) *tcell.EventKey {
+func (ui *UI) createFindingDetailInputHandler(finding *findings.Finding, focusableViews []tview.Primitive, statusBar *tview.TextView) func(*tcell.EventKey) *tcell.EventKey {
 	// Start at the last index since we set focus to descView (Description) by default
 	focusIndex := len(focusableViews) - 1
 
 	return func(event *tcell.EventKey) *tcell.EventKey {
 		switch event.Key() {
 		case tcell.KeyEscape:
-			ui.pages.SwitchToPage("findings")
-			ui.app.SetFocus(ui.findingsTable)
+			ui.closeFindingDetail()
 			return nil
 		case tcell.KeyRune:
 			if event.Rune() == 'm' {
 				ui.showMitigationModal(finding)
 				return nil
 			}
+			if event.Rune() == 'X' {
+				ui.cancelFindingProposal(finding, statusBar)
+				return nil
+			}
+			if event.Rune() == 'c' {
+				ui.copyFindingToClipboard(finding, statusBar)
+				return nil
+			}
+			if event.Rune() == 'M' {
+				ui.copyFindingAsMarkdown(finding, statusBar)
+				return nil
+			}
+			if event.Rune() == 'p' && finding.ScanType == findings.ScanTypeStatic {
+				ui.copyDataPathToClipboard(statusBar)
+				return nil
+			}
+			if event.Rune() == 'x' {
+				ui.toggleAnnotationTimeline(finding)
+				return nil
+			}
+			if event.Rune() == 'J' {
+				ui.showFindingJSON(finding)
+				return nil
+			}
+			if event.Rune() == 'o' {
+				ui.openApplicationResults(statusBar)
+				return nil
+			}
 			if event.Rune() == 'q' {
-				ui.app.Stop()
+				ui.stop()
 				return nil
 			}
 		case tcell.KeyTab:
@@ -337,7 +366,7 @@ func (ui *UI) handleDataPathNavigation(direction int) {
 		ui.currentDataPathIndex = 0
 	}
 
-	ui.currentDataPathsView.SetTitle(fmt.Sprintf(" Data Path %d of %d ", ui.currentDataPathIndex+1, len(ui.currentStaticFlawInfo.DataPaths)))
+	ui.currentDataPathsView.SetTitle(ui.dataPathTitle(ui.currentStaticFlawInfo))
 	ui.currentDataPathsView.SetText(ui.buildDataPathsContent(ui.currentStaticFlawInfo))
 	ui.currentDataPathsView.ScrollToBeginning()
 }
@@ -359,12 +388,7 @@ func (ui *UI) buildBasicInfoContent(finding *findings.Finding) string {
 	sb.WriteString(fmt.Sprintf("[%s]Status:[-] [%s]%s[-]\n", ui.theme.Label, statusColor, status))
 
 	// Grace period expiration date
-	if finding.GracePeriodExpiresDate != nil {
-		sb.WriteString(fmt.Sprintf("[%s]Grace Period Expires:[-] [white]%s[-]\n", ui.theme.Label,
-			finding.GracePeriodExpiresDate.Format("2006-01-02")))
-	} else {
-		sb.WriteString(fmt.Sprintf("[%s]Grace Period Expires:[-] [white]%s[-]\n", ui.theme.Label, TextNotAvailable))
-	}
+	sb.WriteString(fmt.Sprintf("[%s]Grace Period Expires:[-] %s\n", ui.theme.Label, ui.gracePeriodDetail(finding)))
 
 	return sb.String()
 }
@@ -383,7 +407,7 @@ func (ui *UI) appendCWEAndSeverity(sb *strings.Builder, finding *findings.Findin
 		if id, ok := cweData["id"].(float64); ok {
 			cweID = fmt.Sprintf("%d", int(id))
 		}
-		if name, ok := cweData["name"].(string); ok {
+		if name := findings.FindingCWEName(finding); name != "" {
 			cweName = processCWEDescription(name)
 		}
 		if cweID != "" && cweName != "" {
@@ -396,7 +420,7 @@ func (ui *UI) appendCWEAndSeverity(sb *strings.Builder, finding *findings.Findin
 	// Severity with color
 	if sev, ok := details["severity"].(float64); ok {
 		sevInt := int(sev)
-		sevColor := ui.getSeverityColorHex(sevInt)
+		sevColor := ui.severityColor(sevInt)
 		sb.WriteString(fmt.Sprintf("[%s]Severity:[-] [%s]%d[-]\n", ui.theme.Label, sevColor, sevInt))
 	}
 
@@ -581,74 +605,12 @@ func (ui *UI) buildTechnicalDetailsContent(finding *findings.Finding) string {
 	return sb.String()
 }
 
+// buildAnnotationsContent renders the finding's annotations as a timeline
 func (ui *UI) buildAnnotationsContent(finding *findings.Finding) string {
-	var sb strings.Builder
-
 	if len(finding.Annotations) == 0 {
-		sb.WriteString(fmt.Sprintf("[%s]No mitigations[-]\n", ui.theme.SecondaryText))
-		return sb.String()
+		return fmt.Sprintf("[%s]No mitigations[-]\n", ui.theme.SecondaryText)
 	}
-
-	// Sort annotations by date descending (newest first)
-	annotations := make([]findings.Annotation, len(finding.Annotations))
-	copy(annotations, finding.Annotations)
-	sort.Slice(annotations, func(i, j int) bool {
-		// Use Created field (from API) with fallback to Date (legacy)
-		dateI := annotations[i].Created
-		if dateI == nil {
-			dateI = annotations[i].Date
-		}
-		dateJ := annotations[j].Created
-		if dateJ == nil {
-			dateJ = annotations[j].Date
-		}
-
-		if dateI == nil {
-			return false
-		}
-		if dateJ == nil {
-			return true
-		}
-		return dateI.After(*dateJ)
-	})
-
-	for i, annotation := range annotations {
-		if i > 0 {
-			sb.WriteString(fmt.Sprintf("\n[%s]────────────────────────────────────────[-]\n\n", ui.theme.Separator))
-		}
-
-		if annotation.Action != "" {
-			sb.WriteString(fmt.Sprintf("[%s]Action:[-] [white]%s[-]\n", ui.theme.Label, annotation.Action))
-		}
-
-		// Display user (prefer UserName from API, fallback to User)
-		user := annotation.UserName
-		if user == "" {
-			user = annotation.User
-		}
-		if user != "" {
-			sb.WriteString(fmt.Sprintf("[%s]User:[-] [white]%s[-]\n", ui.theme.Label, user))
-		}
-
-		// Display date (prefer Created from API, fallback to Date)
-		date := annotation.Created
-		if date == nil {
-			date = annotation.Date
-		}
-		if date != nil {
-			sb.WriteString(fmt.Sprintf("[%s]Date:[-] [white]%s[-]\n", ui.theme.Label,
-				date.Format("2006-01-02 15:04:05")))
-		}
-
-		if annotation.Description != "" {
-			sb.WriteString(fmt.Sprintf("[%s]Description:[-] [white]%s[-]\n", ui.theme.Label, annotation.Description))
-		}
-		if annotation.Comment != "" {
-			sb.WriteString(fmt.Sprintf("[%s]Comment:[-]\n[white]%s[-]\n", ui.theme.Label, annotation.Comment))
-		}
-	}
-
-	return sb.String()
+	return ui.renderAnnotationTimeline(finding.Annotations)
 }
 
 func (ui *UI) buildDescriptionContent(finding *findings.Finding) string {
@@ -659,6 +621,14 @@ func (ui *UI) buildDescriptionContent(finding *findings.Finding) string {
 		return sb.String()
 	}
 
+	sb.WriteString(fmt.Sprintf("[white]%s[-]\n", findingDescriptionText(finding)))
+
+	return sb.String()
+}
+
+// findingDescriptionText returns the finding's description as plain text, decoding the base64
+// descriptions of dynamic flaws and removing HTML
+func findingDescriptionText(finding *findings.Finding) string {
 	description := finding.Description
 
 	// For dynamic flaws, check if description is base64 encoded
@@ -670,10 +640,7 @@ func (ui *UI) buildDescriptionContent(finding *findings.Finding) string {
 		// If decode fails, use original (it wasn't base64)
 	}
 
-	cleanDesc := cleanHTMLDescription(description)
-	sb.WriteString(fmt.Sprintf("[white]%s[-]\n", cleanDesc))
-
-	return sb.String()
+	return cleanHTMLDescription(description)
 }
 
 // cleanHTMLDescription removes HTML tags and cleans up the description text
@@ -715,23 +682,6 @@ func cleanHTMLDescription(desc string) string {
 	return decoded
 }
 
-func (ui *UI) getSeverityColorHex(severity int) string {
-	switch severity {
-	case 5:
-		return ui.theme.SeverityVeryHigh // Very High severity
-	case 4:
-		return ui.theme.SeverityHigh // High severity
-	case 3:
-		return ui.theme.SeverityMedium // Medium severity
-	case 2:
-		return ui.theme.SeverityLow // Low severity
-	case 1:
-		return ui.theme.SeverityVeryLow // Very Low severity
-	default:
-		return ui.theme.DefaultText // Default
-	}
-}
-
 func (ui *UI) getResolutionColor(status findings.ResolutionStatus) string {
 	switch status {
 	case findings.ResolutionApproved:
@@ -807,17 +757,12 @@ func processCWEDescription(name string) string {
 
 // loadAndDisplayStaticFlawInfo fetches data paths and conditionally displays them
 func (ui *UI) loadAndDisplayStaticFlawInfo(finding *findings.Finding, dataPathsView *tview.TextView) {
-	// NOTE: API bug - the static_flaw_info endpoint returns 404 when context parameter is provided
-	// for sandbox findings ("Build does not have static flaws"), even though the Swaggerhub API
-	// definition documents the context parameter as supported. Workaround: always omit context.
-	// The finding data is the same regardless of context (same flaw ID).
+	// The service retries without the context when the API returns its sandbox 404 bug
 	// See: TestGetSandboxFindingStaticFlawInfo integration test
-
-	// Fetch static flaw info without context parameter (API bug workaround)
-	staticFlawInfo, err := ui.findingsService.GetStaticFlawInfo(ui.selectedApp.GUID, finding.IssueID, "")
+	staticFlawInfo, err := ui.findingsService.GetStaticFlawInfo(ui.selectedApp.GUID, finding.IssueID, ui.currentContextGUID())
 	if err != nil {
-		ui.app.QueueUpdateDraw(func() {
-			dataPathsView.SetText(fmt.Sprintf("[red]Error loading data paths: %v[-]", err))
+		ui.queueUpdateDraw(func() {
+			dataPathsView.SetText(fmt.Sprintf("[red]Error loading data paths: %s[-]", tview.Escape(veracode.UserMessage(err, "Finding"))))
 			dataPathsView.ScrollToBeginning()
 		})
 		return
@@ -827,85 +772,90 @@ func (ui *UI) loadAndDisplayStaticFlawInfo(finding *findings.Finding, dataPathsV
 	ui.currentStaticFlawInfo = staticFlawInfo
 	ui.currentDataPathIndex = 0
 
-	ui.app.QueueUpdateDraw(func() {
-		// Update title and content based on whether data paths exist
-		if staticFlawInfo != nil && len(staticFlawInfo.DataPaths) > 0 {
-			// Update title based on count
-			if len(staticFlawInfo.DataPaths) == 1 {
-				dataPathsView.SetTitle(" Data Path ")
-			} else {
-				dataPathsView.SetTitle(fmt.Sprintf(" Data Path %d of %d ", ui.currentDataPathIndex+1, len(staticFlawInfo.DataPaths)))
-			}
-		} else {
-			// No data paths available
-			dataPathsView.SetTitle(" Data Path ")
-		}
-
-		// Build and display content
+	ui.queueUpdateDraw(func() {
+		// Build and display title and content
+		dataPathsView.SetTitle(ui.dataPathTitle(staticFlawInfo))
 		content := ui.buildDataPathsContent(staticFlawInfo)
 		dataPathsView.SetText(content)
 		dataPathsView.ScrollToBeginning()
 	})
 }
 
-// buildDataPathsContent formats static flaw data paths for display
+// buildDataPathsContent formats the current static flaw data path for display
 func (ui *UI) buildDataPathsContent(staticFlawInfo *findings.StaticFlawInfo) string {
 	if staticFlawInfo == nil || len(staticFlawInfo.DataPaths) == 0 {
-		return fmt.Sprintf("[%s]No data paths available[-]", ui.theme.SecondaryText)
+		return fmt.Sprintf("[%s]No data paths were reported for this flaw.[-]\n\n[%s]The scan did not record a call stack leading to it.[-]",
+			ui.theme.SecondaryText, ui.theme.DimmedText)
 	}
 
 	var sb strings.Builder
 
-	// Show navigation hint if multiple paths
+	// Show position and navigation hint if multiple paths
 	if len(staticFlawInfo.DataPaths) > 1 {
-		sb.WriteString(fmt.Sprintf("[%s]Use ← → to navigate[-]\n\n", ui.theme.DimmedText))
+		sb.WriteString(fmt.Sprintf("[%s]Path %d/%d[-]  [%s]Use ← → to navigate[-]\n\n", ui.theme.Info,
+			ui.currentDataPathIndex+1, len(staticFlawInfo.DataPaths), ui.theme.DimmedText))
 	}
 
 	// Display only the current data path
-	dataPath := staticFlawInfo.DataPaths[ui.currentDataPathIndex]
+	sb.WriteString(ui.renderDataPath(staticFlawInfo.DataPaths[ui.currentDataPathIndex]))
+	return sb.String()
+}
 
-	// Module and entry point
-	sb.WriteString(fmt.Sprintf("[yellow]Module:[-] [white]%s[-]\n", dataPath.ModuleName))
-	sb.WriteString(fmt.Sprintf("[yellow]Steps:[-] [white]%d[-]\n", dataPath.Steps))
+// renderDataPath formats a data path as a module/function/location header followed by
+// its calls as an indented stack trace, most recent call first
+func (ui *UI) renderDataPath(dp findings.DataPath) string {
+	var sb strings.Builder
 
-	if dataPath.LocalPath != "" {
-		sb.WriteString(fmt.Sprintf("[yellow]Path:[-] [white]%s[-]\n", dataPath.LocalPath))
-	}
+	sb.WriteString(fmt.Sprintf("[%s]Module:[-] [%s]%s[-]\n", ui.theme.Label, ui.theme.DefaultText, tview.Escape(dp.ModuleName)))
+	sb.WriteString(fmt.Sprintf("[%s]Steps:[-] [%s]%d[-]\n", ui.theme.Label, ui.theme.DefaultText, dp.Steps))
 
-	if dataPath.FunctionName != "" {
-		sb.WriteString(fmt.Sprintf("[yellow]Function:[-] [white]%s[-] [%s]at line %d[-]\n", dataPath.FunctionName, ui.theme.SecondaryText,
-			dataPath.LineNumber))
+	if dp.FunctionName != "" {
+		sb.WriteString(fmt.Sprintf("[%s]Function:[-] [%s]%s[-]\n", ui.theme.Label, ui.theme.DefaultText, tview.Escape(dp.FunctionName)))
+	}
+	if dp.LocalPath != "" {
+		sb.WriteString(fmt.Sprintf("[%s]Location:[-] [%s]%s:%d[-]\n", ui.theme.Label, ui.theme.DefaultText, tview.Escape(dp.LocalPath), dp.LineNumber))
+	} else if dp.LineNumber > 0 {
+		sb.WriteString(fmt.Sprintf("[%s]Line:[-] [%s]%d[-]\n", ui.theme.Label, ui.theme.DefaultText, dp.LineNumber))
 	}
 
-	// Call stack
-	if len(dataPath.Calls) > 0 {
-		sb.WriteString(fmt.Sprintf("\n[%s]Call Stack:[-]\n", ui.theme.Label))
+	if len(dp.Calls) == 0 {
+		return sb.String()
+	}
 
-		// Sort calls by data_path in descending order (most recent first)
-		calls := make([]findings.Call, len(dataPath.Calls))
-		copy(calls, dataPath.Calls)
-		sort.Slice(calls, func(i, j int) bool {
-			return calls[i].DataPath > calls[j].DataPath
-		})
+	sb.WriteString(fmt.Sprintf("\n[%s]Call Stack:[-]\n", ui.theme.Label))
 
-		for _, call := range calls {
-			sb.WriteString(fmt.Sprintf("  [%s]→ Step %d:[-] [white]%s[-]\n", ui.theme.SecondaryText,
-				call.DataPath, call.FunctionName))
+	// Sort calls by data_path in descending order (most recent first)
+	calls := make([]findings.Call, len(dp.Calls))
+	copy(calls, dp.Calls)
+	sort.Slice(calls, func(i, j int) bool {
+		return calls[i].DataPath > calls[j].DataPath
+	})
 
-			filePath := call.FilePath
-			if filePath == "" {
-				filePath = call.FileName
-			}
+	for depth, call := range calls {
+		indent := strings.Repeat("  ", depth+1)
+		sb.WriteString(fmt.Sprintf("%s[%s]└─ Step %d:[-] [%s]%s[-]\n", indent, ui.theme.SecondaryText,
+			call.DataPath, ui.theme.DefaultText, tview.Escape(call.FunctionName)))
 
-			if filePath != "" {
-				sb.WriteString(fmt.Sprintf("    [%s]%s:%d[-]\n", ui.theme.DimmedText, filePath, call.LineNumber))
-			}
+		filePath := call.FilePath
+		if filePath == "" {
+			filePath = call.FileName
+		}
+		if filePath != "" {
+			sb.WriteString(fmt.Sprintf("%s   [%s]%s:%d[-]\n", indent, ui.theme.DimmedText, tview.Escape(filePath), call.LineNumber))
 		}
 	}
 
 	return sb.String()
 }
 
+// dataPathTitle returns the data path panel title, including the position when there are several paths
+func (ui *UI) dataPathTitle(staticFlawInfo *findings.StaticFlawInfo) string {
+	if staticFlawInfo == nil || len(staticFlawInfo.DataPaths) <= 1 {
+		return " Data Path "
+	}
+	return fmt.Sprintf(" Data Path %d/%d ", ui.currentDataPathIndex+1, len(staticFlawInfo.DataPaths))
+}
+
 // modal creates a centered modal primitive
 // Use width/height of 0 for default centered size, or positive values for custom proportions
 func modal(p tview.Primitive, widthProportion, heightProportion int) tview.Primitive {
@@ -930,7 +880,10 @@ func (ui *UI) getAvailableAnnotationActions(finding *findings.Finding) []string
 	baseActions := []string{"COMMENT", "FP", "APPDESIGN", "OSENV", "NETENV"}
 
 	// Check if user has approveMitigations permission
-	principal, err := ui.identityService.GetPrincipal(context.Background())
+	if ui.identityService == nil {
+		return baseActions
+	}
+	principal, err := ui.identityService.GetPrincipal(ui.ctx)
 	if err != nil || principal == nil {
 		return baseActions
 	}
@@ -988,6 +941,7 @@ func (ui *UI) setupMitigationModalInputCapture(
 	focusables []tview.Primitive,
 	currentFocus *int,
 ) func(*tcell.EventKey) *tcell.EventKey {
+	policyConfirmed := false
 	return func(event *tcell.EventKey) *tcell.EventKey {
 		switch event.Key() {
 		case tcell.KeyEscape:
@@ -1003,6 +957,11 @@ func (ui *UI) setupMitigationModalInputCapture(
 			ui.app.SetFocus(focusables[*currentFocus])
 			return nil
 		case tcell.KeyCtrlS:
+			if ui.annotationSubmitting {
+				statusText.SetText(fmt.Sprintf("[%s]Already submitting, please wait...[-]", ui.theme.Pending))
+				return nil
+			}
+
 			commentText := commentTextArea.GetText()
 			if strings.TrimSpace(commentText) == "" {
 				statusText.SetText(fmt.Sprintf("[%s]Error: Comment cannot be empty[-]  [%s]ESC/q[-] Close", ui.theme.Error, ui.theme.Info))
@@ -1010,11 +969,18 @@ func (ui *UI) setupMitigationModalInputCapture(
 			}
 
 			_, actionText := actionDropdown.GetCurrentOption()
+			if !ui.confirmPolicyMitigation(actionText, &policyConfirmed, statusText) {
+				return nil
+			}
 			statusText.SetText(fmt.Sprintf("[%s]Submitting...[-]", ui.theme.Pending))
 			commentTextArea.SetDisabled(true)
+			ui.annotationSubmitting = true
 
 			go ui.submitAnnotationCommentInModal(finding, commentText, actionText, statusText, commentTextArea, mitigationView)
 			return nil
+		case tcell.KeyCtrlD:
+			ui.saveMitigationDraft(finding, actionDropdown, commentTextArea, statusText)
+			return nil
 		case tcell.KeyRune:
 			if event.Rune() == 'q' {
 				ui.pages.RemovePage("mitigation-modal")
@@ -1096,12 +1062,16 @@ func (ui *UI) showMitigationModal(finding *findings.Finding) {
 	statusText := tview.NewTextView().
 		SetDynamicColors(true).
 		SetTextAlign(tview.AlignCenter).
-		SetText(fmt.Sprintf("[%s]Ctrl+S[-] Submit Annotation  [%s]Tab[-] Navigate  [%s]ESC/q[-] Close", ui.theme.Info, ui.theme.Info, ui.theme.Info))
+		SetText(fmt.Sprintf("[%s]Ctrl+S[-] Submit Annotation  [%s]Ctrl+D[-] Save Draft  [%s]Tab[-] Navigate  [%s]ESC/q[-] Close", ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info))
 	statusText.SetBorder(false)
+	if note := ui.restoreMitigationDraft(finding, actionOptions, actionDropdown, commentTextArea); note != "" {
+		statusText.SetText(note + "  " + statusText.GetText(false))
+	}
 
 	// Create layout
 	modalContent := tview.NewFlex().
 		SetDirection(tview.FlexRow).
+		AddItem(ui.createMitigationTargetView(1), 1, 0, false).
 		AddItem(actionDropdown, 3, 0, false).
 		AddItem(commentTextArea, 6, 0, true).
 		AddItem(mitigationView, 0, 1, false).
@@ -1128,12 +1098,6 @@ func (ui *UI) showMitigationModal(finding *findings.Finding) {
 
 // submitAnnotationCommentInModal submits the annotation and refreshes the modal
 func (ui *UI) submitAnnotationCommentInModal(finding *findings.Finding, comment, action string, statusText *tview.TextView, textArea *tview.TextArea, mitigationView *tview.TextView) {
-	// Determine context (sandbox GUID or empty for policy)
-	contextGUID := ""
-	if finding.ContextType == findings.ContextTypeSandbox && ui.selectionIndex >= 0 && ui.selectionIndex < len(ui.sandboxes) {
-		contextGUID = ui.sandboxes[ui.selectionIndex].GUID
-	}
-
 	// Create the annotation
 	annotation := &annotations.AnnotationData{
 		IssueList: fmt.Sprintf("%d", finding.IssueID),
@@ -1141,30 +1105,25 @@ func (ui *UI) submitAnnotationCommentInModal(finding *findings.Finding, comment,
 		Action:    action,
 	}
 
+	// Annotate in the context the finding was loaded from, the sandbox GUID or empty for policy
 	opts := &annotations.CreateAnnotationOptions{
-		Context: contextGUID,
+		Context: ui.currentContextGUID(),
 	}
 
-	_, err := ui.annotationsService.CreateAnnotation(ui.selectedApp.GUID, annotation, opts)
+	appGUID := ui.selectedApp.GUID
+	_, err := ui.annotationsService.CreateAnnotation(appGUID, annotation, opts)
+
+	// The comment is now on the finding, so its draft is no longer needed
+	draftWarning := ""
+	if err == nil {
+		draftWarning = ui.deleteMitigationDraft(appGUID, finding.IssueID)
+	}
 
-	ui.app.QueueUpdateDraw(func() {
+	ui.queueUpdateDraw(func() {
+		ui.annotationSubmitting = false
 		if err != nil {
-			// Format error message - check if it's an HTTPError
-			errorMsg := err.Error()
-
-			// Unwrap to find HTTPError in the error chain
-			var httpErr *veracode.HTTPError
-			if errors.As(err, &httpErr) {
-				// Parse the JSON response to get title and detail
-				var errorResp annotations.AnnotationErrorResponse
-				if parseErr := json.Unmarshal(httpErr.Body, &errorResp); parseErr == nil {
-					if len(errorResp.Embedded.APIErrors) > 0 {
-						apiErr := errorResp.Embedded.APIErrors[0]
-						// Format: {HTTP Code}:{Detail}
-						errorMsg = fmt.Sprintf("%d:%s", httpErr.StatusCode, apiErr.Detail)
-					}
-				}
-			}
+			// Format error message, using the API error envelope when available
+			errorMsg := tview.Escape(veracode.UserMessage(err, "Finding"))
 
 			statusText.SetText(fmt.Sprintf("[%s]Error: %s  [%s]Press ESC to close[-]", ui.theme.Error, errorMsg, ui.theme.Info))
 			textArea.SetDisabled(false)
@@ -1175,7 +1134,7 @@ func (ui *UI) submitAnnotationCommentInModal(finding *findings.Finding, comment,
 			// Get current user name if available
 			userName := "Current User"
 			if ui.identityService != nil {
-				principal, err := ui.identityService.GetPrincipal(context.Background())
+				principal, err := ui.identityService.GetPrincipal(ui.ctx)
 				if err == nil && principal != nil {
 					userName = principal.Username
 				}
@@ -1193,6 +1152,7 @@ func (ui *UI) submitAnnotationCommentInModal(finding *findings.Finding, comment,
 			// Updating selectedFinding updates the master findings list automatically
 			if ui.selectedFinding != nil {
 				ui.selectedFinding.Annotations = append(ui.selectedFinding.Annotations, newAnnotation)
+				ui.syncFindingAnnotations(ui.selectedFinding)
 			}
 
 			// Refresh both the mitigation view and the main finding annotations view
@@ -1208,7 +1168,7 @@ func (ui *UI) submitAnnotationCommentInModal(finding *findings.Finding, comment,
 			ui.updateFindingRowInTable(finding)
 
 			// Show success message
-			statusText.SetText(fmt.Sprintf("[%s]✓ Annotation submitted!  [%s]Ctrl+S[-] Submit Another  [%s]ESC/q[-] Close", ui.theme.Success, ui.theme.Info, ui.theme.Info))
+			statusText.SetText(fmt.Sprintf("[%s]✓ Annotation submitted!%s  [%s]Ctrl+S[-] Submit Another  [%s]ESC/q[-] Close", ui.theme.Success, draftWarning, ui.theme.Info, ui.theme.Info))
 			textArea.SetDisabled(false)
 			textArea.SetText("", true) // Clear the text area
 		}
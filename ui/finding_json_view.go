@@ -0,0 +1,60 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/dipsylala/veracode-tui/services/findings"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const findingJSONPageName = "finding_json"
+
+// showFindingJSON shows the raw JSON of finding in a read-only, scrollable view over the current page,
+// for inspecting finding details the TUI does not render. ESC returns to the previous view.
+func (ui *UI) showFindingJSON(finding *findings.Finding) {
+	if finding == nil {
+		return
+	}
+
+	data, err := json.MarshalIndent(finding, "", "  ")
+	text := string(data)
+	if err != nil {
+		text = fmt.Sprintf("Failed to encode finding: %v", err)
+	}
+
+	// Dynamic colors stay off so brackets in the JSON are not read as color tags
+	view := tview.NewTextView().
+		SetText(text).
+		SetScrollable(true).
+		SetWrap(false)
+	view.SetBorder(true).
+		SetTitle(fmt.Sprintf(" Raw JSON - Finding %d ", finding.IssueID)).
+		SetTitleAlign(tview.AlignLeft).
+		SetBorderColor(tcell.GetColor(ui.theme.BorderFocused)).
+		SetBorderPadding(0, 0, 1, 1)
+
+	shortcutsBar := tview.NewTextView().
+		SetDynamicColors(true).
+		SetTextAlign(tview.AlignCenter).
+		SetText(fmt.Sprintf("[%s]↑/↓/PgUp/PgDn[-] Scroll  [%s]ESC/J[-] Back", ui.theme.Info, ui.theme.Info))
+
+	flex := tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(view, 0, 1, true).
+		AddItem(shortcutsBar, 1, 0, false)
+
+	previousFocus := ui.app.GetFocus()
+	flex.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape || (event.Key() == tcell.KeyRune && event.Rune() == 'J') {
+			ui.pages.RemovePage(findingJSONPageName)
+			ui.app.SetFocus(previousFocus)
+			return nil
+		}
+		return event
+	})
+
+	ui.pages.AddPage(findingJSONPageName, flex, true, true)
+	ui.app.SetFocus(view)
+}
@@ -0,0 +1,65 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/dipsylala/veracode-tui/services/findings"
+	"github.com/dipsylala/veracode-tui/veracode"
+	"github.com/rivo/tview"
+)
+
+// toggleFindingsAnnotations switches loading annotations with the findings list on or off and
+// reloads the findings. The Findings API does not return annotations for SCA findings, so the
+// toggle is ignored while they are shown.
+func (ui *UI) toggleFindingsAnnotations() {
+	if ui.findingsScanFilter == findings.ScanFilterSCA {
+		ui.setFindingsStatus(fmt.Sprintf("[%s]Annotations are not available for SCA findings[-]", ui.theme.Warning))
+		return
+	}
+
+	ui.findingsAnnotations = !ui.findingsAnnotations
+	go ui.loadFindingsWithFilter(ui.findingsScanFilter)
+}
+
+// findingsAnnotationsText describes whether annotations are loaded with the findings, for the
+// findings counts line. SCA findings never have annotations, so nothing is shown for them.
+func (ui *UI) findingsAnnotationsText() string {
+	if ui.findingsScanFilter == findings.ScanFilterSCA {
+		return ""
+	}
+	state := "off"
+	if ui.findingsAnnotations {
+		state = "on"
+	}
+	return fmt.Sprintf("[white]  |  Annotations: [%s]%s", ui.theme.Label, state)
+}
+
+// findingNeedsAnnotations reports whether the finding was listed without its annotations, which
+// the detail view then fetches on demand. Annotations already fetched, even if there are none, are
+// not fetched again.
+func (ui *UI) findingNeedsAnnotations(finding *findings.Finding) bool {
+	return finding.ScanType != findings.ScanTypeSCA && finding.Annotations == nil &&
+		ui.findingsQueryOpts != nil && !ui.findingsQueryOpts.IncludeAnnotations
+}
+
+// loadFindingAnnotations fetches the annotations of a finding listed without them, keeps them on
+// the loaded finding and shows them in view
+func (ui *UI) loadFindingAnnotations(appGUID, contextGUID string, finding *findings.Finding, view *tview.TextView) {
+	ui.beginRequest()
+	defer ui.endRequest()
+
+	fetched, err := ui.findingsService.GetFinding(appGUID, finding.IssueID, contextGUID)
+
+	ui.queueUpdateDraw(func() {
+		if err != nil {
+			view.SetText(fmt.Sprintf("[%s]Could not load mitigations: %s[-]", ui.theme.Error, tview.Escape(veracode.UserMessage(err, "Finding"))))
+			return
+		}
+		finding.Annotations = fetched.Annotations
+		if finding.Annotations == nil {
+			finding.Annotations = []findings.Annotation{}
+		}
+		ui.syncFindingAnnotations(finding)
+		view.SetText(ui.buildAnnotationsContent(finding))
+	})
+}
@@ -0,0 +1,59 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dipsylala/veracode-tui/services/findings"
+	"github.com/rivo/tview"
+)
+
+func TestToggleFindingsAnnotations(t *testing.T) {
+	ui := NewUI(nil, nil, nil, nil, nil)
+	ui.findingsCountsLabel = tview.NewTextView().SetDynamicColors(true)
+
+	if ui.findingsAnnotations {
+		t.Fatal("Expected annotations to be off by default")
+	}
+	ui.toggleFindingsAnnotations()
+	if !ui.findingsAnnotations {
+		t.Error("Expected the toggle to turn annotations on")
+	}
+	if text := ui.findingsAnnotationsText(); !strings.Contains(text, "Annotations: ") || !strings.HasSuffix(text, "on") {
+		t.Errorf("Expected the counts line to show annotations on, got %q", text)
+	}
+
+	ui.findingsScanFilter = findings.ScanFilterSCA
+	ui.toggleFindingsAnnotations()
+	if !ui.findingsAnnotations {
+		t.Error("Expected the toggle to be ignored for SCA findings")
+	}
+	if text := ui.findingsAnnotationsText(); text != "" {
+		t.Errorf("Expected no annotations state for SCA findings, got %q", text)
+	}
+}
+
+func TestFindingNeedsAnnotations(t *testing.T) {
+	tests := []struct {
+		name    string
+		finding findings.Finding
+		opts    *findings.GetFindingsOptions
+		want    bool
+	}{
+		{"listed without annotations", findings.Finding{ScanType: findings.ScanTypeStatic}, &findings.GetFindingsOptions{}, true},
+		{"listed with annotations", findings.Finding{ScanType: findings.ScanTypeStatic}, &findings.GetFindingsOptions{IncludeAnnotations: true}, false},
+		{"already fetched", findings.Finding{ScanType: findings.ScanTypeDynamic, Annotations: []findings.Annotation{}}, &findings.GetFindingsOptions{}, false},
+		{"SCA", findings.Finding{ScanType: findings.ScanTypeSCA}, &findings.GetFindingsOptions{}, false},
+		{"not from the findings list", findings.Finding{ScanType: findings.ScanTypeStatic}, nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ui := NewUI(nil, nil, nil, nil, nil)
+			ui.findingsQueryOpts = tt.opts
+			if got := ui.findingNeedsAnnotations(&tt.finding); got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
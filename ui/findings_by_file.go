@@ -0,0 +1,153 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/dipsylala/veracode-tui/services/findings"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const findingsByFilePageName = "findings-by-file"
+
+// fileTreeRow is one row of the findings-by-file tree: a file, or one of its flaws when finding is set
+type fileTreeRow struct {
+	group   *findings.FileGroup
+	finding *findings.Finding
+}
+
+// showFindingsByFile opens a tree of the loaded static findings grouped by source file. Enter
+// expands or collapses a file, and opens the detail of a flaw.
+func (ui *UI) showFindingsByFile() {
+	groups := findings.GroupByFile(ui.findings)
+	if len(groups) == 0 {
+		ui.setFindingsStatus(fmt.Sprintf("[%s]No static findings to group by file[-]", ui.theme.Warning))
+		return
+	}
+
+	table := tview.NewTable().
+		SetBorders(false).
+		SetSelectable(true, false).
+		SetFixed(1, 0)
+	table.SetBorder(true).
+		SetTitle(fmt.Sprintf(" Static findings by file: %d files ", len(groups))).
+		SetTitleAlign(tview.AlignLeft).
+		SetBorderColor(tcell.GetColor(ui.theme.BorderFocused)).
+		SetBorderPadding(0, 0, 1, 1)
+	table.SetSelectedStyle(tcell.StyleDefault.
+		Background(tcell.GetColor(ui.theme.SelectionBackground)).
+		Foreground(tcell.GetColor(ui.theme.SelectionForeground)))
+
+	expanded := make(map[string]bool)
+	rows := ui.renderFindingsByFileTable(table, groups, expanded)
+
+	table.SetSelectedFunc(func(row, column int) {
+		if row < 1 || row-1 >= len(rows) {
+			return
+		}
+		selected := rows[row-1]
+		if selected.finding != nil {
+			ui.selectedFinding = selected.finding
+			ui.showFindingDetail()
+			return
+		}
+		expanded[selected.group.FilePath] = !expanded[selected.group.FilePath]
+		rows = ui.renderFindingsByFileTable(table, groups, expanded)
+		table.Select(row, 0)
+	})
+	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape {
+			ui.closeFindingsByFile()
+			return nil
+		}
+		return event
+	})
+
+	shortcutsBar := tview.NewTextView().
+		SetDynamicColors(true).
+		SetTextAlign(tview.AlignCenter).
+		SetText(fmt.Sprintf("[%s]Enter[-] Expand File/Open Finding  [%s]ESC[-] Back", ui.theme.Info, ui.theme.Info))
+
+	flex := tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(table, 0, 1, true).
+		AddItem(shortcutsBar, 1, 0, false)
+
+	ui.pages.RemovePage(findingsByFilePageName)
+	ui.pages.AddAndSwitchToPage(findingsByFilePageName, flex, true)
+	ui.app.SetFocus(table)
+}
+
+// renderFindingsByFileTable renders a row per file, followed by its flaws when the file is
+// expanded, and returns what each row below the header shows
+func (ui *UI) renderFindingsByFileTable(table *tview.Table, groups []findings.FileGroup, expanded map[string]bool) []fileTreeRow {
+	table.Clear()
+	for col, header := range []string{"File", "Findings", "Line", "CWE", "Sev", "Status"} {
+		table.SetCell(0, col, tview.NewTableCell(header).
+			SetTextColor(tcell.GetColor(ui.theme.ColumnHeader)).
+			SetAttributes(tcell.AttrBold).
+			SetSelectable(false))
+	}
+
+	rows := []fileTreeRow{}
+	for i := range groups {
+		group := &groups[i]
+		expandChar := "▶"
+		if expanded[group.FilePath] {
+			expandChar = "▼"
+		}
+
+		row := len(rows) + 1
+		table.SetCell(row, 0, tview.NewTableCell(fmt.Sprintf("%s %s", expandChar, group.FilePath)).
+			SetTextColor(tcell.GetColor(ui.theme.DefaultText)).
+			SetAttributes(tcell.AttrBold).
+			SetExpansion(1))
+		table.SetCell(row, 1, tview.NewTableCell(fmt.Sprintf("%d", len(group.Findings))))
+		rows = append(rows, fileTreeRow{group: group})
+
+		if !expanded[group.FilePath] {
+			continue
+		}
+		for _, finding := range group.Findings {
+			ui.renderFileTreeFindingRow(table, len(rows)+1, finding)
+			rows = append(rows, fileTreeRow{group: group, finding: finding})
+		}
+	}
+	return rows
+}
+
+// renderFileTreeFindingRow renders a flaw indented under its file
+func (ui *UI) renderFileTreeFindingRow(table *tview.Table, row int, finding *findings.Finding) {
+	line := "-"
+	if number := findings.LineNumber(finding); number > 0 {
+		line = fmt.Sprintf("%d", number)
+	}
+
+	table.SetCell(row, 0, tview.NewTableCell(fmt.Sprintf("  └─ %d", finding.IssueID)).
+		SetTextColor(tcell.GetColor(ui.theme.SecondaryText)).
+		SetExpansion(1))
+	table.SetCell(row, 1, tview.NewTableCell(""))
+	table.SetCell(row, 2, tview.NewTableCell(line))
+	table.SetCell(row, 3, tview.NewTableCell(extractCWE(finding)))
+	table.SetCell(row, 4, ui.newSeverityCell(finding))
+	table.SetCell(row, 5, tview.NewTableCell(extractStatus(finding)).SetTextColor(ui.getStatusColor(finding)))
+}
+
+// closeFindingsByFile removes the findings-by-file page and returns to the findings view
+func (ui *UI) closeFindingsByFile() {
+	ui.pages.RemovePage(findingsByFilePageName)
+	ui.pages.SwitchToPage("findings")
+	ui.app.SetFocus(ui.findingsTable)
+}
+
+// closeFindingDetail leaves the finding detail view, returning to the findings-by-file tree when
+// it is open, otherwise to the findings view
+func (ui *UI) closeFindingDetail() {
+	if ui.pages.HasPage(findingsByFilePageName) {
+		ui.pages.SwitchToPage(findingsByFilePageName)
+		ui.app.SetFocus(ui.pages.GetPage(findingsByFilePageName))
+		return
+	}
+	ui.pages.SwitchToPage("findings")
+	ui.app.SetFocus(ui.findingsTable)
+}
@@ -0,0 +1,50 @@
+package ui
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/dipsylala/veracode-tui/services/applications"
+	"github.com/dipsylala/veracode-tui/services/findings"
+	"github.com/rivo/tview"
+)
+
+// countsClient reports a different findings total for each scan type, recording the contexts asked for
+type countsClient struct {
+	totals   map[string]int
+	contexts []string
+}
+
+func (c *countsClient) DoRequestWithQueryParams(method, urlPath string, params url.Values) ([]byte, error) {
+	c.contexts = append(c.contexts, params.Get("context"))
+	return []byte(fmt.Sprintf(`{"page":{"total_elements":%d}}`, c.totals[params.Get("scan_type")])), nil
+}
+
+func TestLoadOtherScanCounts(t *testing.T) {
+	for _, loaded := range []findings.ScanType{findings.ScanTypeStatic, findings.ScanTypeDynamic, findings.ScanTypeSCA} {
+		t.Run(string(loaded), func(t *testing.T) {
+			client := &countsClient{totals: map[string]int{"STATIC": 1, "DYNAMIC": 2, "SCA": 3}}
+			ui := NewUI(nil, findings.NewService(client), nil, nil, nil)
+			ui.selectedApp = &applications.Application{GUID: "app-1"}
+			ui.sandboxes = []applications.Sandbox{{GUID: "sandbox-1"}}
+			ui.selectionIndex = 0
+			ui.findingsCountsLabel = tview.NewTextView()
+			runSimulated(t, ui)
+
+			ui.loadOtherScanCounts(loaded)
+
+			want := map[findings.ScanType]int64{findings.ScanTypeStatic: 1, findings.ScanTypeDynamic: 2, findings.ScanTypeSCA: 3}
+			want[loaded] = 0
+			got := map[findings.ScanType]int64{findings.ScanTypeStatic: ui.staticCount, findings.ScanTypeDynamic: ui.dynamicCount, findings.ScanTypeSCA: ui.scaCount}
+			for scanType, count := range want {
+				if got[scanType] != count {
+					t.Errorf("Expected %s count %d, got %d", scanType, count, got[scanType])
+				}
+			}
+			if len(client.contexts) != 2 || client.contexts[0] != "sandbox-1" || client.contexts[1] != "sandbox-1" {
+				t.Errorf("Expected two count requests in the sandbox context, got %q", client.contexts)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/dipsylala/veracode-tui/services/findings"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// createFindingsCWEFilter creates the CWE dropdown and its bordered container
+func (ui *UI) createFindingsCWEFilter() *tview.Flex {
+	ui.findingsCWEDropdown = tview.NewDropDown().
+		SetOptions([]string{"All"}, nil).
+		SetCurrentOption(0).
+		SetFieldWidth(0).
+		SetFieldTextColor(tcell.GetColor(ui.theme.DropDownText)).
+		SetFieldBackgroundColor(tcell.GetColor(ui.theme.DropDownBackground))
+	ui.findingsCWEDropdown.SetListStyles(
+		tcell.StyleDefault.Foreground(tcell.GetColor(ui.theme.DropDownText)).Background(tcell.GetColor(ui.theme.DropDownBackground)),
+		tcell.StyleDefault.Foreground(tcell.GetColor(ui.theme.DropDownSelectedForeground)).Background(tcell.GetColor(ui.theme.DropDownSelectedBackground)))
+
+	container := tview.NewFlex().
+		AddItem(ui.findingsCWEDropdown, 0, 1, false)
+	container.SetBorder(true).
+		SetTitle(" CWE (w) ").
+		SetTitleAlign(tview.AlignLeft).
+		SetBorderColor(tcell.GetColor(ui.theme.Border)).
+		SetBorderPadding(0, 0, 1, 1)
+
+	ui.findingsCWEDropdown.SetFocusFunc(func() {
+		container.SetBorderColor(tcell.GetColor(ui.theme.BorderFocused))
+	})
+	ui.findingsCWEDropdown.SetBlurFunc(func() {
+		container.SetBorderColor(tcell.GetColor(ui.theme.Border))
+	})
+	ui.findingsCWEDropdown.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape {
+			ui.app.SetFocus(ui.findingsTable)
+			return nil
+		}
+		return event
+	})
+
+	return container
+}
+
+// populateFindingsCWEDropdown lists the CWEs present in the loaded findings, most frequent first.
+// The current CWE filter is kept if it is still present, otherwise it is cleared.
+func (ui *UI) populateFindingsCWEDropdown() {
+	groups := findings.GroupByCWE(ui.allFindings)
+
+	ids := make([]int, 0, len(groups))
+	for id := range groups {
+		if id != 0 {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if len(groups[ids[i]]) != len(groups[ids[j]]) {
+			return len(groups[ids[i]]) > len(groups[ids[j]])
+		}
+		return ids[i] < ids[j]
+	})
+
+	options := []string{"All"}
+	selected := 0
+	for i, id := range ids {
+		options = append(options, cweOptionText(id, findings.FindingCWEName(&groups[id][0]), len(groups[id])))
+		if id == ui.findingsCWEFilter {
+			selected = i + 1
+		}
+	}
+	if selected == 0 {
+		ui.findingsCWEFilter = 0
+	}
+
+	// Set the selection before attaching the callback so repopulating does not re-filter
+	ui.findingsCWEDropdown.SetOptions(options, nil)
+	ui.findingsCWEDropdown.SetCurrentOption(selected)
+	ui.findingsCWEDropdown.SetSelectedFunc(func(text string, index int) {
+		cwe := 0
+		if index > 0 {
+			cwe = ids[index-1]
+		}
+		if cwe == ui.findingsCWEFilter {
+			return
+		}
+		ui.findingsCWEFilter = cwe
+		ui.applyFindingsSearch()
+		if len(ui.findings) > 0 {
+			ui.findingsTable.Select(1, 0)
+		}
+	})
+}
+
+// cweOptionText formats a CWE dropdown entry, e.g. "CWE-89 SQL Injection (12)"
+func cweOptionText(id int, name string, count int) string {
+	if name == "" {
+		return fmt.Sprintf("CWE-%d (%d)", id, count)
+	}
+	return fmt.Sprintf("CWE-%d %s (%d)", id, name, count)
+}
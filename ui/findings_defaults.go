@@ -0,0 +1,45 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/dipsylala/veracode-tui/services/findings"
+)
+
+// SetFindingsDefaults sets the scan type and policy filter the findings view opens with, e.g.
+// "DYNAMIC" and "Violations". Empty values keep the built-in defaults of STATIC and All. An
+// unknown value is ignored, keeping its default, and reported in the returned error.
+func (ui *UI) SetFindingsDefaults(scanType, policyFilter string) error {
+	var errs []error
+
+	if scanType != "" {
+		if parsed, ok := findings.ParseScanFilterType(scanType); ok {
+			ui.defaultScanFilter = parsed
+		} else {
+			errs = append(errs, fmt.Errorf("unknown default scan type %q (expected one of %v)", scanType, findings.ScanFilterTypes))
+		}
+	}
+
+	if policyFilter != "" {
+		if parsed, ok := findings.ParsePolicyFilterType(policyFilter); ok {
+			ui.defaultPolicyFilter = parsed
+		} else {
+			errs = append(errs, fmt.Errorf("unknown default policy filter %q (expected one of %v)", policyFilter, findings.PolicyFilterTypes))
+		}
+	}
+
+	ui.findingsScanFilter = ui.defaultScanFilter
+	ui.findingsPolicyFilter = ui.defaultPolicyFilter
+	return errors.Join(errs...)
+}
+
+// resetFindingsFiltersToDefaults restores the scan type and policy filters, and the policy
+// dropdown, to the configured defaults. The dropdown lists the filters in the order of the findings
+// constants.
+func (ui *UI) resetFindingsFiltersToDefaults() {
+	ui.findingsScanFilter = ui.defaultScanFilter
+	ui.findingsPolicyFilter = ui.defaultPolicyFilter
+	ui.findingsPolicyFilterDropdown.SetCurrentOption(max(slices.Index(findings.PolicyFilterTypes, ui.defaultPolicyFilter), 0))
+}
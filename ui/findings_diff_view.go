@@ -0,0 +1,206 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/dipsylala/veracode-tui/services/findings"
+	"github.com/dipsylala/veracode-tui/veracode"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const findingsDiffPageName = "findings-diff"
+
+// findingsDiffContext is a policy scan or sandbox context the loaded findings can be compared with
+type findingsDiffContext struct {
+	name string
+	guid string // Empty for the policy scan
+}
+
+// otherFindingsContexts returns the policy scan and sandbox contexts other than the current one
+func (ui *UI) otherFindingsContexts() []findingsDiffContext {
+	contexts := []findingsDiffContext{}
+	if ui.selectionIndex != -1 {
+		contexts = append(contexts, findingsDiffContext{name: DefaultContextName})
+	}
+	for i, sandbox := range ui.sandboxes {
+		if i != ui.selectionIndex {
+			contexts = append(contexts, findingsDiffContext{name: sandbox.Name, guid: sandbox.GUID})
+		}
+	}
+	return contexts
+}
+
+// showFindingsDiffPicker lets the user choose the context to compare the loaded findings with
+func (ui *UI) showFindingsDiffPicker() {
+	if ui.selectedApp == nil || ui.findingsQueryOpts == nil {
+		return
+	}
+
+	contexts := ui.otherFindingsContexts()
+	if len(contexts) == 0 {
+		ui.setFindingsStatus(fmt.Sprintf("[%s]No other policy scan or sandbox to compare with[-]", ui.theme.Warning))
+		return
+	}
+
+	list := tview.NewList().ShowSecondaryText(false)
+	list.SetBorder(true).
+		SetTitle(fmt.Sprintf(" Compare %s with ", ui.currentContextName())).
+		SetTitleAlign(tview.AlignLeft).
+		SetBorderColor(tcell.GetColor(ui.theme.BorderFocused))
+	list.SetSelectedStyle(tcell.StyleDefault.
+		Background(tcell.GetColor(ui.theme.SelectionBackground)).
+		Foreground(tcell.GetColor(ui.theme.SelectionForeground)))
+
+	for _, context := range contexts {
+		context := context
+		list.AddItem(context.name, "", 0, func() {
+			ui.showFindingsDiff(context)
+		})
+	}
+	list.SetDoneFunc(ui.closeFindingsDiff)
+
+	ui.pages.AddPage(findingsDiffPageName, modal(list, 1, 1), true, true)
+	ui.app.SetFocus(list)
+}
+
+// showFindingsDiff loads the findings of baseline with the current filters and shows how the
+// loaded findings differ from them
+func (ui *UI) showFindingsDiff(baseline findingsDiffContext) {
+	current := ui.currentContextName()
+
+	table := tview.NewTable().
+		SetBorders(false).
+		SetSelectable(true, false).
+		SetFixed(1, 0)
+	table.SetBorder(true).
+		SetTitle(fmt.Sprintf(" %s compared with %s ", current, baseline.name)).
+		SetTitleAlign(tview.AlignLeft).
+		SetBorderColor(tcell.GetColor(ui.theme.BorderFocused)).
+		SetBorderPadding(0, 0, 1, 1)
+	table.SetSelectedStyle(tcell.StyleDefault.
+		Background(tcell.GetColor(ui.theme.SelectionBackground)).
+		Foreground(tcell.GetColor(ui.theme.SelectionForeground)))
+	table.SetCell(0, 0, tview.NewTableCell(fmt.Sprintf("Loading findings for %s...", baseline.name)).
+		SetTextColor(tcell.GetColor(ui.theme.Pending)).
+		SetSelectable(false))
+	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape {
+			ui.closeFindingsDiff()
+			return nil
+		}
+		return event
+	})
+
+	shortcutsBar := tview.NewTextView().
+		SetDynamicColors(true).
+		SetTextAlign(tview.AlignCenter).
+		SetText(fmt.Sprintf("[%s]Added[-] only in %s  [%s]Removed[-] only in %s  [%s]Changed[-] status differs  [%s]ESC[-] Back",
+			ui.theme.Error, current, ui.theme.Success, baseline.name, ui.theme.Pending, ui.theme.Info))
+
+	flex := tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(table, 0, 1, true).
+		AddItem(shortcutsBar, 1, 0, false)
+
+	ui.pages.RemovePage(findingsDiffPageName)
+	ui.pages.AddAndSwitchToPage(findingsDiffPageName, flex, true)
+	ui.app.SetFocus(table)
+
+	appGUID := ui.findingsQueryAppGUID
+	opts := *ui.findingsQueryOpts
+	opts.Context = baseline.guid
+	opts.Page = 0
+	opts.Size = findingsPageSize
+	loaded := ui.allFindings
+
+	go func() {
+		result, err := ui.findingsService.GetFindings(appGUID, &opts)
+
+		ui.queueUpdateDraw(func() {
+			if err != nil {
+				table.Clear()
+				table.SetCell(0, 0, tview.NewTableCell(fmt.Sprintf("Error loading findings: %s", veracode.UserMessage(err, "Application"))).
+					SetTextColor(tcell.GetColor(ui.theme.Error)).
+					SetSelectable(false))
+				return
+			}
+
+			var baselineFindings []findings.Finding
+			if result != nil && result.Embedded != nil {
+				baselineFindings = result.Embedded.Findings
+			}
+			added, removed, changed := findings.Diff(baselineFindings, loaded)
+			table.SetTitle(fmt.Sprintf(" %s compared with %s: %d added, %d removed, %d changed ",
+				current, baseline.name, len(added), len(removed), len(changed)))
+			ui.renderFindingsDiffTable(table, added, removed, changed)
+		})
+	}()
+}
+
+// renderFindingsDiffTable lists the added, removed and changed findings
+func (ui *UI) renderFindingsDiffTable(table *tview.Table, added, removed, changed []findings.Finding) {
+	table.Clear()
+
+	for col, header := range []string{"Change", "ID", "CWE/CVE", "Location", "Status"} {
+		table.SetCell(0, col, tview.NewTableCell(header).
+			SetTextColor(tcell.GetColor(ui.theme.ColumnHeader)).
+			SetAttributes(tcell.AttrBold).
+			SetSelectable(false))
+	}
+
+	if len(added)+len(removed)+len(changed) == 0 {
+		table.SetCell(1, 0, ui.emptyStateCell("No differences in the loaded findings"))
+		return
+	}
+
+	row := 1
+	for _, group := range []struct {
+		label    string
+		color    string
+		findings []findings.Finding
+	}{
+		{"Added", ui.theme.Error, added},
+		{"Removed", ui.theme.Success, removed},
+		{"Changed", ui.theme.Pending, changed},
+	} {
+		for i := range group.findings {
+			finding := &group.findings[i]
+			table.SetCell(row, 0, tview.NewTableCell(group.label).SetTextColor(tcell.GetColor(group.color)))
+			table.SetCell(row, 1, tview.NewTableCell(fmt.Sprintf("%d", finding.IssueID)))
+			table.SetCell(row, 2, tview.NewTableCell(findingDiffWeakness(finding)))
+			table.SetCell(row, 3, tview.NewTableCell(findingDiffLocation(finding)).SetExpansion(1))
+			table.SetCell(row, 4, tview.NewTableCell(extractStatus(finding)))
+			row++
+		}
+	}
+
+	table.Select(1, 0)
+}
+
+// findingDiffWeakness returns the CVE of an SCA finding, or the CWE of any other finding
+func findingDiffWeakness(finding *findings.Finding) string {
+	if finding.ScanType == findings.ScanTypeSCA {
+		return extractCVE(finding)
+	}
+	return extractCWE(finding)
+}
+
+// findingDiffLocation returns the component, URL or file location of a finding, by scan type
+func findingDiffLocation(finding *findings.Finding) string {
+	switch finding.ScanType {
+	case findings.ScanTypeSCA:
+		return extractComponent(finding)
+	case findings.ScanTypeDynamic:
+		return extractURL(finding)
+	default:
+		return extractFileLine(finding)
+	}
+}
+
+// closeFindingsDiff removes the comparison page and returns to the findings view
+func (ui *UI) closeFindingsDiff() {
+	ui.pages.RemovePage(findingsDiffPageName)
+	ui.pages.SwitchToPage("findings")
+	ui.app.SetFocus(ui.findingsTable)
+}
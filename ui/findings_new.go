@@ -0,0 +1,35 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/dipsylala/veracode-tui/services/findings"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// newBadgeCell returns the New column cell, a NEW badge for findings first reported by the latest scan
+func (ui *UI) newBadgeCell(finding *findings.Finding) *tview.TableCell {
+	if !findings.IsNew(finding) {
+		return tview.NewTableCell("").SetExpansion(1)
+	}
+	return tview.NewTableCell("NEW").
+		SetTextColor(tcell.GetColor(ui.theme.New)).
+		SetAttributes(tcell.AttrBold).
+		SetExpansion(1)
+}
+
+// toggleNewFindingsFilter switches between showing every loaded finding and only new ones
+func (ui *UI) toggleNewFindingsFilter() {
+	ui.findingsNewOnly = !ui.findingsNewOnly
+	ui.applyFindingsSearch()
+	if len(ui.findings) > 0 {
+		ui.findingsTable.Select(1, 0)
+	}
+
+	if ui.findingsNewOnly {
+		ui.setFindingsStatus(fmt.Sprintf("[%s]Showing only new findings[-]", ui.theme.New))
+	} else {
+		ui.setFindingsStatus(fmt.Sprintf("[%s]Showing all findings[-]", ui.theme.SecondaryText))
+	}
+}
@@ -0,0 +1,107 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/dipsylala/veracode-tui/services/findings"
+	"github.com/dipsylala/veracode-tui/veracode"
+)
+
+const (
+	// findingsPageSize is the number of findings requested per page
+	findingsPageSize = 500
+
+	// findingsLoadMoreThreshold is how close to the last row the selection must be before the next page is fetched
+	findingsLoadMoreThreshold = 10
+)
+
+// resetFindingsPaging records the first page of a new findings query
+func (ui *UI) resetFindingsPaging(appGUID string, opts *findings.GetFindingsOptions, page *findings.PageMetadata) {
+	ui.findingsQueryAppGUID = appGUID
+	ui.findingsQueryOpts = opts
+	ui.findingsCurrentPage = 0
+	ui.findingsTotalPages = 1
+	ui.findingsTotalElements = 0
+	if page != nil {
+		ui.findingsTotalPages = int(page.TotalPages)
+		ui.findingsTotalElements = page.TotalElements
+	}
+}
+
+// hasMoreFindings reports whether further pages of the current findings query remain
+func (ui *UI) hasMoreFindings() bool {
+	return ui.findingsQueryOpts != nil && ui.findingsCurrentPage < ui.findingsTotalPages-1
+}
+
+// maybeLoadMoreFindings fetches the next page when the selected row nears the bottom of the table
+func (ui *UI) maybeLoadMoreFindings(row int) {
+	if ui.findingsLoadingMore || !ui.hasMoreFindings() {
+		return
+	}
+	if row < ui.findingsTable.GetRowCount()-findingsLoadMoreThreshold {
+		return
+	}
+
+	ui.findingsLoadingMore = true
+	ui.setFindingsStatus(fmt.Sprintf("[%s]Loading more findings...[-]", ui.theme.Pending))
+
+	appGUID := ui.findingsQueryAppGUID
+	baseOpts := ui.findingsQueryOpts
+	opts := *baseOpts
+	opts.Page = ui.findingsCurrentPage + 1
+
+	go func() {
+		result, err := ui.findingsService.GetFindings(appGUID, &opts)
+
+		ui.queueUpdateDraw(func() {
+			ui.findingsLoadingMore = false
+
+			// Discard the page if the filters or context changed while it was loading
+			if ui.findingsQueryOpts != baseOpts {
+				return
+			}
+
+			if err != nil {
+				ui.setFindingsStatus(fmt.Sprintf("[%s]Error loading more findings: %s[-]", ui.theme.Error, veracode.UserMessage(err, "Application")))
+				return
+			}
+
+			ui.findingsCurrentPage = opts.Page
+			if result == nil || result.Embedded == nil {
+				ui.updateCountsLabel()
+				return
+			}
+			ui.appendFindings(result.Embedded.Findings)
+		})
+	}()
+}
+
+// appendFindings adds a newly loaded page to the findings, re-applying the search and keeping the table position.
+// Each page is sorted on its own so rows already on screen do not move.
+func (ui *UI) appendFindings(page []findings.Finding) {
+	ui.sortFindings(page, ui.findingsSortKey, ui.findingsSortAsc)
+	ui.allFindings = append(ui.allFindings, page...)
+	ui.populateFindingsCWEDropdown()
+	ui.findings = ui.filterFindings(ui.allFindings, ui.findingsSearchQuery)
+
+	row, _ := ui.findingsTable.GetSelection()
+	rowOffset, columnOffset := ui.findingsTable.GetOffset()
+	ui.renderFindingsTable()
+	ui.findingsTable.Select(row, 0)
+	ui.findingsTable.SetOffset(rowOffset, columnOffset)
+	ui.updateCountsLabel()
+}
+
+// findingsPageText describes how many findings of the query total are loaded and how many pages
+// have been fetched, so truncated results are visible
+func (ui *UI) findingsPageText() string {
+	if ui.findingsQueryOpts == nil {
+		return ""
+	}
+
+	text := fmt.Sprintf("[white]  |  Showing [%s]%d[white] of [%s]%d[white] findings", ui.theme.Label, len(ui.allFindings), ui.theme.Label, ui.findingsTotalElements)
+	if ui.findingsTotalPages > 1 {
+		text += fmt.Sprintf(" • Page %d/%d", ui.findingsCurrentPage+1, ui.findingsTotalPages)
+	}
+	return text
+}
@@ -0,0 +1,60 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/dipsylala/veracode-tui/services/findings"
+)
+
+// findingsScanTabLabels names the scan type tabs shown above the findings filters
+var findingsScanTabLabels = map[findings.ScanFilterType]string{
+	findings.ScanFilterStatic:  "Static",
+	findings.ScanFilterDynamic: "Dynamic",
+	findings.ScanFilterSCA:     "SCA",
+}
+
+// findingsScanCount returns the total findings of scanType in the current context, as last loaded
+func (ui *UI) findingsScanCount(scanType findings.ScanFilterType) int64 {
+	switch scanType {
+	case findings.ScanFilterDynamic:
+		return ui.dynamicCount
+	case findings.ScanFilterSCA:
+		return ui.scaCount
+	default:
+		return ui.staticCount
+	}
+}
+
+// buildFindingsScanTabs renders a tab per scan type, in the order of findings.ScanFilterTypes, with
+// its count. The tab of the findings shown is highlighted.
+func (ui *UI) buildFindingsScanTabs() string {
+	tabs := make([]string, 0, len(findings.ScanFilterTypes))
+	for _, scanType := range findings.ScanFilterTypes {
+		label := fmt.Sprintf("%s (%d)", findingsScanTabLabels[scanType], ui.findingsScanCount(scanType))
+		if scanType == ui.findingsScanFilter {
+			tabs = append(tabs, fmt.Sprintf("[%s]▌[%s::b]%s[-::-][%s]▐[-]", ui.theme.BorderFocused, ui.theme.ColumnHeader, label, ui.theme.BorderFocused))
+		} else {
+			tabs = append(tabs, fmt.Sprintf(" [%s]%s[-] ", ui.theme.SecondaryText, label))
+		}
+	}
+	return "  " + strings.Join(tabs, fmt.Sprintf("[%s]│[-]", ui.theme.Separator))
+}
+
+// selectFindingsScanTab switches the findings view to the tab of scanType and loads its findings
+func (ui *UI) selectFindingsScanTab(scanType findings.ScanFilterType) {
+	if scanType == ui.findingsScanFilter {
+		return
+	}
+	ui.findingsScanFilter = scanType
+	ui.updateCountsLabel()
+	go ui.loadFindingsWithFilter(scanType)
+}
+
+// cycleFindingsScanTab moves to the previous (-1) or next (1) scan type tab, wrapping around
+func (ui *UI) cycleFindingsScanTab(direction int) {
+	types := findings.ScanFilterTypes
+	index := max(slices.Index(types, ui.findingsScanFilter), 0)
+	ui.selectFindingsScanTab(types[(index+direction+len(types))%len(types)])
+}
@@ -0,0 +1,48 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dipsylala/veracode-tui/services/findings"
+	"github.com/rivo/tview"
+)
+
+func TestCycleFindingsScanTab(t *testing.T) {
+	ui := NewUI(nil, nil, nil, nil, nil)
+	ui.findingsCountsLabel = tview.NewTextView().SetDynamicColors(true)
+
+	steps := []struct {
+		direction int
+		want      findings.ScanFilterType
+	}{
+		{1, findings.ScanFilterDynamic},
+		{1, findings.ScanFilterSCA},
+		{1, findings.ScanFilterStatic},
+		{-1, findings.ScanFilterSCA},
+	}
+	for _, step := range steps {
+		ui.cycleFindingsScanTab(step.direction)
+		if ui.findingsScanFilter != step.want {
+			t.Fatalf("Expected %s after moving %d, got %s", step.want, step.direction, ui.findingsScanFilter)
+		}
+	}
+}
+
+func TestBuildFindingsScanTabs(t *testing.T) {
+	ui := NewUI(nil, nil, nil, nil, nil)
+	ui.findingsScanFilter = findings.ScanFilterDynamic
+	ui.staticCount, ui.dynamicCount, ui.scaCount = 12, 3, 0
+
+	tabs := ui.buildFindingsScanTabs()
+
+	plain := tview.NewTextView().SetDynamicColors(true).SetText(tabs).GetText(true)
+	for _, want := range []string{"Static (12)", "Dynamic (3)", "SCA (0)"} {
+		if !strings.Contains(plain, want) {
+			t.Errorf("Expected tab %q in %q", want, plain)
+		}
+	}
+	if !strings.Contains(tabs, "["+ui.theme.ColumnHeader+"::b]Dynamic (3)") {
+		t.Errorf("Expected the Dynamic tab to be highlighted, got %q", tabs)
+	}
+}
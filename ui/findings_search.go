@@ -0,0 +1,79 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/dipsylala/veracode-tui/services/findings"
+)
+
+// filterFindings returns the findings that pass every client-side filter: the status filter (or
+// mitigable only), new only, the selected CWE, and query, which must be contained in the
+// description, CWE name or file path, ignoring case. With none of these set the input slice is
+// returned unchanged.
+func (ui *UI) filterFindings(findingsList []findings.Finding, query string) []findings.Finding {
+	findingsList = ui.filterFindingsByStatus(findingsList)
+	if ui.findingsNewOnly {
+		findingsList = findings.FilterNew(findingsList)
+	}
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" && ui.findingsCWEFilter == 0 {
+		return findingsList
+	}
+
+	filtered := make([]findings.Finding, 0, len(findingsList))
+	for _, finding := range findingsList {
+		if ui.findingsCWEFilter != 0 && findings.CWEID(&finding) != ui.findingsCWEFilter {
+			continue
+		}
+		if query == "" || findingMatchesQuery(&finding, query) {
+			filtered = append(filtered, finding)
+		}
+	}
+	return filtered
+}
+
+// findingMatchesQuery reports whether any searchable field of finding contains the lower-cased query
+func findingMatchesQuery(finding *findings.Finding, query string) bool {
+	if strings.Contains(strings.ToLower(finding.Description), query) {
+		return true
+	}
+
+	details, ok := finding.FindingDetails.(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	if cweData, ok := details["cwe"].(map[string]interface{}); ok {
+		if name, ok := cweData["name"].(string); ok && strings.Contains(strings.ToLower(name), query) {
+			return true
+		}
+	}
+
+	for _, key := range []string{"file_path", "file_name"} {
+		if value, ok := details[key].(string); ok && strings.Contains(strings.ToLower(value), query) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// applyFindingsSearch narrows the loaded findings to those matching the search query and re-renders the table
+func (ui *UI) applyFindingsSearch() {
+	ui.findings = ui.filterFindings(ui.allFindings, ui.findingsSearchQuery)
+	ui.renderFindingsTable()
+	ui.updateCountsLabel()
+}
+
+// syncFindingAnnotations copies annotations from a displayed finding back to the full loaded set,
+// since a filtered view holds copies rather than references
+func (ui *UI) syncFindingAnnotations(finding *findings.Finding) {
+	if finding == nil {
+		return
+	}
+	for i := range ui.allFindings {
+		if ui.allFindings[i].IssueID == finding.IssueID && &ui.allFindings[i] != finding {
+			ui.allFindings[i].Annotations = finding.Annotations
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package ui
+
+import "fmt"
+
+// severityFilterTitle returns the severity filter's border title for the current matching mode
+func (ui *UI) severityFilterTitle() string {
+	if ui.findingsSeverityExact {
+		return " Severity (s, S: exact) "
+	}
+	return " Min Severity (s, S: and above) "
+}
+
+// toggleSeverityFilterMode switches the severity filter between matching the chosen severity
+// exactly and matching it and above, reloading the findings when a severity is chosen
+func (ui *UI) toggleSeverityFilterMode() {
+	ui.findingsSeverityExact = !ui.findingsSeverityExact
+	ui.findingsSeverityContainer.SetTitle(ui.severityFilterTitle())
+
+	mode := "and above"
+	if ui.findingsSeverityExact {
+		mode = "exact"
+	}
+	ui.showTransientStatus(ui.findingsCountsLabel, fmt.Sprintf("[%s]Severity filter: %s[-]", ui.theme.Info, mode))
+
+	if ui.findingsSeverityFilter > 0 {
+		go ui.loadFindingsWithFilter(ui.findingsScanFilter)
+	}
+}
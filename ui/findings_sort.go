@@ -0,0 +1,121 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/dipsylala/veracode-tui/services/findings"
+)
+
+// SortKey identifies the value the findings table is sorted by
+type SortKey int
+
+// Findings sort keys, in the order 'r' cycles through them
+const (
+	SortFindingsBySeverity SortKey = iota
+	SortFindingsByStatus
+	SortFindingsByIssueID
+	SortFindingsByCWE
+	SortFindingsByPriority
+)
+
+// findingsSortKeyNames are the sort key names shown in status messages, indexed by SortKey
+var findingsSortKeyNames = []string{"Severity", "Status", "Issue ID", "CWE", "Priority"}
+
+// findingsSortKeyHeaders are the findings table headings each sort key applies to, indexed by SortKey.
+// Priority has no column of its own.
+var findingsSortKeyHeaders = []string{"Sev", "Status", "ID", "CWE", ""}
+
+// sortFindings stable-sorts findingsList in place by key. Findings without a status or CWE sort
+// last whichever the direction, and a finding without a severity counts as severity 0. Priority
+// weights the severity by the selected application's business criticality. Ties are broken by
+// ascending issue ID so the order is always the same.
+func (ui *UI) sortFindings(findingsList []findings.Finding, key SortKey, asc bool) {
+	criticality := ui.selectedApp.BusinessCriticality()
+	sort.SliceStable(findingsList, func(i, j int) bool {
+		a, b := &findingsList[i], &findingsList[j]
+		switch key {
+		case SortFindingsByStatus:
+			if sa, sb := findingStatusText(a), findingStatusText(b); sa != sb {
+				return lessText(sa, sb, asc)
+			}
+		case SortFindingsByIssueID:
+			if a.IssueID != b.IssueID {
+				return (a.IssueID < b.IssueID) == asc
+			}
+		case SortFindingsByPriority:
+			pa := findings.PriorityScore(ui.getFindingSeverity(a), criticality)
+			pb := findings.PriorityScore(ui.getFindingSeverity(b), criticality)
+			if pa != pb {
+				return (pa < pb) == asc
+			}
+		case SortFindingsByCWE:
+			if ca, cb := findings.CWEID(a), findings.CWEID(b); ca != cb {
+				if ca == 0 || cb == 0 {
+					return ca != 0
+				}
+				return (ca < cb) == asc
+			}
+		default:
+			if sa, sb := ui.getFindingSeverity(a), ui.getFindingSeverity(b); sa != sb {
+				return (sa < sb) == asc
+			}
+		}
+		return a.IssueID < b.IssueID
+	})
+}
+
+// findingStatusText returns a finding's status, or "" when it has none
+func findingStatusText(finding *findings.Finding) string {
+	if finding.FindingStatus == nil {
+		return ""
+	}
+	return string(finding.FindingStatus.Status)
+}
+
+// defaultFindingsSortAscending returns the initial direction for a newly chosen sort key:
+// highest severity or priority first, otherwise ascending
+func defaultFindingsSortAscending(key SortKey) bool {
+	return key != SortFindingsBySeverity && key != SortFindingsByPriority
+}
+
+// setFindingsSort sorts the loaded findings by key, re-applies the filters and re-renders the table
+func (ui *UI) setFindingsSort(key SortKey, asc bool) {
+	ui.findingsSortKey = key
+	ui.findingsSortAsc = asc
+	ui.sortFindings(ui.allFindings, key, asc)
+	ui.findings = ui.filterFindings(ui.allFindings, ui.findingsSearchQuery)
+	ui.renderFindingsTable()
+	if len(ui.findings) > 0 {
+		ui.findingsTable.Select(1, 0)
+	}
+
+	direction := "descending"
+	if asc {
+		direction = "ascending"
+	}
+	ui.showTransientStatus(ui.findingsCountsLabel, fmt.Sprintf("[%s]Sorted by %s, %s[-]", ui.theme.Info, findingsSortKeyNames[key], direction))
+}
+
+// cycleFindingsSortKey moves the findings sort to the next key in its default direction
+func (ui *UI) cycleFindingsSortKey() {
+	key := (ui.findingsSortKey + 1) % SortKey(len(findingsSortKeyNames))
+	ui.setFindingsSort(key, defaultFindingsSortAscending(key))
+}
+
+// toggleFindingsSortDirection reverses the findings sort
+func (ui *UI) toggleFindingsSortDirection() {
+	ui.setFindingsSort(ui.findingsSortKey, !ui.findingsSortAsc)
+}
+
+// findingsColumnHeader returns a findings table heading, marking the current sort column and
+// direction. SCA findings are grouped by component, so their headings are never marked.
+func (ui *UI) findingsColumnHeader(header string) string {
+	if ui.findingsScanFilter == findings.ScanFilterSCA || header != findingsSortKeyHeaders[ui.findingsSortKey] {
+		return header
+	}
+	if ui.findingsSortAsc {
+		return header + " ▲"
+	}
+	return header + " ▼"
+}
@@ -0,0 +1,84 @@
+package ui
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dipsylala/veracode-tui/services/applications"
+	"github.com/dipsylala/veracode-tui/services/findings"
+)
+
+// mixedFindings returns findings with and without severity, status and CWE
+func mixedFindings() []findings.Finding {
+	withDetails := func(id int64, severity, cwe float64, status findings.Status) findings.Finding {
+		f := findings.Finding{IssueID: id, FindingDetails: map[string]interface{}{
+			"severity": severity,
+			"cwe":      map[string]interface{}{"id": cwe},
+		}}
+		if status != "" {
+			f.FindingStatus = &findings.FindingStatus{Status: status}
+		}
+		return f
+	}
+	return []findings.Finding{
+		withDetails(30, 3, 79, "OPEN"),
+		{IssueID: 10},
+		withDetails(20, 5, 89, "CLOSED"),
+		withDetails(40, 3, 0, ""),
+		withDetails(5, 5, 22, "NEW"),
+	}
+}
+
+func issueIDs(list []findings.Finding) string {
+	ids := make([]int64, len(list))
+	for i := range list {
+		ids[i] = list[i].IssueID
+	}
+	return fmt.Sprint(ids)
+}
+
+func TestSortFindings(t *testing.T) {
+	tests := []struct {
+		name string
+		key  SortKey
+		asc  bool
+		want string
+	}{
+		{"severity descending, ties by issue ID", SortFindingsBySeverity, false, "[5 20 30 40 10]"},
+		{"severity ascending", SortFindingsBySeverity, true, "[10 30 40 5 20]"},
+		{"status ascending, missing last", SortFindingsByStatus, true, "[20 5 30 10 40]"},
+		{"status descending, missing last", SortFindingsByStatus, false, "[30 5 20 10 40]"},
+		{"issue ID ascending", SortFindingsByIssueID, true, "[5 10 20 30 40]"},
+		{"issue ID descending", SortFindingsByIssueID, false, "[40 30 20 10 5]"},
+		{"CWE ascending, missing last", SortFindingsByCWE, true, "[5 30 20 10 40]"},
+		{"CWE descending, missing last", SortFindingsByCWE, false, "[20 30 5 10 40]"},
+		{"priority descending, ties by issue ID", SortFindingsByPriority, false, "[5 20 30 40 10]"},
+		{"priority ascending", SortFindingsByPriority, true, "[10 30 40 5 20]"},
+	}
+
+	ui := &UI{selectedApp: &applications.Application{Profile: &applications.ApplicationProfile{BusinessCriticality: "VERY_HIGH"}}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := mixedFindings()
+			ui.sortFindings(list, tt.key, tt.asc)
+			if got := issueIDs(list); got != tt.want {
+				t.Errorf("sortFindings() order = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindingsColumnHeader(t *testing.T) {
+	ui := &UI{findingsScanFilter: findings.ScanFilterStatic, findingsSortKey: SortFindingsByCWE, findingsSortAsc: true}
+	if got := ui.findingsColumnHeader("CWE"); got != "CWE ▲" {
+		t.Errorf("Expected the sort column to be marked, got %q", got)
+	}
+	if got := ui.findingsColumnHeader("Sev"); got != "Sev" {
+		t.Errorf("Expected other columns unmarked, got %q", got)
+	}
+
+	ui.findingsScanFilter = findings.ScanFilterSCA
+	if got := ui.findingsColumnHeader("CWE"); got != "CWE" {
+		t.Errorf("Expected SCA headings unmarked, got %q", got)
+	}
+}
@@ -0,0 +1,100 @@
+package ui
+
+import (
+	"github.com/dipsylala/veracode-tui/services/findings"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// findingsStatusOptions are the status dropdown entries; index 0 shows every finding. The Mitigable
+// entry keeps policy-violating findings with no mitigation in progress or accepted, whatever their status.
+var findingsStatusOptions = []struct {
+	label     string
+	status    findings.Status
+	mitigable bool
+}{
+	{"All", "", false},
+	{"Open", findings.StatusOpen, false},
+	{"Closed", findings.StatusClosed, false},
+	{"Reopened", findings.StatusReopened, false},
+	{"Mitigable", "", true},
+}
+
+// createFindingsStatusFilter creates the finding status dropdown and its bordered container
+func (ui *UI) createFindingsStatusFilter() *tview.Flex {
+	labels := make([]string, len(findingsStatusOptions))
+	for i, option := range findingsStatusOptions {
+		labels[i] = option.label
+	}
+
+	ui.findingsStatusDropdown = tview.NewDropDown().
+		SetOptions(labels, nil).
+		SetCurrentOption(0).
+		SetFieldWidth(0).
+		SetFieldTextColor(tcell.GetColor(ui.theme.DropDownText)).
+		SetFieldBackgroundColor(tcell.GetColor(ui.theme.DropDownBackground))
+	ui.findingsStatusDropdown.SetListStyles(
+		tcell.StyleDefault.Foreground(tcell.GetColor(ui.theme.DropDownText)).Background(tcell.GetColor(ui.theme.DropDownBackground)),
+		tcell.StyleDefault.Foreground(tcell.GetColor(ui.theme.DropDownSelectedForeground)).Background(tcell.GetColor(ui.theme.DropDownSelectedBackground)))
+
+	container := tview.NewFlex().
+		AddItem(ui.findingsStatusDropdown, 0, 1, false)
+	container.SetBorder(true).
+		SetTitle(" Status (o) ").
+		SetTitleAlign(tview.AlignLeft).
+		SetBorderColor(tcell.GetColor(ui.theme.Border)).
+		SetBorderPadding(0, 0, 1, 1)
+
+	ui.findingsStatusDropdown.SetFocusFunc(func() {
+		container.SetBorderColor(tcell.GetColor(ui.theme.BorderFocused))
+	})
+	ui.findingsStatusDropdown.SetBlurFunc(func() {
+		container.SetBorderColor(tcell.GetColor(ui.theme.Border))
+	})
+	ui.findingsStatusDropdown.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape {
+			ui.app.SetFocus(ui.findingsTable)
+			return nil
+		}
+		return event
+	})
+
+	return container
+}
+
+// setFindingsStatusFilter filters the loaded findings by the status at index in findingsStatusOptions
+func (ui *UI) setFindingsStatusFilter(index int) {
+	if index < 0 || index >= len(findingsStatusOptions) {
+		return
+	}
+	option := findingsStatusOptions[index]
+	if option.status == ui.findingsStatusFilter && option.mitigable == ui.findingsMitigableOnly {
+		return
+	}
+	ui.findingsStatusFilter = option.status
+	ui.findingsMitigableOnly = option.mitigable
+	ui.applyFindingsSearch()
+	if len(ui.findings) > 0 {
+		ui.findingsTable.Select(1, 0)
+	}
+}
+
+// filterFindingsByStatus returns the findings whose status matches the status filter, or only the
+// mitigable findings when that option is chosen. Findings without a status are treated as Unknown,
+// so they are only kept when no status is chosen.
+func (ui *UI) filterFindingsByStatus(findingsList []findings.Finding) []findings.Finding {
+	if ui.findingsMitigableOnly {
+		return findings.FilterMitigable(findingsList)
+	}
+	if ui.findingsStatusFilter == "" {
+		return findingsList
+	}
+
+	filtered := make([]findings.Finding, 0, len(findingsList))
+	for _, finding := range findingsList {
+		if finding.FindingStatus != nil && finding.FindingStatus.Status == ui.findingsStatusFilter {
+			filtered = append(filtered, finding)
+		}
+	}
+	return filtered
+}
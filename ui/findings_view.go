@@ -2,11 +2,14 @@ package ui
 
 import (
 	"fmt"
+	"math"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/dipsylala/veracode-tui/services/annotations"
 	"github.com/dipsylala/veracode-tui/services/findings"
+	"github.com/dipsylala/veracode-tui/veracode"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 )
@@ -17,32 +20,36 @@ func (ui *UI) showFindings() {
 		return
 	}
 
+	// Watching scans and loading context counts only apply while the detail view is shown
+	ui.stopScanWatch()
+	ui.stopContextFindingCounts()
+
 	// Create the findings view components if they don't exist
 	if ui.findingsTable == nil {
 		ui.initializeFindingsView()
 	}
 
-	// Determine context name for title
-	contextName := "Policy Scan"
-	if ui.selectionIndex >= 0 && ui.selectionIndex < len(ui.sandboxes) {
-		contextName = ui.sandboxes[ui.selectionIndex].Name
-	}
-
 	// Update title with application name and context
-	appName := ui.selectedApp.Profile.Name
-	ui.findingsTitleView.SetText(fmt.Sprintf("[white::b]Latest Findings - %s - %s", appName, contextName))
+	ui.updateFindingsTitle()
 	ui.findingsTable.SetTitle("") // Clear the table title
 
 	// Clear existing data and reset filters
 	ui.findings = []findings.Finding{}
+	ui.allFindings = nil
+	ui.clearFindingSelection()
+	ui.findingsSearchQuery = ""
+	ui.findingsSearchInput.SetText("")
+	ui.findingsCWEFilter = 0
+	ui.findingsStatusFilter = ""
+	ui.findingsMitigableOnly = false
+	ui.findingsNewOnly = false
 	ui.selectedFinding = nil
-	ui.findingsScanFilter = findings.ScanFilterStatic
 	ui.findingsSeverityFilter = 0
-	ui.findingsPolicyFilter = findings.PolicyFilterAll
 	ui.scaExpandedComponents = make(map[string]bool)
-	ui.findingsFilter.SetCurrentOption(0)                 // Reset to STATIC
+	ui.resetFindingsFiltersToDefaults()
 	ui.findingsSeverityFilterDropdown.SetCurrentOption(0) // Reset to All
-	ui.findingsPolicyFilterDropdown.SetCurrentOption(0)   // Reset to All
+	ui.findingsStatusDropdown.SetCurrentOption(0)         // Reset to All
+	ui.populateFindingsContextDropdown()
 
 	// Set up the filter callbacks (do this after SetCurrentOption to avoid triggering during init)
 	ui.setupFindingsFilterCallbacks()
@@ -65,11 +72,56 @@ func (ui *UI) showFindings() {
 
 	// Load findings with initial filter after UI is ready
 	// The count for the loaded scan type will come from the response
+	scanFilter := ui.findingsScanFilter
 	go func() {
-		ui.loadFindingsWithFilter("STATIC")
+		ui.loadFindingsWithFilter(scanFilter)
 	}()
 }
 
+// currentContextGUID returns the findings context for the current selection:
+// empty for the policy scan, or the selected sandbox's GUID
+func (ui *UI) currentContextGUID() string {
+	if ui.selectionIndex >= 0 && ui.selectionIndex < len(ui.sandboxes) {
+		return ui.sandboxes[ui.selectionIndex].GUID
+	}
+	return ""
+}
+
+// currentContextName returns the display name of the current findings context
+func (ui *UI) currentContextName() string {
+	if ui.selectionIndex >= 0 && ui.selectionIndex < len(ui.sandboxes) {
+		return ui.sandboxes[ui.selectionIndex].Name
+	}
+	return DefaultContextName
+}
+
+// updateFindingsTitle sets the findings title from the application and current context
+func (ui *UI) updateFindingsTitle() {
+	appName := applicationDisplayName(ui.selectedApp)
+	ui.findingsTitleView.SetText(fmt.Sprintf("[white::b]Latest Findings - %s - %s", appName, ui.currentContextName()))
+}
+
+// populateFindingsContextDropdown fills the context dropdown with the policy scan and loaded sandboxes
+func (ui *UI) populateFindingsContextDropdown() {
+	options := []string{DefaultContextName}
+	for _, sandbox := range ui.sandboxes {
+		options = append(options, sandbox.Name)
+	}
+	ui.findingsContextDropdown.SetOptions(options, nil)
+	ui.findingsContextDropdown.SetCurrentOption(ui.selectionIndex + 1)
+}
+
+// cycleFindingsContext moves to the previous (-1) or next (1) context, wrapping between the
+// policy scan and the last sandbox. Selecting the option reloads the findings and the title.
+func (ui *UI) cycleFindingsContext(direction int) {
+	contexts := len(ui.sandboxes) + 1
+	if contexts < 2 {
+		return
+	}
+	index := (ui.selectionIndex + 1 + direction + contexts) % contexts
+	ui.findingsContextDropdown.SetCurrentOption(index)
+}
+
 // initializeFindingsView creates all the findings view components
 func (ui *UI) initializeFindingsView() {
 	ui.findingsTable = tview.NewTable().
@@ -90,6 +142,9 @@ func (ui *UI) initializeFindingsView() {
 	ui.findingsTable.SetBlurFunc(func() {
 		ui.findingsTable.SetBorderColor(tcell.GetColor(ui.theme.Border))
 	})
+	ui.findingsTable.SetSelectionChangedFunc(func(row, column int) {
+		ui.maybeLoadMoreFindings(row)
+	})
 
 	// Create title view
 	ui.findingsTitleView = tview.NewTextView().
@@ -98,38 +153,6 @@ func (ui *UI) initializeFindingsView() {
 	ui.findingsTitleView.SetBorder(false)
 
 	// Create filter dropdowns with individual borders (matching applications list style)
-	// Scan Type dropdown
-	ui.findingsFilter = tview.NewDropDown().
-		SetOptions([]string{"STATIC", "DYNAMIC", "SCA"}, nil).
-		SetCurrentOption(0).
-		SetFieldWidth(0).
-		SetFieldTextColor(tcell.GetColor(ui.theme.DropDownText)).
-		SetFieldBackgroundColor(tcell.GetColor(ui.theme.DropDownBackground))
-	ui.findingsFilter.SetListStyles(
-		tcell.StyleDefault.Foreground(tcell.GetColor(ui.theme.DropDownText)).Background(tcell.GetColor(ui.theme.DropDownBackground)),
-		tcell.StyleDefault.Foreground(tcell.GetColor(ui.theme.DropDownSelectedForeground)).Background(tcell.GetColor(ui.theme.DropDownSelectedBackground)))
-
-	// Wrap scan type in container with border
-	scanTypeContainer := tview.NewFlex().
-		AddItem(ui.findingsFilter, 0, 1, false)
-	scanTypeContainer.SetBorder(true).
-		SetTitle(" Scan Type (t) ").
-		SetTitleAlign(tview.AlignLeft).
-		SetBorderColor(tcell.GetColor(ui.theme.Border)).
-		SetBorderPadding(0, 0, 1, 1)
-
-	ui.findingsFilter.SetDoneFunc(func(key tcell.Key) {
-		if key == tcell.KeyEnter {
-			ui.app.SetFocus(ui.findingsFilter)
-		}
-	})
-	ui.findingsFilter.SetFocusFunc(func() {
-		scanTypeContainer.SetBorderColor(tcell.GetColor(ui.theme.BorderFocused))
-	})
-	ui.findingsFilter.SetBlurFunc(func() {
-		scanTypeContainer.SetBorderColor(tcell.GetColor(ui.theme.Border))
-	})
-
 	// Min Severity dropdown
 	ui.findingsSeverityFilterDropdown = tview.NewDropDown().
 		SetOptions([]string{"All", "5-Very High", "4-High", "3-Medium", "2-Low", "1-Very Low"}, nil).
@@ -145,10 +168,11 @@ func (ui *UI) initializeFindingsView() {
 	severityContainer := tview.NewFlex().
 		AddItem(ui.findingsSeverityFilterDropdown, 0, 1, false)
 	severityContainer.SetBorder(true).
-		SetTitle(" Min Severity (s) ").
+		SetTitle(ui.severityFilterTitle()).
 		SetTitleAlign(tview.AlignLeft).
 		SetBorderColor(tcell.GetColor(ui.theme.Border)).
 		SetBorderPadding(0, 0, 1, 1)
+	ui.findingsSeverityContainer = severityContainer
 
 	ui.findingsSeverityFilterDropdown.SetDoneFunc(func(key tcell.Key) {
 		if key == tcell.KeyEnter {
@@ -194,6 +218,64 @@ func (ui *UI) initializeFindingsView() {
 		policyContainer.SetBorderColor(tcell.GetColor(ui.theme.Border))
 	})
 
+	// Context dropdown (policy scan or sandbox)
+	ui.findingsContextDropdown = tview.NewDropDown().
+		SetOptions([]string{DefaultContextName}, nil).
+		SetCurrentOption(0).
+		SetFieldWidth(0).
+		SetFieldTextColor(tcell.GetColor(ui.theme.DropDownText)).
+		SetFieldBackgroundColor(tcell.GetColor(ui.theme.DropDownBackground))
+	ui.findingsContextDropdown.SetListStyles(
+		tcell.StyleDefault.Foreground(tcell.GetColor(ui.theme.DropDownText)).Background(tcell.GetColor(ui.theme.DropDownBackground)),
+		tcell.StyleDefault.Foreground(tcell.GetColor(ui.theme.DropDownSelectedForeground)).Background(tcell.GetColor(ui.theme.DropDownSelectedBackground)))
+
+	// Wrap context in container with border
+	contextContainer := tview.NewFlex().
+		AddItem(ui.findingsContextDropdown, 0, 1, false)
+	contextContainer.SetBorder(true).
+		SetTitle(" Context (c) ").
+		SetTitleAlign(tview.AlignLeft).
+		SetBorderColor(tcell.GetColor(ui.theme.Border)).
+		SetBorderPadding(0, 0, 1, 1)
+
+	ui.findingsContextDropdown.SetDoneFunc(func(key tcell.Key) {
+		if key == tcell.KeyEnter {
+			ui.app.SetFocus(ui.findingsContextDropdown)
+		}
+	})
+	ui.findingsContextDropdown.SetFocusFunc(func() {
+		contextContainer.SetBorderColor(tcell.GetColor(ui.theme.BorderFocused))
+	})
+	ui.findingsContextDropdown.SetBlurFunc(func() {
+		contextContainer.SetBorderColor(tcell.GetColor(ui.theme.Border))
+	})
+
+	// Free-text search across the loaded findings
+	ui.findingsSearchInput = tview.NewInputField().
+		SetPlaceholder("description, CWE or file").
+		SetFieldWidth(0).
+		SetFieldTextColor(tcell.GetColor(ui.theme.DropDownText)).
+		SetFieldBackgroundColor(tcell.GetColor(ui.theme.DropDownBackground))
+	ui.findingsSearchInput.SetPlaceholderTextColor(tcell.GetColor(ui.theme.DimmedText))
+
+	searchContainer := tview.NewFlex().
+		AddItem(ui.findingsSearchInput, 0, 1, false)
+	searchContainer.SetBorder(true).
+		SetTitle(" Search (/) ").
+		SetTitleAlign(tview.AlignLeft).
+		SetBorderColor(tcell.GetColor(ui.theme.Border)).
+		SetBorderPadding(0, 0, 1, 1)
+
+	ui.findingsSearchInput.SetFocusFunc(func() {
+		searchContainer.SetBorderColor(tcell.GetColor(ui.theme.BorderFocused))
+	})
+	ui.findingsSearchInput.SetBlurFunc(func() {
+		searchContainer.SetBorderColor(tcell.GetColor(ui.theme.Border))
+	})
+
+	statusContainer := ui.createFindingsStatusFilter()
+	cweContainer := ui.createFindingsCWEFilter()
+
 	// Create counts label
 	ui.findingsCountsLabel = tview.NewTextView().
 		SetDynamicColors(true).
@@ -203,16 +285,19 @@ func (ui *UI) initializeFindingsView() {
 	// Create flex container for filters - all on one line
 	filtersRow := tview.NewFlex().
 		SetDirection(tview.FlexColumn).
-		AddItem(scanTypeContainer, 0, 1, false).
+		AddItem(contextContainer, 0, 1, false).
 		AddItem(severityContainer, 0, 1, false).
-		AddItem(policyContainer, 0, 1, false)
+		AddItem(policyContainer, 0, 1, false).
+		AddItem(statusContainer, 0, 1, false).
+		AddItem(cweContainer, 0, 1, false).
+		AddItem(searchContainer, 0, 1, false)
 
 	// Create keyboard shortcuts bar
 	shortcutsBar := tview.NewTextView().
 		SetDynamicColors(true).
 		SetTextAlign(tview.AlignCenter).
-		SetText(fmt.Sprintf("[%s]Enter/Double-click[-] Details  [%s]t/s/p/f[-] Filters  [%s]ESC[-] Back  [%s]q[-] Quit",
-			ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info))
+		SetText(fmt.Sprintf("[%s]Enter[-] Details  [%s]1/2/3 ←/→[-] Scan Type  [%s]c/s/p/o/w/f[-] Filters  [%s][/][-] Prev/Next Context  [%s]/[-] Search  [%s]Space/m[-] Select/Mitigate  [%s]F5[-] Refresh  [%s]ESC[-] Back  [%s]?[-] All Keys  [%s]q[-] Quit",
+			ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info))
 	shortcutsBar.SetBorder(false)
 
 	ui.findingsFlex = tview.NewFlex().
@@ -273,24 +358,100 @@ func (ui *UI) setupFindingsInputHandlers() {
 			return ui.handleFindingsTabNavigation(true)
 		}
 
+		if isRefreshKey(event) {
+			ui.refreshFindings()
+			return nil
+		}
+
+		// Let the search box receive typed text and its own Escape handling
+		if ui.app.GetFocus() == ui.findingsSearchInput {
+			return event
+		}
+
+		// Left and right switch scan type tabs while the table has focus
+		if ui.app.GetFocus() == ui.findingsTable {
+			switch event.Key() {
+			case tcell.KeyLeft:
+				ui.cycleFindingsScanTab(-1)
+				return nil
+			case tcell.KeyRight:
+				ui.cycleFindingsScanTab(1)
+				return nil
+			}
+		}
+
 		// Handle global hotkeys
 		if event.Key() == tcell.KeyRune {
 			switch event.Rune() {
 			case 'q':
-				ui.app.Stop()
+				ui.stop()
+				return nil
+			case '/':
+				ui.app.SetFocus(ui.findingsSearchInput)
 				return nil
 			case 'f':
 				ui.app.SetFocus(ui.findingsTable)
 				return nil
+			case 'c':
+				ui.app.SetFocus(ui.findingsContextDropdown)
+				return nil
+			case '[':
+				ui.cycleFindingsContext(-1)
+				return nil
+			case ']':
+				ui.cycleFindingsContext(1)
+				return nil
+			case '1', '2', '3':
+				ui.selectFindingsScanTab(findings.ScanFilterTypes[event.Rune()-'1'])
+				return nil
 			case 't':
-				ui.app.SetFocus(ui.findingsFilter)
+				ui.cycleFindingsScanTab(1)
 				return nil
 			case 's':
 				ui.app.SetFocus(ui.findingsSeverityFilterDropdown)
 				return nil
+			case 'S':
+				ui.toggleSeverityFilterMode()
+				return nil
+			case 'r':
+				ui.cycleFindingsSortKey()
+				return nil
+			case 'R':
+				ui.toggleFindingsSortDirection()
+				return nil
 			case 'p':
 				ui.app.SetFocus(ui.findingsPolicyFilterDropdown)
 				return nil
+			case 'o':
+				ui.app.SetFocus(ui.findingsStatusDropdown)
+				return nil
+			case 'w':
+				ui.app.SetFocus(ui.findingsCWEDropdown)
+				return nil
+			case 'e':
+				go ui.exportFindingsToJSON()
+				return nil
+			case 'm':
+				ui.showBatchMitigationModal()
+				return nil
+			case 'X':
+				ui.cancelSelectedProposals()
+				return nil
+			case 'D':
+				ui.showFindingsDiffPicker()
+				return nil
+			case 'g':
+				ui.showFindingsByFile()
+				return nil
+			case 'n':
+				ui.toggleNewFindingsFilter()
+				return nil
+			case 'A':
+				ui.toggleFindingsAnnotations()
+				return nil
+			case 'O':
+				ui.openApplicationResults(ui.findingsCountsLabel)
+				return nil
 			}
 		}
 
@@ -306,6 +467,11 @@ func (ui *UI) setupFindingsInputHandlers() {
 
 	// Table-specific handlers
 	ui.findingsTable.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyRune && event.Rune() == ' ' {
+			row, _ := ui.findingsTable.GetSelection()
+			ui.toggleFindingSelection(row)
+			return nil
+		}
 		if event.Key() == tcell.KeyEnter {
 			row, _ := ui.findingsTable.GetSelection()
 			// For SCA, handle expand/collapse of component groups
@@ -326,8 +492,23 @@ func (ui *UI) setupFindingsInputHandlers() {
 		return event
 	})
 
+	// Search updates the table as the user types; Escape clears it
+	ui.findingsSearchInput.SetChangedFunc(func(text string) {
+		ui.findingsSearchQuery = text
+		ui.applyFindingsSearch()
+	})
+	ui.findingsSearchInput.SetDoneFunc(func(key tcell.Key) {
+		switch key {
+		case tcell.KeyEscape:
+			ui.findingsSearchInput.SetText("")
+			ui.app.SetFocus(ui.findingsTable)
+		case tcell.KeyEnter:
+			ui.app.SetFocus(ui.findingsTable)
+		}
+	})
+
 	// Dropdown escape handlers
-	ui.findingsFilter.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+	ui.findingsContextDropdown.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
 		if event.Key() == tcell.KeyEscape {
 			ui.app.SetFocus(ui.findingsTable)
 			return nil
@@ -355,9 +536,12 @@ func (ui *UI) setupFindingsInputHandlers() {
 // handleFindingsTabNavigation handles Tab and Shift-Tab navigation between fields
 func (ui *UI) handleFindingsTabNavigation(reverse bool) *tcell.EventKey {
 	focusables := []tview.Primitive{
-		ui.findingsFilter,
+		ui.findingsContextDropdown,
 		ui.findingsSeverityFilterDropdown,
 		ui.findingsPolicyFilterDropdown,
+		ui.findingsStatusDropdown,
+		ui.findingsCWEDropdown,
+		ui.findingsSearchInput,
 		ui.findingsTable,
 	}
 
@@ -395,21 +579,20 @@ func (ui *UI) handleFindingsTabNavigation(reverse bool) *tcell.EventKey {
 
 // setupFindingsFilterCallbacks configures the filter change callbacks
 func (ui *UI) setupFindingsFilterCallbacks() {
-	ui.findingsFilter.SetSelectedFunc(func(text string, index int) {
+	ui.findingsContextDropdown.SetSelectedFunc(func(text string, index int) {
+		if index-1 == ui.selectionIndex {
+			return
+		}
+		// Index 0 is the policy scan, 1+ map to sandboxes
+		ui.selectionIndex = index - 1
+		ui.staticCount = 0
+		ui.dynamicCount = 0
+		ui.scaCount = 0
+		ui.scaExpandedComponents = make(map[string]bool)
+		ui.updateFindingsTitle()
+		ui.updateCountsLabel()
 		go func() {
-			// Convert dropdown text to ScanFilterType
-			var scanFilter findings.ScanFilterType
-			switch text {
-			case "STATIC":
-				scanFilter = findings.ScanFilterStatic
-			case "DYNAMIC":
-				scanFilter = findings.ScanFilterDynamic
-			case "SCA":
-				scanFilter = findings.ScanFilterSCA
-			default:
-				scanFilter = findings.ScanFilterStatic
-			}
-			ui.loadFindingsWithFilter(scanFilter)
+			ui.loadFindingsWithFilter(ui.findingsScanFilter)
 		}()
 	})
 
@@ -438,6 +621,10 @@ func (ui *UI) setupFindingsFilterCallbacks() {
 			ui.loadFindingsWithFilter(ui.findingsScanFilter)
 		}()
 	})
+
+	ui.findingsStatusDropdown.SetSelectedFunc(func(text string, index int) {
+		ui.setFindingsStatusFilter(index)
+	})
 }
 
 // loadFindingsWithFilter loads findings with the specified scan type filter
@@ -447,23 +634,20 @@ func (ui *UI) loadFindingsWithFilter(scanType findings.ScanFilterType) {
 	}
 
 	ui.findingsScanFilter = scanType
-
-	// Determine context value
-	contextValue := ""
-	if ui.selectionIndex >= 0 && ui.selectionIndex < len(ui.sandboxes) {
-		sandbox := ui.sandboxes[ui.selectionIndex]
-		contextValue = sandbox.GUID
-	}
+	ui.clearFindingSelection()
 
 	// Capture variables for the goroutine
 	appGUID := ui.selectedApp.GUID
-	capturedContextValue := contextValue
+	capturedContextValue := ui.currentContextGUID()
 	capturedScanType := string(scanType)
 	capturedSeverity := ui.findingsSeverityFilter
+	capturedSeverityExact := ui.findingsSeverityExact
+	capturedAnnotations := ui.findingsAnnotations
 	capturedPolicyFilter := ui.findingsPolicyFilter
 
 	// Show loading
-	ui.app.QueueUpdateDraw(func() {
+	ui.queueUpdateDraw(func() {
+		ui.findingsQueryOpts = nil
 		ui.findingsTable.Clear()
 		loadingCell := tview.NewTableCell(fmt.Sprintf("Loading %s findings...", capturedScanType)).
 			SetTextColor(tcell.GetColor(ui.theme.Pending)).
@@ -476,34 +660,25 @@ func (ui *UI) loadFindingsWithFilter(scanType findings.ScanFilterType) {
 		opts := &findings.GetFindingsOptions{
 			Context:            capturedContextValue,
 			ScanType:           []string{capturedScanType},
-			Size:               500,
-			IncludeAnnotations: capturedScanType != "SCA", // Not valid for SCA scan type per API spec
+			Size:               findingsPageSize,
+			IncludeAnnotations: capturedAnnotations && capturedScanType != "SCA", // Not valid for SCA scan type per API spec
 		}
 
-		// Apply severity filter if set
-		if capturedSeverity > 0 {
-			opts.SeverityGTE = capturedSeverity
-		}
+		// Apply severity filter if set, as that severity and above unless toggled to exact
+		opts.SetSeverityFilter(capturedSeverity, !capturedSeverityExact)
 
 		// Apply policy filter if set
-		switch capturedPolicyFilter {
-		case findings.PolicyFilterViolations:
-			violates := true
-			opts.ViolatesPolicy = &violates
-		case findings.PolicyFilterNonViolations:
-			violates := false
-			opts.ViolatesPolicy = &violates
-		}
+		opts.ViolatesPolicy = capturedPolicyFilter.ToViolatesPolicy()
 
 		result, err := ui.findingsService.GetFindings(appGUID, opts)
 
 		if err != nil {
-			ui.app.QueueUpdateDraw(func() {
+			ui.queueUpdateDraw(func() {
 				// Show error in the table
 				ui.findings = []findings.Finding{}
 
 				// Show error message in table
-				errorMsg := fmt.Sprintf("Error loading findings: %s", err.Error())
+				errorMsg := fmt.Sprintf("Error loading findings: %s", veracode.UserMessage(err, "Application"))
 				errorCell := tview.NewTableCell(errorMsg).
 					SetTextColor(tcell.GetColor(ui.theme.Error)).
 					SetAlign(tview.AlignCenter).
@@ -518,41 +693,50 @@ func (ui *UI) loadFindingsWithFilter(scanType findings.ScanFilterType) {
 		if result != nil && result.Embedded != nil {
 			ui.findings = result.Embedded.Findings
 
-			// Sort findings by severity (highest first)
-			ui.sortFindingsBySeverity()
+			// Sort findings by the chosen key (severity, highest first, by default)
+			ui.sortFindings(ui.findings, ui.findingsSortKey, ui.findingsSortAsc)
+			ui.allFindings = ui.findings
 
-			// Update the count for this scan type from the response
+			// Update the count for this scan type from the response, and fetch the others in background
 			if result.Page != nil {
-				if capturedScanType == string(findings.ScanTypeStatic) {
+				switch capturedScanType {
+				case string(findings.ScanTypeStatic):
 					ui.staticCount = result.Page.TotalElements
-					// Fetch dynamic count in background
-					go ui.loadDynamicCount()
-				} else if capturedScanType == string(findings.ScanTypeDynamic) {
+				case string(findings.ScanTypeDynamic):
 					ui.dynamicCount = result.Page.TotalElements
-					// Fetch static count in background
-					go ui.loadStaticCount()
-				} else if capturedScanType == string(findings.ScanTypeSCA) {
+				case string(findings.ScanTypeSCA):
 					ui.scaCount = result.Page.TotalElements
-					// Fetch other counts in background
-					go ui.loadStaticCount()
 				}
+				go ui.loadOtherScanCounts(findings.ScanType(capturedScanType))
 			}
 		} else {
 			ui.findings = []findings.Finding{}
+			ui.allFindings = ui.findings
 		}
 
 		// Update the table with findings
-		ui.app.QueueUpdateDraw(func() {
+		ui.queueUpdateDraw(func() {
+			var page *findings.PageMetadata
+			if result != nil {
+				page = result.Page
+			}
+			ui.resetFindingsPaging(appGUID, opts, page)
+
 			ui.findingsTable.SetTitle(fmt.Sprintf(" %s ", capturedScanType))
 
+			// Re-apply any active search and CWE filter to the newly loaded findings
+			ui.populateFindingsCWEDropdown()
+			ui.findings = ui.filterFindings(ui.allFindings, ui.findingsSearchQuery)
 			ui.renderFindingsTable()
 			ui.updateCountsLabel()
 			// Auto-select first finding if available
 			if len(ui.findings) > 0 {
 				ui.findingsTable.Select(1, 0)
 			}
-			// Set focus to the findings table after loading
-			ui.app.SetFocus(ui.findingsTable)
+			// Set focus to the findings table after loading, unless a finding was opened meanwhile
+			if frontPage, _ := ui.pages.GetFrontPage(); frontPage == "findings" {
+				ui.app.SetFocus(ui.findingsTable)
+			}
 		})
 	}()
 }
@@ -600,20 +784,17 @@ func (ui *UI) renderEmptyFindingsTable() {
 		ui.findingsTable.SetCell(0, col, cell)
 	}
 
-	cell := tview.NewTableCell("No findings to display").
-		SetTextColor(tcell.GetColor(ui.theme.SecondaryText)).
-		SetAlign(tview.AlignCenter).
-		SetExpansion(1)
-	ui.findingsTable.SetCell(1, 0, cell)
+	filtered := len(ui.allFindings) > 0
+	ui.findingsTable.SetCell(1, 0, ui.emptyStateCell(emptyFindingsMessage(ui.findingsScanFilter, filtered)))
 }
 
 // getFindingsTableHeaders returns the appropriate headers based on scan type
 func (ui *UI) getFindingsTableHeaders(scanFilter findings.ScanFilterType) []string {
 	switch scanFilter {
 	case findings.ScanFilterStatic:
-		return []string{"ID", "Policy", "CWE", "Sev", "Module", "File:Line", "Attack Vector", "First Found", "Status"}
+		return []string{"ID", "New", "Policy", "CWE", "Sev", "Module", "File:Line", "Attack Vector", "First Found", "Grace", "Status"}
 	case findings.ScanFilterDynamic:
-		return []string{"ID", "Policy", "CWE", "Sev", "URL", "Parameter", "First Found", "Status"}
+		return []string{"ID", "New", "Policy", "CWE", "Sev", "URL", "Parameter", "First Found", "Grace", "Status"}
 	case findings.ScanFilterSCA:
 		return []string{"Component", "Version", "Policy", "Sev:5", "Sev:4", "Sev:3", "Sev:2", "Sev:1", "CVEs", "First Found", "Status"}
 	default:
@@ -624,7 +805,7 @@ func (ui *UI) getFindingsTableHeaders(scanFilter findings.ScanFilterType) []stri
 // renderTableHeaders renders the header row
 func (ui *UI) renderTableHeaders(headers []string) {
 	for col, header := range headers {
-		cell := tview.NewTableCell(header).
+		cell := tview.NewTableCell(ui.findingsColumnHeader(header)).
 			SetTextColor(tcell.GetColor(ui.theme.ColumnHeader)).
 			SetAttributes(tcell.AttrBold).
 			SetSelectable(false).
@@ -675,7 +856,11 @@ func (ui *UI) renderStaticRow(rowNum int, finding *findings.Finding) {
 	col := 0
 
 	// Issue ID
-	ui.findingsTable.SetCell(rowNum, col, tview.NewTableCell(fmt.Sprintf("%d", finding.IssueID)).SetExpansion(1))
+	ui.findingsTable.SetCell(rowNum, col, tview.NewTableCell(ui.issueIDCellText(finding)).SetExpansion(1))
+	col++
+
+	// New in the latest scan
+	ui.findingsTable.SetCell(rowNum, col, ui.newBadgeCell(finding))
 	col++
 
 	// Policy indicator
@@ -687,9 +872,7 @@ func (ui *UI) renderStaticRow(rowNum int, finding *findings.Finding) {
 	col++
 
 	// Severity
-	severity := extractSeverity(finding)
-	sevColor := ui.getSeverityColor(severity)
-	ui.findingsTable.SetCell(rowNum, col, tview.NewTableCell(severity).SetTextColor(sevColor).SetExpansion(1))
+	ui.findingsTable.SetCell(rowNum, col, ui.newSeverityCell(finding))
 	col++
 
 	// Module
@@ -712,6 +895,11 @@ func (ui *UI) renderStaticRow(rowNum int, finding *findings.Finding) {
 	ui.findingsTable.SetCell(rowNum, col, tview.NewTableCell(firstFound).SetExpansion(1))
 	col++
 
+	// Grace period remaining
+	graceText, graceColor := ui.gracePeriodText(finding)
+	ui.findingsTable.SetCell(rowNum, col, tview.NewTableCell(graceText).SetTextColor(tcell.GetColor(graceColor)).SetExpansion(1))
+	col++
+
 	// Status
 	status := extractStatus(finding)
 	statusColor := ui.getStatusColor(finding)
@@ -725,7 +913,11 @@ func (ui *UI) renderDynamicRow(rowNum int, finding *findings.Finding) {
 	col := 0
 
 	// Issue ID
-	ui.findingsTable.SetCell(rowNum, col, tview.NewTableCell(fmt.Sprintf("%d", finding.IssueID)).SetExpansion(1))
+	ui.findingsTable.SetCell(rowNum, col, tview.NewTableCell(ui.issueIDCellText(finding)).SetExpansion(1))
+	col++
+
+	// New in the latest scan
+	ui.findingsTable.SetCell(rowNum, col, ui.newBadgeCell(finding))
 	col++
 
 	// Policy indicator
@@ -737,9 +929,7 @@ func (ui *UI) renderDynamicRow(rowNum int, finding *findings.Finding) {
 	col++
 
 	// Severity
-	severity := extractSeverity(finding)
-	sevColor := ui.getSeverityColor(severity)
-	ui.findingsTable.SetCell(rowNum, col, tview.NewTableCell(severity).SetTextColor(sevColor).SetExpansion(1))
+	ui.findingsTable.SetCell(rowNum, col, ui.newSeverityCell(finding))
 	col++
 
 	// URL
@@ -757,6 +947,11 @@ func (ui *UI) renderDynamicRow(rowNum int, finding *findings.Finding) {
 	ui.findingsTable.SetCell(rowNum, col, tview.NewTableCell(firstFound).SetExpansion(1))
 	col++
 
+	// Grace period remaining
+	graceText, graceColor := ui.gracePeriodText(finding)
+	ui.findingsTable.SetCell(rowNum, col, tview.NewTableCell(graceText).SetTextColor(tcell.GetColor(graceColor)).SetExpansion(1))
+	col++
+
 	// Status
 	status := extractStatus(finding)
 	statusColor := ui.getStatusColor(finding)
@@ -792,19 +987,7 @@ func (ui *UI) renderSCARow(rowNum int, finding *findings.Finding) {
 	col++
 
 	// Severity
-	severity := extractSeverity(finding)
-	sevColor := ui.getSeverityColor(severity)
-	ui.findingsTable.SetCell(rowNum, col, tview.NewTableCell(severity).SetTextColor(sevColor).SetExpansion(1))
-}
-
-// SCAComponent represents a grouped component with its CVEs
-type SCAComponent struct {
-	Name         string
-	Version      string
-	CVEs         []*findings.Finding
-	SevCounts    map[int]int
-	WorstSev     int
-	HasViolation bool
+	ui.findingsTable.SetCell(rowNum, col, ui.newSeverityCell(finding))
 }
 
 // renderSCAGroupedFindings renders SCA findings grouped by component
@@ -813,7 +996,9 @@ func (ui *UI) renderSCAGroupedFindings() {
 	components := ui.groupSCAByComponent()
 
 	rowNum := 1
-	for _, comp := range components {
+	for i := range components {
+		comp := &components[i]
+
 		// Render component summary row
 		ui.renderSCAComponentRow(rowNum, comp)
 		rowNum++
@@ -821,7 +1006,7 @@ func (ui *UI) renderSCAGroupedFindings() {
 		// If expanded, render CVE detail rows
 		componentKey := comp.Name + "|" + comp.Version
 		if ui.scaExpandedComponents[componentKey] {
-			for _, cve := range comp.CVEs {
+			for _, cve := range comp.Findings {
 				ui.renderSCACVERow(rowNum, cve)
 				rowNum++
 			}
@@ -829,68 +1014,26 @@ func (ui *UI) renderSCAGroupedFindings() {
 	}
 }
 
-// groupSCAByComponent groups findings by component name and version
-func (ui *UI) groupSCAByComponent() []*SCAComponent {
-	componentMap := make(map[string]*SCAComponent)
-
-	for i := range ui.findings {
-		finding := &ui.findings[i]
-		component := extractComponent(finding)
-		version := extractVersion(finding)
-		key := component + "|" + version
-
-		comp, exists := componentMap[key]
-		if !exists {
-			comp = &SCAComponent{
-				Name:      component,
-				Version:   version,
-				CVEs:      []*findings.Finding{},
-				SevCounts: make(map[int]int),
-				WorstSev:  0,
-			}
-			componentMap[key] = comp
-		}
-
-		comp.CVEs = append(comp.CVEs, finding)
-
-		// Track severity counts
-		sev := ui.getFindingSeverity(finding)
-		comp.SevCounts[sev]++
-		if sev > comp.WorstSev {
-			comp.WorstSev = sev
-		}
-
-		// Track if any CVE violates policy
-		if finding.ViolatesPolicy {
-			comp.HasViolation = true
-		}
-	}
-
-	// Convert map to sorted slice (by severity counts, then name)
-	components := make([]*SCAComponent, 0, len(componentMap))
-	for _, comp := range componentMap {
-		components = append(components, comp)
-	}
+// groupSCAByComponent groups the loaded findings by component name and version,
+// ordered by severity counts then name
+func (ui *UI) groupSCAByComponent() []findings.SCAComponent {
+	components := findings.GroupSCAByComponent(ui.findings)
 
 	// Sort by severity counts (5, 4, 3, 2, 1 in descending order), then by name
-	for i := 0; i < len(components); i++ {
-		for j := i + 1; j < len(components); j++ {
-			if ui.shouldSwapComponents(components[i], components[j]) {
-				components[i], components[j] = components[j], components[i]
-			}
-		}
-	}
+	sort.SliceStable(components, func(i, j int) bool {
+		return ui.shouldSwapComponents(&components[j], &components[i])
+	})
 
 	return components
 }
 
 // shouldSwapComponents determines if two components should be swapped during sorting
-func (ui *UI) shouldSwapComponents(a, b *SCAComponent) bool {
+func (ui *UI) shouldSwapComponents(a, b *findings.SCAComponent) bool {
 	// Compare severity counts from 5 down to 1
 	for sev := 5; sev >= 1; sev-- {
-		if a.SevCounts[sev] < b.SevCounts[sev] {
+		if a.SeverityCounts[sev] < b.SeverityCounts[sev] {
 			return true
-		} else if a.SevCounts[sev] > b.SevCounts[sev] {
+		} else if a.SeverityCounts[sev] > b.SeverityCounts[sev] {
 			return false
 		}
 	}
@@ -932,16 +1075,15 @@ func (ui *UI) getWorstCVEStatus(cves []*findings.Finding) *findings.Finding {
 }
 
 // renderSeverityCounts renders the severity count columns for SCA component row
-func (ui *UI) renderSeverityCounts(rowNum int, col int, comp *SCAComponent) int {
+func (ui *UI) renderSeverityCounts(rowNum int, col int, comp *findings.SCAComponent) int {
 	for sev := 5; sev >= 1; sev-- {
-		count := comp.SevCounts[sev]
+		count := comp.SeverityCounts[sev]
 		countText := "-"
 		if count > 0 {
 			countText = fmt.Sprintf("%d", count)
 		}
-		sevColor := ui.getSeverityColor(fmt.Sprintf("%d", sev))
 		ui.findingsTable.SetCell(rowNum, col, tview.NewTableCell(countText).
-			SetTextColor(sevColor).
+			SetTextColor(tcell.GetColor(ui.severityColor(sev))).
 			SetExpansion(1))
 		col++
 	}
@@ -949,7 +1091,7 @@ func (ui *UI) renderSeverityCounts(rowNum int, col int, comp *SCAComponent) int
 }
 
 // renderSCAComponentRow renders a component summary row
-func (ui *UI) renderSCAComponentRow(rowNum int, comp *SCAComponent) {
+func (ui *UI) renderSCAComponentRow(rowNum int, comp *findings.SCAComponent) {
 	col := 0
 	componentKey := comp.Name + "|" + comp.Version
 	expanded := ui.scaExpandedComponents[componentKey]
@@ -959,7 +1101,7 @@ func (ui *UI) renderSCAComponentRow(rowNum int, comp *SCAComponent) {
 	if expanded {
 		expandChar = "▼"
 	}
-	componentText := fmt.Sprintf("%s %s", expandChar, comp.Name)
+	componentText := fmt.Sprintf("%s %s", expandChar, valueOrDash(comp.Name))
 	ui.findingsTable.SetCell(rowNum, col, tview.NewTableCell(componentText).
 		SetTextColor(tcell.GetColor(ui.theme.DefaultText)).
 		SetAttributes(tcell.AttrBold).
@@ -967,13 +1109,13 @@ func (ui *UI) renderSCAComponentRow(rowNum int, comp *SCAComponent) {
 	col++
 
 	// Version
-	ui.findingsTable.SetCell(rowNum, col, tview.NewTableCell(comp.Version).SetExpansion(1))
+	ui.findingsTable.SetCell(rowNum, col, tview.NewTableCell(valueOrDash(comp.Version)).SetExpansion(1))
 	col++
 
 	// Policy indicator (whether component affects policy)
 	statusText := " "
 	statusColor := tcell.GetColor(ui.theme.PolicyNeutral)
-	if comp.HasViolation {
+	if comp.ViolatesPolicy {
 		statusText = EmojiViolatesPolicy
 		statusColor = tcell.GetColor(ui.theme.PolicyFail)
 	}
@@ -987,17 +1129,17 @@ func (ui *UI) renderSCAComponentRow(rowNum int, comp *SCAComponent) {
 	col = ui.renderSeverityCounts(rowNum, col, comp)
 
 	// Total CVEs count
-	ui.findingsTable.SetCell(rowNum, col, tview.NewTableCell(fmt.Sprintf("%d", len(comp.CVEs))).
+	ui.findingsTable.SetCell(rowNum, col, tview.NewTableCell(fmt.Sprintf("%d", len(comp.Findings))).
 		SetExpansion(1))
 	col++
 
 	// First Found Date (earliest from all CVEs)
-	firstFound := ui.getEarliestCVEDate(comp.CVEs)
+	firstFound := ui.getEarliestCVEDate(comp.Findings)
 	ui.findingsTable.SetCell(rowNum, col, tview.NewTableCell(firstFound).SetExpansion(1))
 	col++
 
 	// Status (aggregate status across CVEs - show worst status)
-	worstStatus := ui.getWorstCVEStatus(comp.CVEs)
+	worstStatus := ui.getWorstCVEStatus(comp.Findings)
 	status := "-"
 	statusTextColor := tcell.GetColor(ui.theme.DefaultText)
 	if worstStatus != nil {
@@ -1048,12 +1190,8 @@ func (ui *UI) renderSCACVERow(rowNum int, finding *findings.Finding) {
 	col++
 
 	// Severity columns - show "*" in the appropriate column
-	severity := extractSeverity(finding)
-	sevValue := 0
-	if severity != "-" {
-		_, _ = fmt.Sscanf(severity, "%d", &sevValue)
-	}
-	sevColor := ui.getSeverityColor(severity)
+	sevValue := ui.getFindingSeverity(finding)
+	sevColor := tcell.GetColor(ui.severityColor(sevValue))
 
 	for sev := 5; sev >= 1; sev-- {
 		if sev == sevValue {
@@ -1110,16 +1248,16 @@ func (ui *UI) handleSCARowSelection(row int) {
 
 		// Skip expanded CVE rows
 		if ui.scaExpandedComponents[comp.Name+"|"+comp.Version] {
-			if row >= currentRow && row < currentRow+len(comp.CVEs) {
+			if row >= currentRow && row < currentRow+len(comp.Findings) {
 				// Clicked on a CVE row - show SCA detail
 				cveIndex := row - currentRow
-				if cveIndex < len(comp.CVEs) {
-					ui.selectedFinding = comp.CVEs[cveIndex]
+				if cveIndex < len(comp.Findings) {
+					ui.selectedFinding = comp.Findings[cveIndex]
 					ui.showSCAFindingDetail()
 				}
 				return
 			}
-			currentRow += len(comp.CVEs)
+			currentRow += len(comp.Findings)
 		}
 	}
 }
@@ -1138,8 +1276,68 @@ func (ui *UI) renderPolicyIndicator(rowNum, col int, finding *findings.Finding)
 	return col + 1
 }
 
+// gracePeriodWarningDays is how many days before expiry a grace period is highlighted as a warning
+const gracePeriodWarningDays = 7
+
+// gracePeriodDays returns the whole days left in a finding's policy grace period (zero or less once
+// expired) and the theme color to show it in: warning when under a week remains, error once expired
+func (ui *UI) gracePeriodDays(finding *findings.Finding) (int, string, bool) {
+	remaining, applies := findings.GracePeriodRemaining(*finding, time.Now())
+	if !applies {
+		return 0, ui.theme.SecondaryText, false
+	}
+	if remaining <= 0 {
+		return 0, ui.theme.Error, true
+	}
+
+	days := int(math.Ceil(remaining.Hours() / 24))
+	if days < gracePeriodWarningDays {
+		return days, ui.theme.Warning, true
+	}
+	return days, ui.theme.DefaultText, true
+}
+
+// gracePeriodText returns the grace period column text and its theme color
+func (ui *UI) gracePeriodText(finding *findings.Finding) (string, string) {
+	days, color, applies := ui.gracePeriodDays(finding)
+	switch {
+	case !applies:
+		return "-", color
+	case days <= 0:
+		return "Expired", color
+	default:
+		return fmt.Sprintf("%dd", days), color
+	}
+}
+
+// gracePeriodDetail formats a finding's grace period expiry date with the days remaining, for detail views
+func (ui *UI) gracePeriodDetail(finding *findings.Finding) string {
+	if finding.GracePeriodExpiresDate == nil {
+		return fmt.Sprintf("[white]%s[-]", TextNotAvailable)
+	}
+
+	detail := fmt.Sprintf("[white]%s[-]", finding.GracePeriodExpiresDate.Format("2006-01-02"))
+	days, color, applies := ui.gracePeriodDays(finding)
+	switch {
+	case !applies:
+		return detail
+	case days <= 0:
+		return detail + fmt.Sprintf(" [%s](expired)[-]", color)
+	default:
+		return detail + fmt.Sprintf(" [%s](%d days remaining)[-]", color, days)
+	}
+}
+
 // Helper functions for extracting finding data
 
+// valueOrDash returns "-" in place of an empty value
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
 func getPolicyIndicator(finding *findings.Finding) string {
 	if finding.FindingStatus != nil {
 		// APPROVED mitigations
@@ -1193,26 +1391,75 @@ func extractSeverity(finding *findings.Finding) string {
 		return fmt.Sprintf("%d", int(severity))
 	}
 
+	// SCA findings may only carry a CVSS score, which is banded onto the same scale
+	if _, ok := findings.FindingCVSS(finding); ok && finding.ScanType == findings.ScanTypeSCA {
+		return fmt.Sprintf("%d", findings.FindingSeverity(finding))
+	}
+
 	return "-"
 }
 
-func (ui *UI) getSeverityColor(severity string) tcell.Color {
-	switch severity {
-	case "5":
-		return tcell.GetColor(ui.theme.SeverityVeryHigh)
-	case "4":
-		return tcell.GetColor(ui.theme.SeverityHigh)
-	case "3":
-		return tcell.GetColor(ui.theme.SeverityMedium)
-	case "2":
-		return tcell.GetColor(ui.theme.SeverityLow)
-	case "1", "0":
-		return tcell.GetColor(ui.theme.SeverityVeryLow)
+// severityColor maps a severity level (5 Very High to 0 Very Low) to its theme color
+func (ui *UI) severityColor(sev int) string {
+	switch sev {
+	case findings.SeverityVeryHigh:
+		return ui.theme.SeverityVeryHigh
+	case findings.SeverityHigh:
+		return ui.theme.SeverityHigh
+	case findings.SeverityMedium:
+		return ui.theme.SeverityMedium
+	case findings.SeverityLow:
+		return ui.theme.SeverityLow
+	case findings.SeverityVeryLow, findings.SeverityInformational:
+		return ui.theme.SeverityVeryLow
 	default:
-		return tcell.GetColor(ui.theme.SeverityDefault)
+		return ui.theme.SeverityDefault
 	}
 }
 
+// severityColorsDistinct reports whether the theme tells severities apart by color.
+// Themes such as monochrome use a single color, so severity must be conveyed by text.
+func (ui *UI) severityColorsDistinct() bool {
+	return ui.theme.SeverityVeryHigh != ui.theme.SeverityVeryLow ||
+		ui.theme.SeverityHigh != ui.theme.SeverityLow
+}
+
+// severitySymbol returns a shape marking a severity level, so it can be told apart without color
+func severitySymbol(sev int) string {
+	switch sev {
+	case findings.SeverityVeryHigh:
+		return "▲▲"
+	case findings.SeverityHigh:
+		return "▲"
+	case findings.SeverityMedium:
+		return "■"
+	case findings.SeverityLow:
+		return "▼"
+	default:
+		return "▼▼"
+	}
+}
+
+// newSeverityCell creates a findings table cell for the finding's severity, colored by level.
+// When the theme cannot distinguish severities by color, or asks for severity labels, the
+// severity symbol and name are added to the text.
+func (ui *UI) newSeverityCell(finding *findings.Finding) *tview.TableCell {
+	text := extractSeverity(finding)
+	if text == "-" {
+		return tview.NewTableCell(text).
+			SetTextColor(tcell.GetColor(ui.theme.SeverityDefault)).
+			SetExpansion(1)
+	}
+
+	sev := ui.getFindingSeverity(finding)
+	if !ui.severityColorsDistinct() || ui.theme.SeverityLabels {
+		text = fmt.Sprintf("%s %d %s", severitySymbol(sev), sev, findings.SeverityName(sev))
+	}
+	return tview.NewTableCell(text).
+		SetTextColor(tcell.GetColor(ui.severityColor(sev))).
+		SetExpansion(1)
+}
+
 func extractModule(finding *findings.Finding) string {
 	if finding.FindingDetails == nil {
 		return "-"
@@ -1240,11 +1487,7 @@ func extractAttackVector(finding *findings.Finding) string {
 	}
 
 	if attackVector, ok := details["attack_vector"].(string); ok && attackVector != "" {
-		// Truncate if too long (e.g., show first 50 chars)
-		if len(attackVector) > 50 {
-			return attackVector[:47] + "..."
-		}
-		return attackVector
+		return truncateRunes(attackVector, 50)
 	}
 	return "-"
 }
@@ -1291,9 +1534,7 @@ func extractProcedureLocation(details map[string]interface{}) string {
 			procedure = parts[len(parts)-1]
 		}
 		// Truncate if still too long
-		if len(procedure) > 30 {
-			procedure = procedure[:27] + "..."
-		}
+		procedure = truncateRunes(procedure, 30)
 	}
 
 	percentage := ""
@@ -1347,11 +1588,7 @@ func extractURL(finding *findings.Finding) string {
 	}
 
 	if url, ok := details["url"].(string); ok {
-		// Truncate long URLs
-		if len(url) > 50 {
-			return url[:47] + "..."
-		}
-		return url
+		return truncateRunes(url, 50)
 	}
 	return "-"
 }
@@ -1590,72 +1827,58 @@ func (ui *UI) loadFindingsCount(scanType findings.ScanType, updateCount func(int
 		return
 	}
 
-	// Determine context value
-	contextValue := ""
-	if ui.selectionIndex >= 0 && ui.selectionIndex < len(ui.sandboxes) {
-		contextValue = ui.sandboxes[ui.selectionIndex].GUID
-	}
-
 	result, err := ui.findingsService.GetFindings(ui.selectedApp.GUID, &findings.GetFindingsOptions{
-		Context:  contextValue,
+		Context:  ui.currentContextGUID(),
 		ScanType: []string{string(scanType)},
 		Size:     1, // We only need the count
 	})
 	if err == nil && result.Page != nil {
 		updateCount(result.Page.TotalElements)
-		ui.app.QueueUpdateDraw(func() {
+		ui.queueUpdateDraw(func() {
 			ui.updateCountsLabel()
 		})
 	}
 }
 
-// loadStaticCount fetches only the static findings count
-func (ui *UI) loadStaticCount() {
-	ui.loadFindingsCount(findings.ScanTypeStatic, func(count int64) {
-		ui.staticCount = count
-	})
-}
-
-// loadDynamicCount fetches only the dynamic findings count
-func (ui *UI) loadDynamicCount() {
-	ui.loadFindingsCount(findings.ScanTypeDynamic, func(count int64) {
-		ui.dynamicCount = count
-	})
-	ui.loadFindingsCount(findings.ScanTypeSCA, func(count int64) {
-		ui.scaCount = count
-	})
+// loadOtherScanCounts fetches the findings counts of every scan type but loaded, whose count came
+// with its findings
+func (ui *UI) loadOtherScanCounts(loaded findings.ScanType) {
+	counts := []struct {
+		scanType findings.ScanType
+		count    *int64
+	}{
+		{findings.ScanTypeStatic, &ui.staticCount},
+		{findings.ScanTypeDynamic, &ui.dynamicCount},
+		{findings.ScanTypeSCA, &ui.scaCount},
+	}
+	for _, c := range counts {
+		if c.scanType == loaded {
+			continue
+		}
+		ui.loadFindingsCount(c.scanType, func(count int64) {
+			*c.count = count
+		})
+	}
 }
 
 func (ui *UI) updateCountsLabel() {
-	ui.findingsCountsLabel.SetText(fmt.Sprintf("  [white]Static: [%s]%d[white]  |  Dynamic: [%s]%d[white]  |  SCA: [%s]%d", ui.theme.Label, ui.staticCount, ui.theme.Label, ui.dynamicCount, ui.theme.Label, ui.scaCount))
+	ui.findingsCountsLabel.SetText(ui.buildCountsText())
 }
 
-func (ui *UI) sortFindingsBySeverity() {
-	// Sort by severity in descending order (5 = highest, 0 = lowest)
-	for i := 0; i < len(ui.findings); i++ {
-		for j := i + 1; j < len(ui.findings); j++ {
-			sevI := ui.getFindingSeverity(&ui.findings[i])
-			sevJ := ui.getFindingSeverity(&ui.findings[j])
-			if sevI < sevJ {
-				ui.findings[i], ui.findings[j] = ui.findings[j], ui.findings[i]
-			}
-		}
+// buildCountsText builds the scan type tabs, with their counts, shown above the findings filters
+func (ui *UI) buildCountsText() string {
+	text := ui.buildFindingsScanTabs() + ui.findingsAnnotationsText()
+	if ui.findingsSearchQuery != "" || ui.findingsCWEFilter != 0 || ui.findingsStatusFilter != "" || ui.findingsMitigableOnly || ui.findingsNewOnly {
+		text += fmt.Sprintf("[white]  |  Matching filter: [%s]%d of %d", ui.theme.Label, len(ui.findings), len(ui.allFindings))
 	}
+	return text + ui.findingsPageText()
 }
 
-func (ui *UI) getFindingSeverity(finding *findings.Finding) int {
-	if finding.FindingDetails == nil {
-		return 0
-	}
-
-	details, ok := finding.FindingDetails.(map[string]interface{})
-	if !ok {
-		return 0
-	}
-
-	if severity, ok := details["severity"].(float64); ok {
-		return int(severity)
-	}
+// setFindingsStatus shows a status message alongside the findings counts
+func (ui *UI) setFindingsStatus(message string) {
+	ui.findingsCountsLabel.SetText(ui.buildCountsText() + "    " + message)
+}
 
-	return 0
+func (ui *UI) getFindingSeverity(finding *findings.Finding) int {
+	return findings.FindingSeverity(finding)
 }
@@ -0,0 +1,180 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dipsylala/veracode-tui/services/identity"
+	"github.com/dipsylala/veracode-tui/veracode"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// maxErrorBodyLength limits how much of an error response body is shown on the health check page
+const maxErrorBodyLength = 500
+
+// runStartupCheck verifies API connectivity and credentials before loading applications.
+// On failure a dedicated error page is shown instead of the applications list.
+func (ui *UI) runStartupCheck() {
+	if ui.identityService == nil {
+		ui.loadApplications()
+		ui.openInitialTarget()
+		return
+	}
+
+	ui.queueUpdateDraw(func() {
+		ui.statusBar.SetText(fmt.Sprintf("[%s]Checking API connectivity...[-]", ui.theme.Pending))
+	})
+
+	healthErr := ui.identityService.HealthCheck()
+	principal, identityUnavailable := ui.identityService.TryGetPrincipal(ui.ctx)
+	if ui.ctx.Err() != nil {
+		return
+	}
+
+	if healthErr != nil {
+		ui.queueUpdateDraw(func() {
+			ui.showHealthCheckError(healthErr, principal, identityUnavailable)
+		})
+		return
+	}
+
+	// The identity lookup is not required to browse applications and findings, so a failure is
+	// only noted in the header
+	ui.queueUpdateDraw(func() {
+		ui.principal = principal
+		ui.identityUnavailable = identityUnavailable
+		ui.updateHeader()
+	})
+
+	go ui.checkCredentialsExpiry()
+	ui.loadApplications()
+	ui.openInitialTarget()
+}
+
+// updateHeader shows the connected user and organization next to the application title, or why
+// they are unavailable, and any credentials expiry warning beneath it
+func (ui *UI) updateHeader() {
+	text := "[" + ui.theme.ColumnHeader + "::b]🛡️  Veracode TUI[::-]"
+	if ui.principal != nil {
+		text += fmt.Sprintf("  [%s]Connected as %s[-]",
+			ui.theme.SecondaryText, tview.Escape(principalDisplayName(ui.principal)))
+	} else if ui.identityUnavailable != "" {
+		text += fmt.Sprintf("  [%s]%s[-]", ui.theme.Warning, tview.Escape(ui.identityUnavailable))
+	}
+	ui.headerView.SetText(text + "\n" + ui.credentialsWarning + "\n")
+}
+
+// showHealthCheckError displays the startup connectivity failure page
+func (ui *UI) showHealthCheckError(healthErr error, principal *identity.Principal, identityUnavailable string) {
+	view := tview.NewTextView().
+		SetDynamicColors(true).
+		SetWordWrap(true).
+		SetText(ui.buildHealthCheckErrorText(healthErr, principal, identityUnavailable))
+	view.SetBorder(true).
+		SetTitle(" Connection Failed ").
+		SetTitleAlign(tview.AlignLeft).
+		SetBorderColor(tcell.GetColor(ui.theme.Error)).
+		SetBorderPadding(1, 1, 2, 2)
+
+	shortcutsBar := tview.NewTextView().
+		SetDynamicColors(true).
+		SetTextAlign(tview.AlignCenter).
+		SetText(fmt.Sprintf("[%s]r[-] Retry  [%s]q[-] Quit", ui.theme.Info, ui.theme.Info))
+
+	flex := tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(view, 0, 1, true).
+		AddItem(shortcutsBar, 1, 0, false)
+
+	flex.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyCtrlC {
+			ui.stop()
+			return nil
+		}
+		switch event.Rune() {
+		case 'r', 'R':
+			ui.pages.RemovePage("healthcheck")
+			ui.pages.SwitchToPage("applications")
+			ui.app.SetFocus(ui.applicationsTable)
+			go ui.runStartupCheck()
+			return nil
+		case 'q', 'Q':
+			ui.stop()
+			return nil
+		}
+		return event
+	})
+
+	ui.pages.AddAndSwitchToPage("healthcheck", flex, true)
+	ui.app.SetFocus(view)
+}
+
+// buildHealthCheckErrorText describes the failure, including the HTTP status when available.
+// identityUnavailable explains a failed principal lookup.
+func (ui *UI) buildHealthCheckErrorText(healthErr error, principal *identity.Principal, identityUnavailable string) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("[%s::b]Unable to connect to the Veracode API[::-]\n\n", ui.theme.Error))
+	sb.WriteString("The startup health check failed, so authentication or network connectivity is not working.\n\n")
+
+	var httpErr *veracode.HTTPError
+	if errors.As(healthErr, &httpErr) {
+		sb.WriteString(fmt.Sprintf("[%s]HTTP Status:[-] %s\n", ui.theme.Label, tview.Escape(httpErr.Status)))
+		if body := strings.TrimSpace(string(httpErr.Body)); body != "" {
+			body = truncateRunes(body, maxErrorBodyLength)
+			sb.WriteString(fmt.Sprintf("[%s]Response:[-] %s\n", ui.theme.Label, tview.Escape(body)))
+		}
+		sb.WriteString("\n")
+		if veracode.IsClockSkew(healthErr) {
+			sb.WriteString("The request timestamp was rejected. Check that your system clock is correct, or set --clock-offset.")
+		} else {
+			sb.WriteString(healthCheckHint(httpErr.StatusCode))
+		}
+	} else {
+		sb.WriteString(fmt.Sprintf("[%s]Error:[-] %s\n\n", ui.theme.Label, tview.Escape(healthErr.Error())))
+		sb.WriteString("Check your network connection, proxy settings and the configured region.")
+	}
+	sb.WriteString("\n\n")
+
+	sb.WriteString(fmt.Sprintf("[%s::b]Identity[::-]\n", ui.theme.ColumnHeader))
+	if principal == nil {
+		message := identityUnavailable
+		if message == "" {
+			message = "Could not retrieve the API principal."
+		}
+		sb.WriteString(fmt.Sprintf("[%s]%s[-]\n", ui.theme.DimmedText, tview.Escape(message)))
+	} else {
+		sb.WriteString(fmt.Sprintf("[%s]User:[-] %s\n", ui.theme.Label, tview.Escape(principal.Username)))
+		sb.WriteString(fmt.Sprintf("[%s]Organization:[-] %s\n", ui.theme.Label, tview.Escape(principal.OrganizationName)))
+	}
+
+	return sb.String()
+}
+
+// healthCheckHint suggests a likely cause for an HTTP status returned by the health check
+func healthCheckHint(statusCode int) string {
+	switch {
+	case statusCode == 401:
+		return "The API credentials were rejected. Verify your API key ID and secret."
+	case statusCode == 403:
+		return "The API credentials do not have access. Check the account's API roles."
+	case statusCode >= 500:
+		return "The Veracode API is currently unavailable. Try again shortly."
+	default:
+		return "The Veracode API returned an unexpected response."
+	}
+}
+
+// principalDisplayName formats the principal as "username (organization)"
+func principalDisplayName(principal *identity.Principal) string {
+	name := principal.Username
+	if name == "" {
+		name = principal.Email
+	}
+	if principal.OrganizationName != "" {
+		return fmt.Sprintf("%s (%s)", name, principal.OrganizationName)
+	}
+	return name
+}
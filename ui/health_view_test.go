@@ -0,0 +1,34 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dipsylala/veracode-tui/services/identity"
+	"github.com/dipsylala/veracode-tui/veracode"
+	"github.com/rivo/tview"
+)
+
+func TestUpdateHeader_IdentityUnavailable(t *testing.T) {
+	ui := NewUI(nil, nil, nil, nil, nil)
+	ui.headerView = tview.NewTextView()
+	ui.identityUnavailable = identity.UnavailableMessage(&veracode.HTTPError{StatusCode: 403})
+
+	ui.updateHeader()
+
+	if text := ui.headerView.GetText(true); !strings.Contains(text, "lack the identity role") {
+		t.Errorf("Expected the header to explain the missing identity role, got %q", text)
+	}
+}
+
+func TestBuildHealthCheckErrorText_IdentityUnavailable(t *testing.T) {
+	ui := NewUI(nil, nil, nil, nil, nil)
+	unavailable := identity.UnavailableMessage(errors.New("connection refused"))
+
+	text := ui.buildHealthCheckErrorText(errors.New("connection refused"), nil, unavailable)
+
+	if !strings.Contains(text, "could not reach the identity service") {
+		t.Errorf("Expected the identity section to explain the connectivity failure, got %q", text)
+	}
+}
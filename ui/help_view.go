@@ -0,0 +1,224 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const helpPageName = "help"
+
+// keyBinding describes a single keyboard shortcut shown in the help overlay
+type keyBinding struct {
+	keys        string
+	description string
+}
+
+// helpSection groups the key bindings for one view
+type helpSection struct {
+	title    string
+	bindings []keyBinding
+}
+
+// helpSections is the single source of truth for the help overlay, keyed by page name.
+// Add new shortcuts here when adding them to a view.
+var helpSections = map[string]helpSection{
+	"applications": {
+		title: "Applications List",
+		bindings: []keyBinding{
+			{"↑/↓, j/k", "Navigate applications"},
+			{"Enter, Double-click", "View application details"},
+			{"a", "Focus applications table"},
+			{"n", "Focus name search"},
+			{"s", "Focus scan status filter"},
+			{"p", "Focus policy compliance filter"},
+			{"t", "Focus scan type filter (each choice toggles a type; All clears)"},
+			{"m", "Focus modified-after filter"},
+			{"M", "Focus modified-before filter"},
+			{"g", "Focus tag filter"},
+			{"y", "Copy application GUID to clipboard"},
+			{"o", "Open application profile in browser"},
+			{"e", "Export loaded applications to CSV"},
+			{"E", "Export all applications matching the filters to CSV"},
+			{"D", "Show the dashboard of organization-wide totals"},
+			{"c, Click header", "Cycle sort column"},
+			{"d", "Toggle sort direction"},
+			{"b", "Browse teams and filter applications by team"},
+			{"r", "Switch to a recently viewed application"},
+			{"v", "Show/hide business unit and criticality columns"},
+			{"Tab, Shift+Tab", "Move between fields"},
+			{"PgDn/PgUp", "Next/previous page"},
+			{"+, -", "Increase/decrease page size"},
+			{"F5, Ctrl-R", "Refresh, keeping filters and page"},
+			{"q, ESC", "Quit"},
+		},
+	},
+	"teams": {
+		title: "Teams",
+		bindings: []keyBinding{
+			{"↑/↓", "Navigate teams"},
+			{"Enter", "Filter applications by the selected team"},
+			{"ESC", "Back to applications"},
+		},
+	},
+	"detail": {
+		title: "Application Detail",
+		bindings: []keyBinding{
+			{"↑/↓", "Navigate scan contexts"},
+			{"Enter, Double-click", "View findings for the policy scan or sandbox"},
+			{"S", "Browse all sandboxes, a page at a time"},
+			{"w", "Watch scan status until the scans finish (w again stops)"},
+			{"F5, Ctrl-R", "Refresh the application, sandboxes and finding counts"},
+			{"ESC", "Back to applications"},
+			{"q", "Quit"},
+		},
+	},
+	"sandboxes": {
+		title: "Sandboxes",
+		bindings: []keyBinding{
+			{"↑/↓", "Navigate sandboxes"},
+			{"Enter", "View findings for the selected sandbox"},
+			{"PgDn/PgUp", "Next/previous page"},
+			{"ESC", "Back to application details"},
+		},
+	},
+	"findings": {
+		title: "Findings",
+		bindings: []keyBinding{
+			{"Enter, Double-click", "View finding details, expand SCA component"},
+			{"f", "Focus findings table"},
+			{"c", "Switch policy scan/sandbox context"},
+			{"[, ]", "Previous/next policy scan or sandbox context"},
+			{"1/2/3, ←/→, t", "Switch scan type tab: Static, Dynamic or SCA"},
+			{"s", "Focus severity filter"},
+			{"S", "Match the severity filter exactly or as that severity and above (toggle)"},
+			{"r", "Cycle sort: severity, status, issue ID, CWE, priority"},
+			{"R", "Reverse sort direction"},
+			{"p", "Focus policy filter"},
+			{"o", "Filter loaded findings by status (Open, Closed, Reopened, Mitigable)"},
+			{"w", "Filter loaded findings by CWE"},
+			{"n", "Show only findings new in the latest scan (toggle)"},
+			{"A", "Load annotations with the findings list (toggle; not for SCA)"},
+			{"/", "Search loaded findings (ESC clears)"},
+			{"Space", "Select finding for batch mitigation"},
+			{"m", "Mitigate selected findings (or the current one)"},
+			{"X", "Cancel pending mitigation proposals on selected findings"},
+			{"e", "Export all findings for the context and filters to JSON"},
+			{"D", "Compare loaded findings with another policy scan or sandbox"},
+			{"g", "Group static findings by source file"},
+			{"O", "Open the application's scan results in browser"},
+			{"F5, Ctrl-R", "Refresh findings, keeping filters"},
+			{"Tab, Shift+Tab", "Move between fields"},
+			{"ESC", "Back to application details"},
+			{"q", "Quit"},
+		},
+	},
+	"finding_detail": {
+		title: "Finding Detail",
+		bindings: []keyBinding{
+			{"m", "Open mitigation modal (static and dynamic findings)"},
+			{"X", "Cancel the finding's pending mitigation proposal"},
+			{"c", "Copy finding summary to clipboard"},
+			{"M", "Copy finding as Markdown for a ticket"},
+			{"x", "Show full or truncated mitigation comments"},
+			{"J", "Show the raw JSON of the finding (ESC returns)"},
+			{"o", "Open the application's scan results in browser"},
+			{"←/→", "Previous/next data path"},
+			{"p", "Copy the data path shown to the clipboard (static findings)"},
+			{"Tab, Shift+Tab", "Move between panels"},
+			{"Ctrl+S", "Submit annotation (in mitigation modal)"},
+			{"Ctrl+D", "Save the comment as a draft, restored when the modal reopens (in mitigation modal)"},
+			{"ESC", "Back to findings"},
+			{"q", "Quit"},
+		},
+	},
+}
+
+// helpSectionOrder controls the order views are listed in the help overlay
+var helpSectionOrder = []string{"applications", "teams", "detail", "sandboxes", "findings", "finding_detail"}
+
+// handleGlobalInput handles keys that apply on every page
+func (ui *UI) handleGlobalInput(event *tcell.EventKey) *tcell.EventKey {
+	frontPage, _ := ui.pages.GetFrontPage()
+
+	if frontPage == helpPageName {
+		if event.Key() == tcell.KeyEscape || (event.Key() == tcell.KeyRune && event.Rune() == '?') {
+			ui.hideHelp()
+			return nil
+		}
+		return event
+	}
+
+	if event.Key() == tcell.KeyRune && event.Rune() == '?' && !ui.isTextEntryFocused() {
+		ui.showHelp(frontPage)
+		return nil
+	}
+
+	return event
+}
+
+// isTextEntryFocused reports whether the focused primitive accepts typed text
+func (ui *UI) isTextEntryFocused() bool {
+	switch ui.app.GetFocus().(type) {
+	case *tview.InputField, *tview.TextArea:
+		return true
+	}
+	return false
+}
+
+// showHelp pushes the help overlay on top of the current page
+func (ui *UI) showHelp(currentPage string) {
+	ui.helpPreviousFocus = ui.app.GetFocus()
+
+	helpView := tview.NewTextView().
+		SetDynamicColors(true).
+		SetScrollable(true).
+		SetText(ui.buildHelpText(currentPage))
+	helpView.SetBorder(true).
+		SetTitle(" Keyboard Shortcuts (? or ESC to close) ").
+		SetTitleAlign(tview.AlignLeft).
+		SetBorderColor(tcell.GetColor(ui.theme.BorderFocused)).
+		SetBorderPadding(0, 0, 1, 1)
+
+	ui.pages.AddPage(helpPageName, modal(helpView, 3, 4), true, true)
+	ui.app.SetFocus(helpView)
+}
+
+// hideHelp removes the help overlay and restores focus to where it was
+func (ui *UI) hideHelp() {
+	ui.pages.RemovePage(helpPageName)
+	if ui.helpPreviousFocus != nil {
+		ui.app.SetFocus(ui.helpPreviousFocus)
+		ui.helpPreviousFocus = nil
+	}
+}
+
+// buildHelpText renders the help sections, listing the current view first
+func (ui *UI) buildHelpText(currentPage string) string {
+	order := make([]string, 0, len(helpSectionOrder))
+	if _, ok := helpSections[currentPage]; ok {
+		order = append(order, currentPage)
+	}
+	for _, page := range helpSectionOrder {
+		if page != currentPage {
+			order = append(order, page)
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("[%s]?[-] Show or hide this help from any view\n", ui.theme.Info))
+	for _, page := range order {
+		section := helpSections[page]
+		title := section.title
+		if page == currentPage {
+			title += " (current view)"
+		}
+		sb.WriteString(fmt.Sprintf("\n[%s::b]%s[::-]\n", ui.theme.ColumnHeader, title))
+		for _, binding := range section.bindings {
+			sb.WriteString(fmt.Sprintf("  [%s]%-20s[-] %s\n", ui.theme.Info, binding.keys, binding.description))
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,16 @@
+package ui
+
+import "github.com/dipsylala/veracode-tui/veracode"
+
+// SetLogger sets where the UI logs events that are not reported by the API client, such as the
+// clipboard being unavailable. Passing nil stops logging.
+func (ui *UI) SetLogger(logger veracode.LevelLogger) {
+	ui.logger = logger
+}
+
+// warnf logs at LogLevelWarn, if a logger is set
+func (ui *UI) warnf(format string, args ...interface{}) {
+	if ui.logger != nil {
+		ui.logger.Logf(veracode.LogLevelWarn, format, args...)
+	}
+}
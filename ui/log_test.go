@@ -0,0 +1,37 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/atotto/clipboard"
+	"github.com/dipsylala/veracode-tui/veracode"
+	"github.com/rivo/tview"
+)
+
+// recordingLogger keeps the lines logged through it
+type recordingLogger struct {
+	lines []string
+}
+
+func (l *recordingLogger) Logf(level veracode.LogLevel, format string, args ...interface{}) {
+	l.lines = append(l.lines, fmt.Sprintf("[%s] %s", level, fmt.Sprintf(format, args...)))
+}
+
+func TestCopyTextToClipboard_LogsWhenUnavailable(t *testing.T) {
+	if !clipboard.Unsupported {
+		t.Skip("A clipboard is available")
+	}
+	logger := &recordingLogger{}
+	ui := NewUI(nil, nil, nil, nil, nil)
+	ui.SetLogger(logger)
+	// Nothing draws the restored status bar, so updates are dropped rather than queued
+	defer ui.stop()
+
+	ui.copyTextToClipboard("summary", "Finding 42", tview.NewTextView())
+
+	if len(logger.lines) != 1 || !strings.Contains(logger.lines[0], "Clipboard unavailable") || !strings.Contains(logger.lines[0], "Finding 42") {
+		t.Errorf("Expected the unavailable clipboard to be logged, got %q", logger.lines)
+	}
+}
@@ -0,0 +1,39 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/rivo/tview"
+)
+
+// createMitigationTargetView shows which context the mitigation modals will annotate count
+// findings in, warning when it is the policy scan
+func (ui *UI) createMitigationTargetView(count int) *tview.TextView {
+	findingsText := "1 finding"
+	if count != 1 {
+		findingsText = fmt.Sprintf("%d findings", count)
+	}
+
+	text := fmt.Sprintf("[%s]⚠ Mitigating %s in the policy scan - mitigations here affect policy compliance[-]", ui.theme.Warning, findingsText)
+	if ui.currentContextGUID() != "" {
+		text = fmt.Sprintf("[%s]Mitigating %s in sandbox '%s'[-]", ui.theme.Info, findingsText, tview.Escape(ui.currentContextName()))
+	}
+
+	return tview.NewTextView().
+		SetDynamicColors(true).
+		SetTextAlign(tview.AlignCenter).
+		SetText(text)
+}
+
+// confirmPolicyMitigation reports whether an annotation may be submitted. In the policy scan the
+// first attempt to submit a mitigation action, anything but a comment, only warns, and submitting
+// again confirms it.
+func (ui *UI) confirmPolicyMitigation(action string, confirmed *bool, statusText *tview.TextView) bool {
+	if ui.currentContextGUID() != "" || action == "COMMENT" || *confirmed {
+		return true
+	}
+	*confirmed = true
+	statusText.SetText(fmt.Sprintf("[%s]%s applies to the policy scan - press Ctrl+S again to confirm[-]  [%s]ESC[-] Cancel",
+		ui.theme.Warning, tview.Escape(action), ui.theme.Info))
+	return false
+}
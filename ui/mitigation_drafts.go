@@ -0,0 +1,74 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/dipsylala/veracode-tui/config"
+	"github.com/dipsylala/veracode-tui/services/findings"
+	"github.com/rivo/tview"
+)
+
+// SetDraftStore sets where unsubmitted mitigation comments are saved. Without a store, drafts
+// cannot be saved.
+func (ui *UI) SetDraftStore(store *config.DraftStore) {
+	ui.draftStore = store
+}
+
+// restoreMitigationDraft pre-fills the mitigation modal with the draft saved for finding, if any,
+// returning a note for the status line or "" when nothing was restored
+func (ui *UI) restoreMitigationDraft(finding *findings.Finding, actionOptions []string, actionDropdown *tview.DropDown, commentTextArea *tview.TextArea) string {
+	if ui.draftStore == nil || ui.selectedApp == nil {
+		return ""
+	}
+	draft, ok, err := ui.draftStore.LoadDraft(ui.selectedApp.GUID, finding.IssueID)
+	if err != nil {
+		return fmt.Sprintf("[%s]Could not load draft: %s[-]", ui.theme.Warning, tview.Escape(err.Error()))
+	}
+	if !ok {
+		return ""
+	}
+
+	commentTextArea.SetText(draft.Comment, true)
+	// The draft's action may no longer be offered, e.g. once a proposal has been accepted
+	if index := slices.Index(actionOptions, draft.Action); index >= 0 {
+		actionDropdown.SetCurrentOption(index)
+	}
+	return fmt.Sprintf("[%s]Draft from %s restored[-]", ui.theme.Info, draft.Saved.Local().Format("2006-01-02 15:04"))
+}
+
+// saveMitigationDraft saves the comment and action in the mitigation modal as the finding's draft
+func (ui *UI) saveMitigationDraft(finding *findings.Finding, actionDropdown *tview.DropDown, commentTextArea *tview.TextArea, statusText *tview.TextView) {
+	if ui.draftStore == nil || ui.selectedApp == nil {
+		statusText.SetText(fmt.Sprintf("[%s]Drafts are not available[-]  [%s]ESC/q[-] Close", ui.theme.Warning, ui.theme.Info))
+		return
+	}
+
+	comment := commentTextArea.GetText()
+	if strings.TrimSpace(comment) == "" {
+		statusText.SetText(fmt.Sprintf("[%s]Error: Nothing to save as a draft[-]  [%s]ESC/q[-] Close", ui.theme.Error, ui.theme.Info))
+		return
+	}
+
+	_, action := actionDropdown.GetCurrentOption()
+	draft := config.Draft{Action: action, Comment: comment, Saved: time.Now()}
+	if err := ui.draftStore.SaveDraft(ui.selectedApp.GUID, finding.IssueID, draft); err != nil {
+		statusText.SetText(fmt.Sprintf("[%s]Error: %s[-]  [%s]ESC/q[-] Close", ui.theme.Error, tview.Escape(err.Error()), ui.theme.Info))
+		return
+	}
+	statusText.SetText(fmt.Sprintf("[%s]%s Draft saved[-]  [%s]Ctrl+S[-] Submit  [%s]ESC/q[-] Close", ui.theme.Success, EmojiCheckMark, ui.theme.Info, ui.theme.Info))
+}
+
+// deleteMitigationDraft removes the draft saved for a finding once its annotation is submitted,
+// returning a warning for the status line or "" on success
+func (ui *UI) deleteMitigationDraft(appGUID string, issueID int64) string {
+	if ui.draftStore == nil {
+		return ""
+	}
+	if err := ui.draftStore.DeleteDraft(appGUID, issueID); err != nil {
+		return fmt.Sprintf("  [%s]Could not delete draft: %s[-]", ui.theme.Warning, tview.Escape(err.Error()))
+	}
+	return ""
+}
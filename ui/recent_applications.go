@@ -0,0 +1,98 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/dipsylala/veracode-tui/services/applications"
+	"github.com/dipsylala/veracode-tui/veracode"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const (
+	// maxRecentApplications is how many recently opened applications the quick switcher lists
+	maxRecentApplications = 10
+
+	// recentApplicationsPageName is the page of the recently viewed applications quick switcher
+	recentApplicationsPageName = "recent-applications"
+)
+
+// recentApplication is an application opened during the session
+type recentApplication struct {
+	GUID string
+	Name string
+}
+
+// pushRecent records app as the most recently opened application, moving it to the top if it was
+// already listed and dropping the oldest entry beyond maxRecentApplications
+func (ui *UI) pushRecent(app *applications.Application) {
+	if app == nil || app.GUID == "" {
+		return
+	}
+
+	recent := make([]recentApplication, 0, maxRecentApplications)
+	recent = append(recent, recentApplication{GUID: app.GUID, Name: applicationDisplayName(app)})
+	for _, entry := range ui.recentApplications {
+		if len(recent) == maxRecentApplications {
+			break
+		}
+		if entry.GUID != app.GUID {
+			recent = append(recent, entry)
+		}
+	}
+	ui.recentApplications = recent
+}
+
+// showRecentApplications opens the quick switcher listing the recently opened applications,
+// newest first
+func (ui *UI) showRecentApplications() {
+	if len(ui.recentApplications) == 0 {
+		ui.showTransientStatus(ui.statusBar, fmt.Sprintf("[%s]No applications opened yet[-]", ui.theme.Warning))
+		return
+	}
+
+	list := tview.NewList().ShowSecondaryText(false)
+	list.SetBorder(true).
+		SetTitle(" Recently Viewed ").
+		SetTitleAlign(tview.AlignLeft).
+		SetBorderColor(tcell.GetColor(ui.theme.BorderFocused))
+	list.SetSelectedStyle(tcell.StyleDefault.
+		Background(tcell.GetColor(ui.theme.SelectionBackground)).
+		Foreground(tcell.GetColor(ui.theme.SelectionForeground)))
+
+	for _, entry := range ui.recentApplications {
+		entry := entry
+		list.AddItem(tview.Escape(entry.Name), "", 0, func() {
+			ui.closeRecentApplications()
+			ui.openRecentApplication(entry)
+		})
+	}
+	list.SetDoneFunc(ui.closeRecentApplications)
+
+	ui.pages.AddPage(recentApplicationsPageName, modal(list, 1, 1), true, true)
+	ui.app.SetFocus(list)
+}
+
+// closeRecentApplications closes the quick switcher
+func (ui *UI) closeRecentApplications() {
+	ui.pages.RemovePage(recentApplicationsPageName)
+	ui.app.SetFocus(ui.applicationsTable)
+}
+
+// openRecentApplication fetches a recently opened application and shows its details
+func (ui *UI) openRecentApplication(entry recentApplication) {
+	original := ui.statusBar.GetText(false)
+	ui.statusBar.SetText(fmt.Sprintf("[%s]Opening %s...[-]", ui.theme.Pending, tview.Escape(entry.Name)))
+	go func() {
+		app, err := ui.appService.GetApplicationCached(entry.GUID)
+		ui.queueUpdateDraw(func() {
+			if err != nil {
+				ui.statusBar.SetText(fmt.Sprintf("[%s]Cannot open %s: %s[-]", ui.theme.Error, tview.Escape(entry.Name), veracode.UserMessage(err, "Application")))
+				return
+			}
+			ui.statusBar.SetText(original)
+			ui.selectedApp = app
+			ui.showApplicationDetail()
+		})
+	}()
+}
@@ -0,0 +1,63 @@
+package ui
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dipsylala/veracode-tui/services/applications"
+)
+
+func recentTestApp(guid string) *applications.Application {
+	return &applications.Application{GUID: guid, Profile: &applications.ApplicationProfile{Name: "App " + guid}}
+}
+
+func recentGUIDs(recent []recentApplication) string {
+	guids := make([]string, len(recent))
+	for i, entry := range recent {
+		guids[i] = entry.GUID
+	}
+	return fmt.Sprint(guids)
+}
+
+func TestPushRecent_NewestFirstAndDeduplicated(t *testing.T) {
+	ui := &UI{}
+	for _, guid := range []string{"a", "b", "c", "a"} {
+		ui.pushRecent(recentTestApp(guid))
+	}
+
+	if got := recentGUIDs(ui.recentApplications); got != "[a c b]" {
+		t.Errorf("Expected reopened application moved to the top, got %s", got)
+	}
+	if ui.recentApplications[0].Name != "App a" {
+		t.Errorf("Expected the application name to be recorded, got %q", ui.recentApplications[0].Name)
+	}
+}
+
+func TestPushRecent_CapsList(t *testing.T) {
+	ui := &UI{}
+	for i := 0; i < maxRecentApplications+3; i++ {
+		ui.pushRecent(recentTestApp(fmt.Sprint(i)))
+	}
+
+	if len(ui.recentApplications) != maxRecentApplications {
+		t.Fatalf("Expected %d entries, got %d", maxRecentApplications, len(ui.recentApplications))
+	}
+	if first, last := ui.recentApplications[0].GUID, ui.recentApplications[maxRecentApplications-1].GUID; first != "12" || last != "3" {
+		t.Errorf("Expected the oldest entries dropped, got %s", recentGUIDs(ui.recentApplications))
+	}
+}
+
+func TestPushRecent_IgnoresApplicationsWithoutGUID(t *testing.T) {
+	ui := &UI{}
+	ui.pushRecent(nil)
+	ui.pushRecent(&applications.Application{})
+
+	if len(ui.recentApplications) != 0 {
+		t.Errorf("Expected no entries, got %s", recentGUIDs(ui.recentApplications))
+	}
+
+	ui.pushRecent(&applications.Application{GUID: "no-profile"})
+	if ui.recentApplications[0].Name != DefaultApplicationName {
+		t.Errorf("Expected the default name for an application without a profile, got %q", ui.recentApplications[0].Name)
+	}
+}
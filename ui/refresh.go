@@ -0,0 +1,93 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/dipsylala/veracode-tui/services/findings"
+	"github.com/gdamore/tcell/v2"
+)
+
+// SetCacheClearer sets the function used to discard cached API responses before a refresh,
+// so that refreshed views show current data rather than cached pages
+func (ui *UI) SetCacheClearer(clear func()) {
+	ui.clearCache = clear
+}
+
+// isRefreshKey reports whether event is one of the refresh bindings (F5 or Ctrl-R)
+func isRefreshKey(event *tcell.EventKey) bool {
+	return event.Key() == tcell.KeyF5 || event.Key() == tcell.KeyCtrlR
+}
+
+// clearCachedResponses discards cached API responses ahead of a refresh
+func (ui *UI) clearCachedResponses() {
+	if ui.clearCache != nil {
+		ui.clearCache()
+	}
+	ui.appService.ClearApplicationCache()
+}
+
+// refreshApplications re-fetches the current applications page with the active filters,
+// keeping the selected application selected if it is still on the page
+func (ui *UI) refreshApplications() {
+	var selectedGUID string
+	row, _ := ui.applicationsTable.GetSelection()
+	if row > 0 && row-1 < len(ui.applications) {
+		selectedGUID = ui.applications[row-1].GUID
+	}
+
+	ui.statusBar.SetText(fmt.Sprintf("[%s]Refreshing...[-]", ui.theme.Pending))
+	ui.clearCachedResponses()
+
+	go func() {
+		ui.loadApplications()
+		ui.queueUpdateDraw(func() {
+			for i := range ui.applications {
+				if ui.applications[i].GUID == selectedGUID {
+					ui.applicationsTable.Select(i+1, 0)
+					return
+				}
+			}
+		})
+	}()
+}
+
+// refreshApplicationDetail re-fetches the selected application, its sandboxes and the finding counts
+// of its scan contexts
+func (ui *UI) refreshApplicationDetail() {
+	if ui.selectedApp == nil {
+		return
+	}
+	ui.clearCachedResponses()
+	ui.clearContextFindingCounts(ui.selectedApp.GUID)
+	ui.showApplicationDetail()
+}
+
+// refreshFindings re-fetches the findings for the current context and filters, keeping the
+// selected finding selected if it is still loaded. SCA rows are grouped by component, so
+// the selection is only restored for static and dynamic findings.
+func (ui *UI) refreshFindings() {
+	var selectedIssueID int64
+	row, _ := ui.findingsTable.GetSelection()
+	if ui.findingsScanFilter != findings.ScanFilterSCA && row > 0 && row-1 < len(ui.findings) {
+		selectedIssueID = ui.findings[row-1].IssueID
+	}
+
+	ui.setFindingsStatus(fmt.Sprintf("[%s]Refreshing...[-]", ui.theme.Pending))
+	ui.clearCachedResponses()
+
+	scanFilter := ui.findingsScanFilter
+	go func() {
+		ui.loadFindingsWithFilter(scanFilter)
+		ui.queueUpdateDraw(func() {
+			if selectedIssueID == 0 {
+				return
+			}
+			for i := range ui.findings {
+				if ui.findings[i].IssueID == selectedIssueID {
+					ui.findingsTable.Select(i+1, 0)
+					return
+				}
+			}
+		})
+	}()
+}
@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/rivo/tview"
+)
+
+// NotifyRetry shows in the status bar of the current page that a request is being retried. It is
+// called on the goroutine making the request. The notice is removed once no tracked request is in
+// flight, unless something else has replaced it by then.
+func (ui *UI) NotifyRetry(attempt, max int, err error) {
+	message := fmt.Sprintf("[%s]Retrying (attempt %d/%d)…[-]", ui.theme.Pending, attempt, max)
+	ui.queueUpdateDraw(func() {
+		statusBar := ui.activeStatusBar()
+		if statusBar == nil {
+			return
+		}
+		if statusBar != ui.retryStatusBar {
+			ui.clearRetryNotice()
+			ui.retryStatusBar = statusBar
+			ui.retryStatusOriginal = statusBar.GetText(false)
+		}
+		ui.retryStatusMessage = message
+		statusBar.SetText(message)
+	})
+}
+
+// clearRetryNotice restores the status bar showing a retry notice, if the notice is still shown.
+// Must be called on the event loop.
+func (ui *UI) clearRetryNotice() {
+	if ui.retryStatusBar == nil {
+		return
+	}
+	if ui.retryStatusBar.GetText(false) == ui.retryStatusMessage {
+		ui.retryStatusBar.SetText(ui.retryStatusOriginal)
+	}
+	ui.retryStatusBar = nil
+}
+
+// activeStatusBar returns the status bar of the page in front, or nil if it has none
+func (ui *UI) activeStatusBar() *tview.TextView {
+	name, _ := ui.pages.GetFrontPage()
+	switch name {
+	case "applications":
+		return ui.statusBar
+	case "detail":
+		return ui.detailStatusBar
+	case "findings":
+		return ui.findingsCountsLabel
+	}
+	return nil
+}
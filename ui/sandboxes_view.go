@@ -0,0 +1,143 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const (
+	sandboxesPageName = "sandboxes"
+
+	// sandboxesViewPageSize is the number of sandboxes shown per page of the sandbox list
+	sandboxesViewPageSize = 25
+)
+
+// showSandboxes lists every sandbox of the selected application a page at a time.
+// Selecting a sandbox opens its findings.
+func (ui *UI) showSandboxes() {
+	if ui.selectedApp == nil {
+		return
+	}
+
+	table := tview.NewTable().
+		SetBorders(false).
+		SetSelectable(true, false).
+		SetFixed(1, 0)
+	table.SetBorder(true).
+		SetTitleAlign(tview.AlignLeft).
+		SetBorderColor(tcell.GetColor(ui.theme.BorderFocused)).
+		SetBorderPadding(0, 0, 1, 1)
+	table.SetSelectedStyle(tcell.StyleDefault.
+		Background(tcell.GetColor(ui.theme.SelectionBackground)).
+		Foreground(tcell.GetColor(ui.theme.SelectionForeground)))
+
+	shortcutsBar := tview.NewTextView().
+		SetDynamicColors(true).
+		SetTextAlign(tview.AlignCenter).
+		SetText(fmt.Sprintf("[%s]Enter[-] View Findings  [%s]PgDn/PgUp[-] Next/Prev Page  [%s]ESC[-] Back",
+			ui.theme.Info, ui.theme.Info, ui.theme.Info))
+
+	flex := tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(table, 0, 1, true).
+		AddItem(shortcutsBar, 1, 0, false)
+
+	page := 0
+	table.SetSelectedFunc(func(row, column int) {
+		index := page*sandboxesViewPageSize + row - 1
+		if row < 1 || index >= len(ui.sandboxes) {
+			return
+		}
+		ui.pages.RemovePage(sandboxesPageName)
+		ui.selectionIndex = index
+		ui.showFindings()
+	})
+	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEscape:
+			ui.closeSandboxes()
+			return nil
+		case tcell.KeyPgDn:
+			if (page+1)*sandboxesViewPageSize < len(ui.sandboxes) {
+				page++
+				ui.renderSandboxesTable(table, page)
+			}
+			return nil
+		case tcell.KeyPgUp:
+			if page > 0 {
+				page--
+				ui.renderSandboxesTable(table, page)
+			}
+			return nil
+		}
+		return event
+	})
+
+	ui.renderSandboxesTable(table, page)
+
+	ui.pages.AddAndSwitchToPage(sandboxesPageName, flex, true)
+	ui.app.SetFocus(table)
+}
+
+// renderSandboxesTable shows one page of the application's sandboxes
+func (ui *UI) renderSandboxesTable(table *tview.Table, page int) {
+	table.Clear()
+
+	for col, header := range []string{"Name", "GUID", "Owner", "Created", "Modified"} {
+		table.SetCell(0, col, tview.NewTableCell(header).
+			SetTextColor(tcell.GetColor(ui.theme.ColumnHeader)).
+			SetAttributes(tcell.AttrBold).
+			SetSelectable(false))
+	}
+
+	if ui.sandboxes == nil {
+		table.SetTitle(" Sandboxes ")
+		table.SetCell(1, 0, tview.NewTableCell("Loading sandboxes...").
+			SetTextColor(tcell.GetColor(ui.theme.Pending)).
+			SetSelectable(false))
+		return
+	}
+	if len(ui.sandboxes) == 0 {
+		table.SetTitle(" Sandboxes ")
+		table.SetCell(1, 0, ui.emptyStateCell(emptySandboxesMessage))
+		return
+	}
+
+	totalPages := (len(ui.sandboxes) + sandboxesViewPageSize - 1) / sandboxesViewPageSize
+	table.SetTitle(fmt.Sprintf(" Sandboxes (%d) • Page %d/%d ", len(ui.sandboxes), page+1, totalPages))
+
+	start := page * sandboxesViewPageSize
+	end := min(start+sandboxesViewPageSize, len(ui.sandboxes))
+	for i, sandbox := range ui.sandboxes[start:end] {
+		row := i + 1
+		owner := sandbox.OwnerUsername
+		if owner == "" {
+			owner = TextNotAvailable
+		}
+		table.SetCell(row, 0, tview.NewTableCell(sandbox.Name).SetExpansion(1))
+		table.SetCell(row, 1, tview.NewTableCell(sandbox.GUID).SetTextColor(tcell.GetColor(ui.theme.SecondaryText)))
+		table.SetCell(row, 2, tview.NewTableCell(owner).SetExpansion(1))
+		table.SetCell(row, 3, dateCell(sandbox.Created))
+		table.SetCell(row, 4, dateCell(sandbox.Modified))
+	}
+
+	table.Select(1, 0)
+}
+
+// setSandboxesProgress shows text in the detail status bar while the sandboxes of appGUID load.
+// A running scan watch keeps the status bar, as does a detail view for another application.
+func (ui *UI) setSandboxesProgress(appGUID, text string) {
+	if ui.watchCancel != nil || ui.selectedApp == nil || ui.selectedApp.GUID != appGUID {
+		return
+	}
+	ui.detailStatusBar.SetText(text)
+}
+
+// closeSandboxes removes the sandbox list and returns to the application detail view
+func (ui *UI) closeSandboxes() {
+	ui.pages.RemovePage(sandboxesPageName)
+	ui.pages.SwitchToPage("detail")
+	ui.app.SetFocus(ui.contextsTable)
+}
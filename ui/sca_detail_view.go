@@ -25,10 +25,7 @@ func (ui *UI) showSCAFindingDetail() {
 	}
 
 	// Get application name
-	appName := DefaultApplicationName
-	if ui.selectedApp != nil && ui.selectedApp.Profile != nil {
-		appName = ui.selectedApp.Profile.Name
-	}
+	appName := applicationDisplayName(ui.selectedApp)
 
 	// Create title view
 	titleView := tview.NewTextView().
@@ -144,8 +141,8 @@ func (ui *UI) showSCAFindingDetail() {
 	shortcutsBar := tview.NewTextView().
 		SetDynamicColors(true).
 		SetTextAlign(tview.AlignCenter).
-		SetText(fmt.Sprintf("[%s]ESC[-] Back  [%s]q[-] Quit  [%s]Tab[-] Navigate",
-			ui.theme.Info, ui.theme.Info, ui.theme.Info))
+		SetText(fmt.Sprintf("[%s]ESC[-] Back  [%s]q[-] Quit  [%s]c/M[-] Copy Text/Markdown  [%s]J[-] Raw JSON  [%s]o[-] Open Results  [%s]Tab[-] Navigate",
+			ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info, ui.theme.Info))
 	shortcutsBar.SetBorder(false)
 
 	// Focusable views
@@ -175,7 +172,23 @@ func (ui *UI) showSCAFindingDetail() {
 			return nil
 		case tcell.KeyRune:
 			if event.Rune() == 'q' {
-				ui.app.Stop()
+				ui.stop()
+				return nil
+			}
+			if event.Rune() == 'c' {
+				ui.copyFindingToClipboard(finding, shortcutsBar)
+				return nil
+			}
+			if event.Rune() == 'M' {
+				ui.copyFindingAsMarkdown(finding, shortcutsBar)
+				return nil
+			}
+			if event.Rune() == 'J' {
+				ui.showFindingJSON(finding)
+				return nil
+			}
+			if event.Rune() == 'o' {
+				ui.openApplicationResults(shortcutsBar)
 				return nil
 			}
 		case tcell.KeyTab:
@@ -217,12 +230,16 @@ func (ui *UI) buildSCABasicInfoContent(finding *findings.Finding) string {
 			sb.WriteString(fmt.Sprintf("[%s]Version:[-] [white]%s[-]\n", ui.theme.Label, version))
 		}
 
-		// Severity with color
-		if sev, ok := details["severity"].(float64); ok {
-			sevInt := int(sev)
-			sevColor := ui.getSeverityColorHex(sevInt)
+		// Severity with color, banded from the CVSS score when the finding has no severity
+		if extractSeverity(finding) != "-" {
+			sevInt := ui.getFindingSeverity(finding)
+			sevColor := ui.severityColor(sevInt)
 			sb.WriteString(fmt.Sprintf("[%s]Severity:[-] [%s]%d[-]\n", ui.theme.Label, sevColor, sevInt))
 		}
+		if cvss, ok := findings.FindingCVSS(finding); ok {
+			cvssColor := ui.severityColor(findings.CVSSToSeverityBand(cvss))
+			sb.WriteString(fmt.Sprintf("[%s]CVSS:[-] [%s]%.1f[-]\n", ui.theme.Label, cvssColor, cvss))
+		}
 	}
 
 	// Status badge
@@ -231,12 +248,7 @@ func (ui *UI) buildSCABasicInfoContent(finding *findings.Finding) string {
 	sb.WriteString(fmt.Sprintf("[%s]Status:[-] [%s]%s[-]\n", ui.theme.Label, statusColor, status))
 
 	// Grace period expiration date
-	if finding.GracePeriodExpiresDate != nil {
-		sb.WriteString(fmt.Sprintf("[%s]Grace Period Expires:[-] [white]%s[-]\n\n", ui.theme.Label,
-			finding.GracePeriodExpiresDate.Format("2006-01-02")))
-	} else {
-		sb.WriteString(fmt.Sprintf("[%s]Grace Period Expires:[-] [white]%s[-]\n\n", ui.theme.Label, TextNotAvailable))
-	}
+	sb.WriteString(fmt.Sprintf("[%s]Grace Period Expires:[-] %s\n\n", ui.theme.Label, ui.gracePeriodDetail(finding)))
 
 	// Policy info
 	if finding.ViolatesPolicy {
@@ -0,0 +1,71 @@
+package ui
+
+import (
+	"slices"
+	"strings"
+)
+
+// scanTypeFilterAll is the scan type filter option that clears the selection
+const scanTypeFilterAll = "All"
+
+// applicationScanTypes are the scan_type values the applications list can be filtered by, in the
+// order they are listed
+var applicationScanTypes = []string{"STATIC", "DYNAMIC", "MANUAL"}
+
+// toggleScanType adds scanType to the selected scan types, or removes it if already selected,
+// keeping the result in applicationScanTypes order
+func toggleScanType(selected []string, scanType string) []string {
+	var toggled []string
+	for _, candidate := range applicationScanTypes {
+		if slices.Contains(selected, candidate) != (candidate == scanType) {
+			toggled = append(toggled, candidate)
+		}
+	}
+	return toggled
+}
+
+// scanTypeFilterLabels returns the dropdown options, marking the selected scan types
+func scanTypeFilterLabels(selected []string) []string {
+	labels := []string{scanTypeFilterAll}
+	for _, scanType := range applicationScanTypes {
+		mark := "  "
+		if slices.Contains(selected, scanType) {
+			mark = "✓ "
+		}
+		labels = append(labels, mark+scanType)
+	}
+	return labels
+}
+
+// scanTypeFilterSummary returns the text shown in the closed dropdown
+func scanTypeFilterSummary(selected []string) string {
+	if len(selected) == 0 {
+		return scanTypeFilterAll
+	}
+	return strings.Join(selected, ", ")
+}
+
+// selectScanTypeFilterOption handles a choice in the scan type dropdown. "All" clears the
+// selection and any other option toggles its scan type, so several can be combined.
+func (ui *UI) selectScanTypeFilterOption(_ string, index int) {
+	// Rebuilding the options reports "no selection" (index -1), which is not a choice
+	if index < 0 {
+		return
+	}
+	if index == 0 {
+		ui.scanTypeFilterValues = nil
+	} else {
+		ui.scanTypeFilterValues = toggleScanType(ui.scanTypeFilterValues, applicationScanTypes[index-1])
+	}
+	ui.updateScanTypeFilterOptions()
+	ui.triggerApplicationsSearch()
+}
+
+// updateScanTypeFilterOptions redraws the scan type dropdown's checkmarks and summary from the
+// selected scan types
+func (ui *UI) updateScanTypeFilterOptions() {
+	ui.scanTypeFilter.
+		SetOptions(scanTypeFilterLabels(ui.scanTypeFilterValues), ui.selectScanTypeFilterOption).
+		SetTextOptions("", "", "", "", scanTypeFilterSummary(ui.scanTypeFilterValues)).
+		SetCurrentOption(-1)
+}
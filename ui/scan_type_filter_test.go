@@ -0,0 +1,43 @@
+package ui
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestToggleScanType(t *testing.T) {
+	tests := []struct {
+		name     string
+		selected []string
+		scanType string
+		want     []string
+	}{
+		{"select first", nil, "DYNAMIC", []string{"DYNAMIC"}},
+		{"keeps option order", []string{"MANUAL"}, "STATIC", []string{"STATIC", "MANUAL"}},
+		{"deselect", []string{"STATIC", "DYNAMIC"}, "STATIC", []string{"DYNAMIC"}},
+		{"deselect last", []string{"MANUAL"}, "MANUAL", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := toggleScanType(tt.selected, tt.scanType); !slices.Equal(got, tt.want) {
+				t.Errorf("toggleScanType(%v, %q) = %v, want %v", tt.selected, tt.scanType, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScanTypeFilterLabelsAndSummary(t *testing.T) {
+	selected := []string{"STATIC", "MANUAL"}
+
+	want := []string{"All", "✓ STATIC", "  DYNAMIC", "✓ MANUAL"}
+	if got := scanTypeFilterLabels(selected); !slices.Equal(got, want) {
+		t.Errorf("scanTypeFilterLabels() = %q, want %q", got, want)
+	}
+	if got := scanTypeFilterSummary(selected); got != "STATIC, MANUAL" {
+		t.Errorf("scanTypeFilterSummary() = %q, want %q", got, "STATIC, MANUAL")
+	}
+	if got := scanTypeFilterSummary(nil); got != "All" {
+		t.Errorf("scanTypeFilterSummary(nil) = %q, want %q", got, "All")
+	}
+}
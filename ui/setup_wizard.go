@@ -0,0 +1,163 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dipsylala/veracode-tui/config"
+	"github.com/dipsylala/veracode-tui/veracode"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// setupRegions are the regions offered by the setup wizard, in dropdown order
+var setupRegions = []veracode.Region{veracode.RegionCommercial, veracode.RegionEuropean, veracode.RegionFederal}
+
+// setupWizard prompts for API credentials and writes them to a new config file
+type setupWizard struct {
+	app        *tview.Application
+	pages      *tview.Pages
+	form       *tview.Form
+	statusText *tview.TextView
+	theme      *Theme
+	configPath string
+	verifying  bool
+	saved      bool
+}
+
+// RunSetupWizard runs a standalone TUI for first-time users that asks for an API key ID, secret and
+// region, verifies them with a health check and writes them to a new config file at configPath (the
+// default location when empty). It reports whether credentials were saved; false means the user
+// chose to skip setup and exit.
+func RunSetupWizard(theme *Theme, configPath string) (bool, error) {
+	if configPath == "" {
+		defaultPath, err := config.DefaultConfigPath()
+		if err != nil {
+			return false, err
+		}
+		configPath = defaultPath
+	}
+
+	w := &setupWizard{
+		app:        tview.NewApplication(),
+		pages:      tview.NewPages(),
+		theme:      theme,
+		configPath: configPath,
+	}
+	w.pages.AddPage("setup", modal(w.createForm(), 3, 2), true, true)
+
+	if err := w.app.SetRoot(w.pages, true).EnableMouse(true).Run(); err != nil {
+		return false, err
+	}
+	return w.saved, nil
+}
+
+// createForm builds the credentials form with its introduction and status line
+func (w *setupWizard) createForm() tview.Primitive {
+	intro := tview.NewTextView().
+		SetDynamicColors(true).
+		SetWordWrap(true).
+		SetText(fmt.Sprintf("No Veracode credentials were found. Enter the API credentials generated on the Veracode platform "+
+			"to create [%s]%s[-].", w.theme.Info, tview.Escape(w.configPath)))
+
+	regionLabels := make([]string, len(setupRegions))
+	for i, region := range setupRegions {
+		regionLabels[i] = string(region)
+	}
+
+	w.form = tview.NewForm().
+		AddInputField("API key ID", "", 40, nil, nil).
+		AddPasswordField("API key secret", "", 40, '*', nil).
+		AddDropDown("Region", regionLabels, 0, nil).
+		AddButton("Save", w.submit).
+		AddButton("Cancel", w.confirmSkip)
+	w.form.SetLabelColor(tcell.GetColor(w.theme.Label)).
+		SetFieldTextColor(tcell.GetColor(w.theme.DropDownText)).
+		SetFieldBackgroundColor(tcell.GetColor(w.theme.DropDownBackground)).
+		SetButtonTextColor(tcell.GetColor(w.theme.SelectionForeground)).
+		SetButtonBackgroundColor(tcell.GetColor(w.theme.SelectionBackground))
+	w.form.SetCancelFunc(w.confirmSkip)
+
+	w.statusText = tview.NewTextView().
+		SetDynamicColors(true).
+		SetTextAlign(tview.AlignCenter).
+		SetText(fmt.Sprintf("[%s]Tab[-] Next Field  [%s]Enter[-] Select  [%s]ESC[-] Cancel", w.theme.Info, w.theme.Info, w.theme.Info))
+
+	content := tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(intro, 3, 0, false).
+		AddItem(w.form, 0, 1, true).
+		AddItem(w.statusText, 1, 0, false)
+	content.SetBorder(true).
+		SetTitle(" Veracode TUI Setup ").
+		SetTitleAlign(tview.AlignCenter).
+		SetBorderColor(tcell.GetColor(w.theme.BorderFocused)).
+		SetBorderPadding(1, 0, 2, 2)
+	return content
+}
+
+// submit validates the entered credentials locally, then verifies them against the API in the background
+func (w *setupWizard) submit() {
+	if w.verifying {
+		return
+	}
+
+	keyID := strings.TrimSpace(w.form.GetFormItemByLabel("API key ID").(*tview.InputField).GetText())
+	keySecret := strings.TrimSpace(w.form.GetFormItemByLabel("API key secret").(*tview.InputField).GetText())
+	index, _ := w.form.GetFormItemByLabel("Region").(*tview.DropDown).GetCurrentOption()
+	region := setupRegions[max(index, 0)]
+
+	if keyID == "" || keySecret == "" {
+		w.setStatus(w.theme.Error, "API key ID and secret are required")
+		return
+	}
+	if _, err := veracode.NormalizeSecret(keySecret); err != nil {
+		w.setStatus(w.theme.Error, fmt.Sprintf("Invalid API key secret: %v", err))
+		return
+	}
+
+	w.verifying = true
+	w.setStatus(w.theme.Pending, "Verifying credentials...")
+	go w.verifyAndSave(keyID, keySecret, region)
+}
+
+// verifyAndSave runs a health check with the credentials and, when it succeeds, writes the config file
+// and closes the wizard
+func (w *setupWizard) verifyAndSave(keyID, keySecret string, region veracode.Region) {
+	err := veracode.NewClientWithRegion(keyID, keySecret, region).HealthCheck()
+	if err == nil {
+		err = config.WriteCredentials(w.configPath, keyID, keySecret, string(region))
+	}
+
+	w.app.QueueUpdateDraw(func() {
+		w.verifying = false
+		if err != nil {
+			w.setStatus(w.theme.Error, veracode.UserMessage(err, "Health check"))
+			return
+		}
+		w.saved = true
+		w.app.Stop()
+	})
+}
+
+// confirmSkip asks whether to exit without saving credentials
+func (w *setupWizard) confirmSkip() {
+	confirm := tview.NewModal().
+		SetText("Exit without setting up Veracode credentials?").
+		AddButtons([]string{"Skip and exit", "Back"}).
+		SetDoneFunc(func(_ int, label string) {
+			if label == "Skip and exit" {
+				w.app.Stop()
+				return
+			}
+			w.pages.RemovePage("confirm")
+			w.app.SetFocus(w.form)
+		})
+	w.pages.AddPage("confirm", confirm, true, true)
+	w.app.SetFocus(confirm)
+}
+
+// setStatus replaces the status line with message in color
+func (w *setupWizard) setStatus(color, message string) {
+	w.statusText.SetText(fmt.Sprintf("[%s]%s[-]", color, tview.Escape(message)))
+}
@@ -0,0 +1,62 @@
+package ui
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/dipsylala/veracode-tui/services/applications"
+	"github.com/gdamore/tcell/v2"
+)
+
+// pageClient returns a single page holding one application
+type pageClient struct{}
+
+func (c *pageClient) DoRequestWithQueryParams(method, urlPath string, params url.Values) ([]byte, error) {
+	return []byte(`{"_embedded":{"applications":[{"guid":"app-1"}]},"page":{"total_pages":1,"total_elements":1}}`), nil
+}
+
+func TestLoadApplicationsAfterStop(t *testing.T) {
+	ui := NewUI(applications.NewService(&pageClient{}), nil, nil, nil, nil)
+
+	ui.stop()
+	ui.loadApplications()
+
+	if ui.Context().Err() == nil {
+		t.Error("Expected the context to be canceled on stop")
+	}
+	if ui.applications != nil {
+		t.Errorf("Expected a load finishing after stop to be discarded, got %d applications", len(ui.applications))
+	}
+	if ui.requestsInFlight != 0 {
+		t.Errorf("Expected no requests in flight, got %d", ui.requestsInFlight)
+	}
+}
+
+// runSimulated runs the event loop on a simulated screen until the test ends, so queued updates
+// are drawn
+func runSimulated(t *testing.T, ui *UI) {
+	t.Helper()
+	ui.app.SetScreen(tcell.NewSimulationScreen(""))
+	ui.app.SetRoot(ui.pages, true)
+	done := make(chan error, 1)
+	go func() { done <- ui.app.Run() }()
+	t.Cleanup(func() {
+		ui.stop()
+		<-done
+	})
+}
+
+func TestQueueUpdateDrawWhileRunning(t *testing.T) {
+	ui := NewUI(nil, nil, nil, nil, nil)
+	runSimulated(t, ui)
+
+	ran := make(chan struct{})
+	ui.queueUpdateDraw(func() { close(ran) })
+
+	select {
+	case <-ran:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected a queued update to run on the event loop")
+	}
+}
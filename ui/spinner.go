@@ -0,0 +1,76 @@
+package ui
+
+import (
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// spinnerInterval is how often the spinner advances while requests are in flight
+const spinnerInterval = 100 * time.Millisecond
+
+// spinnerFrames are drawn in turn beside the status bar
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// createSpinnerWidget creates the cell beside the status bar that the spinner is drawn in
+func (ui *UI) createSpinnerWidget() *tview.TextView {
+	ui.spinnerView = tview.NewTextView().
+		SetTextColor(tcell.GetColor(ui.theme.Pending))
+	ui.spinnerView.SetBorder(false)
+	return ui.spinnerView
+}
+
+// beginRequest records a request in flight, starting the spinner if no other request is outstanding.
+// Every call must be paired with endRequest.
+func (ui *UI) beginRequest() {
+	ui.spinnerMu.Lock()
+	defer ui.spinnerMu.Unlock()
+
+	ui.requestsInFlight++
+	if ui.requestsInFlight == 1 {
+		ui.spinnerStop = make(chan struct{})
+		go ui.runSpinner(ui.spinnerStop)
+	}
+}
+
+// endRequest records a finished request, stopping the spinner once none remain
+func (ui *UI) endRequest() {
+	ui.spinnerMu.Lock()
+	defer ui.spinnerMu.Unlock()
+
+	if ui.requestsInFlight == 0 {
+		return
+	}
+	ui.requestsInFlight--
+	if ui.requestsInFlight == 0 {
+		close(ui.spinnerStop)
+	}
+}
+
+// runSpinner animates the spinner until stop is closed or the application exits. Once stopped,
+// any retry notice is removed with the spinner.
+func (ui *UI) runSpinner(stop <-chan struct{}) {
+	ticker := time.NewTicker(spinnerInterval)
+	defer ticker.Stop()
+
+	frame := 0
+	for {
+		select {
+		case <-stop:
+			ui.queueUpdateDraw(func() {
+				ui.spinnerView.SetText("")
+				ui.clearRetryNotice()
+			})
+			return
+		case <-ui.stopped:
+			return
+		case <-ticker.C:
+			text := spinnerFrames[frame%len(spinnerFrames)]
+			frame++
+			ui.queueUpdateDraw(func() {
+				ui.spinnerView.SetText(text)
+			})
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/dipsylala/veracode-tui/services/identity"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const teamsPageName = "teams"
+
+// showTeams displays the list of teams, used to filter the applications list by team
+func (ui *UI) showTeams() {
+	if ui.identityService == nil {
+		return
+	}
+
+	table := tview.NewTable().
+		SetBorders(false).
+		SetSelectable(true, false).
+		SetFixed(1, 0)
+	table.SetBorder(true).
+		SetTitle(" Teams ").
+		SetTitleAlign(tview.AlignLeft).
+		SetBorderColor(tcell.GetColor(ui.theme.BorderFocused)).
+		SetBorderPadding(0, 0, 1, 1)
+	table.SetSelectedStyle(tcell.StyleDefault.
+		Background(tcell.GetColor(ui.theme.SelectionBackground)).
+		Foreground(tcell.GetColor(ui.theme.SelectionForeground)))
+
+	shortcutsBar := tview.NewTextView().
+		SetDynamicColors(true).
+		SetTextAlign(tview.AlignCenter).
+		SetText(fmt.Sprintf("[%s]Enter[-] Filter Applications  [%s]ESC[-] Back", ui.theme.Info, ui.theme.Info))
+
+	flex := tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(table, 0, 1, true).
+		AddItem(shortcutsBar, 1, 0, false)
+
+	table.SetSelectedFunc(func(row, column int) {
+		ui.selectTeamFilter(row)
+	})
+	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape {
+			ui.closeTeams()
+			return nil
+		}
+		return event
+	})
+
+	if ui.teams != nil {
+		ui.renderTeamsTable(table)
+	} else {
+		table.SetCell(0, 0, tview.NewTableCell("Loading teams...").
+			SetTextColor(tcell.GetColor(ui.theme.Pending)).
+			SetSelectable(false))
+		go ui.loadTeams(table)
+	}
+
+	ui.pages.AddAndSwitchToPage(teamsPageName, flex, true)
+	ui.app.SetFocus(table)
+}
+
+// loadTeams fetches the organization's teams and renders them into table
+func (ui *UI) loadTeams(table *tview.Table) {
+	teams, err := ui.identityService.GetTeams(ui.ctx)
+
+	ui.queueUpdateDraw(func() {
+		if err != nil {
+			table.Clear()
+			table.SetCell(0, 0, tview.NewTableCell(fmt.Sprintf("Error loading teams: %s", identity.UnavailableMessage(err))).
+				SetTextColor(tcell.GetColor(ui.theme.Error)).
+				SetSelectable(false))
+			return
+		}
+		ui.teams = teams
+		ui.renderTeamsTable(table)
+	})
+}
+
+// renderTeamsTable lists the teams, preceded by an entry that clears the team filter
+func (ui *UI) renderTeamsTable(table *tview.Table) {
+	table.Clear()
+
+	for col, header := range []string{"Team", "Business Unit"} {
+		table.SetCell(0, col, tview.NewTableCell(header).
+			SetTextColor(tcell.GetColor(ui.theme.ColumnHeader)).
+			SetAttributes(tcell.AttrBold).
+			SetSelectable(false))
+	}
+
+	table.SetCell(1, 0, tview.NewTableCell("All teams").
+		SetTextColor(tcell.GetColor(ui.theme.SecondaryText)).
+		SetExpansion(1))
+	table.SetCell(1, 1, tview.NewTableCell("").SetExpansion(1))
+
+	selected := 1
+	for i, team := range ui.teams {
+		row := i + 2
+		businessUnit := TextNotAvailable
+		if team.BusinessUnit != nil && team.BusinessUnit.BUName != "" {
+			businessUnit = team.BusinessUnit.BUName
+		}
+		table.SetCell(row, 0, tview.NewTableCell(team.TeamName).SetExpansion(1))
+		table.SetCell(row, 1, tview.NewTableCell(businessUnit).SetExpansion(1))
+		if team.TeamName == ui.teamFilterValue {
+			selected = row
+		}
+	}
+
+	table.Select(selected, 0)
+}
+
+// selectTeamFilter filters the applications list by the team on the given row and returns to it
+func (ui *UI) selectTeamFilter(row int) {
+	switch {
+	case row == 1:
+		ui.teamFilterValue = ""
+	case row >= 2 && row-2 < len(ui.teams):
+		ui.teamFilterValue = ui.teams[row-2].TeamName
+	default:
+		return
+	}
+
+	ui.closeTeams()
+	ui.triggerApplicationsSearch()
+}
+
+// closeTeams removes the teams page and returns to the applications list
+func (ui *UI) closeTeams() {
+	ui.pages.RemovePage(teamsPageName)
+	ui.pages.SwitchToPage("applications")
+	ui.app.SetFocus(ui.applicationsTable)
+}
@@ -0,0 +1,70 @@
+package ui
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// truncateRunes shortens s to at most max characters, replacing the end with "..." when it is cut.
+// It counts and cuts runes rather than bytes, so multibyte characters are never split.
+func truncateRunes(s string, max int) string {
+	if max <= 0 {
+		return ""
+	}
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	runes := []rune(s)
+	if max <= 3 {
+		return string(runes[:max])
+	}
+	return string(runes[:max-3]) + "..."
+}
+
+// wrap breaks s into lines of at most width characters, breaking between words where possible and
+// splitting words longer than width. Existing line breaks are kept. A width of zero or less returns s
+// unchanged.
+func wrap(s string, width int) string {
+	if width <= 0 {
+		return s
+	}
+
+	var sb strings.Builder
+	for i, paragraph := range strings.Split(s, "\n") {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		lineLength := 0
+		for _, word := range strings.Fields(paragraph) {
+			for _, part := range splitRunes(word, width) {
+				partLength := utf8.RuneCountInString(part)
+				switch {
+				case lineLength == 0:
+				case lineLength+1+partLength > width:
+					sb.WriteString("\n")
+					lineLength = 0
+				default:
+					sb.WriteString(" ")
+					lineLength++
+				}
+				sb.WriteString(part)
+				lineLength += partLength
+			}
+		}
+	}
+	return sb.String()
+}
+
+// splitRunes splits word into pieces of at most width runes
+func splitRunes(word string, width int) []string {
+	runes := []rune(word)
+	if len(runes) <= width {
+		return []string{word}
+	}
+	parts := make([]string, 0, (len(runes)+width-1)/width)
+	for len(runes) > width {
+		parts = append(parts, string(runes[:width]))
+		runes = runes[width:]
+	}
+	return append(parts, string(runes))
+}
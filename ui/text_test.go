@@ -0,0 +1,54 @@
+package ui
+
+import "testing"
+
+func TestTruncateRunes(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		max  int
+		want string
+	}{
+		{"short", "payments", 40, "payments"},
+		{"exact", "abcdef", 6, "abcdef"},
+		{"ascii", "abcdefghij", 8, "abcde..."},
+		{"multibyte kept whole", "日本語アプリケーション", 8, "日本語アプ..."},
+		{"accented", "Gestión de Pagos Área", 10, "Gestión..."},
+		{"emoji", "🛡️ shield app", 5, "🛡️..."},
+		{"tiny max", "日本語", 2, "日本"},
+		{"zero max", "abc", 0, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := truncateRunes(tt.s, tt.max); got != tt.want {
+				t.Errorf("truncateRunes(%q, %d) = %q, want %q", tt.s, tt.max, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWrap(t *testing.T) {
+	tests := []struct {
+		name  string
+		s     string
+		width int
+		want  string
+	}{
+		{"fits", "short text", 20, "short text"},
+		{"word boundaries", "the quick brown fox jumps", 10, "the quick\nbrown fox\njumps"},
+		{"multibyte words", "größer über straße", 6, "größer\nüber\nstraße"},
+		{"long word split", "日本語アプリケーション", 4, "日本語ア\nプリケー\nション"},
+		{"keeps line breaks", "first line\nsecond", 20, "first line\nsecond"},
+		{"collapses spaces", "a   b", 10, "a b"},
+		{"no width", "unchanged  text", 0, "unchanged  text"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := wrap(tt.s, tt.width); got != tt.want {
+				t.Errorf("wrap(%q, %d) = %q, want %q", tt.s, tt.width, got, tt.want)
+			}
+		})
+	}
+}
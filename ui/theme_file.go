@@ -0,0 +1,50 @@
+package ui
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"reflect"
+
+	"github.com/gdamore/tcell/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// LoadThemeFromFile reads a custom theme from a YAML or JSON file. Keys are the yaml tags of Theme,
+// e.g. "column-header"; colors missing from the file keep their DefaultTheme value. Every color must
+// be a hex value such as "#3B78FF" or a color name recognised by tcell.
+func LoadThemeFromFile(path string) (*Theme, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read theme file: %w", err)
+	}
+
+	// YAML is a superset of JSON, so one decoder handles both formats
+	theme := DefaultTheme()
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(theme); err != nil {
+		return nil, fmt.Errorf("failed to parse theme file %s: %w", path, err)
+	}
+
+	if err := validateTheme(theme); err != nil {
+		return nil, fmt.Errorf("invalid theme file %s: %w", path, err)
+	}
+	return theme, nil
+}
+
+// validateTheme checks that every color in theme is one tcell can display
+func validateTheme(theme *Theme) error {
+	value := reflect.ValueOf(theme).Elem()
+	for i := 0; i < value.NumField(); i++ {
+		if value.Field(i).Kind() != reflect.String {
+			continue
+		}
+		color := value.Field(i).String()
+		if tcell.GetColor(color) == tcell.ColorDefault {
+			key := value.Type().Field(i).Tag.Get("yaml")
+			return fmt.Errorf("%s: %q is not a valid color", key, color)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadThemeFromFile_YAML(t *testing.T) {
+	path := writeThemeFile(t, "theme.yml", "column-header: \"#00FFFF\"\nseverity-very-high: red\n")
+
+	theme, err := LoadThemeFromFile(path)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if theme.ColumnHeader != "#00FFFF" || theme.SeverityVeryHigh != "red" {
+		t.Errorf("Expected colors from the file, got %s/%s", theme.ColumnHeader, theme.SeverityVeryHigh)
+	}
+	if theme.Border != DefaultTheme().Border {
+		t.Errorf("Expected missing colors to keep the default, got %s", theme.Border)
+	}
+}
+
+func TestLoadThemeFromFile_JSON(t *testing.T) {
+	path := writeThemeFile(t, "theme.json", `{"border": "#123456", "policy-pass": "#00FF00"}`)
+
+	theme, err := LoadThemeFromFile(path)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if theme.Border != "#123456" || theme.PolicyPass != "#00FF00" {
+		t.Errorf("Expected colors from the file, got %s/%s", theme.Border, theme.PolicyPass)
+	}
+}
+
+func TestLoadThemeFromFile_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"bad color", "border: \"#GGGGGG\"\n", "border"},
+		{"unknown key", "bordr: \"#FFFFFF\"\n", "bordr"},
+		{"malformed", "border: [\n", "parse"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeThemeFile(t, "theme.yml", tt.content)
+			_, err := LoadThemeFromFile(path)
+			if err == nil {
+				t.Fatal("Expected an error, got nil")
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected error mentioning %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestLoadThemeFromFile_Missing(t *testing.T) {
+	if _, err := LoadThemeFromFile(filepath.Join(t.TempDir(), "missing.yml")); err == nil {
+		t.Fatal("Expected an error for a missing file, got nil")
+	}
+}
+
+func writeThemeFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("Failed to write theme file: %v", err)
+	}
+	return path
+}
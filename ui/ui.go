@@ -1,10 +1,16 @@
 package ui
 
 import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/dipsylala/veracode-tui/config"
 	"github.com/dipsylala/veracode-tui/services/annotations"
 	"github.com/dipsylala/veracode-tui/services/applications"
 	"github.com/dipsylala/veracode-tui/services/findings"
 	"github.com/dipsylala/veracode-tui/services/identity"
+	"github.com/dipsylala/veracode-tui/veracode"
 	"github.com/rivo/tview"
 )
 
@@ -26,34 +32,134 @@ type UI struct {
 	totalApps              int
 	pageSize               int
 	searchQuery            string
+	appSortColumn          SortColumn
+	appSortAsc             bool
+	showBusinessColumns    bool
+	applicationsTableWidth int                 // Inner width the applications table was last drawn at
+	applicationsTableCols  []applicationColumn // Columns currently shown, in display order
 	selectedApp            *applications.Application
 	sandboxes              []applications.Sandbox
-	selectionIndex         int // -1 for policy, 0+ for sandbox index
-	findings               []findings.Finding
+	selectionIndex         int                // -1 for policy, 0+ for sandbox index
+	findings               []findings.Finding // Findings shown in the table, after any search
+	allFindings            []findings.Finding // All findings loaded for the current filters
+	findingsSearchQuery    string
+	findingsCWEFilter      int
+	findingsStatusFilter   findings.Status // Empty shows every status
+	findingsMitigableOnly  bool            // Show only policy-violating findings that can still be mitigated
+	findingsNewOnly        bool            // Show only findings new in the latest scan
+	selectedIssueIDs       map[int64]bool  // Findings selected for batch mitigation
 	findingsScanFilter     findings.ScanFilterType
-	findingsSeverityFilter int // 0-5, 0 means no filter
+	findingsSeverityFilter int  // 0-5, 0 means no filter
+	findingsSeverityExact  bool // Match the severity filter exactly rather than that severity and above
+	findingsAnnotations    bool // Load annotations with the findings list; off by default, as they slow loading
+	findingsSortKey        SortKey
+	findingsSortAsc        bool
 	findingsPolicyFilter   findings.PolicyFilterType
+	defaultScanFilter      findings.ScanFilterType   // Scan type the findings view opens with
+	defaultPolicyFilter    findings.PolicyFilterType // Policy filter the findings view opens with
 	selectedFinding        *findings.Finding
 	staticCount            int64
 	dynamicCount           int64
 	scaCount               int64
 	scaExpandedComponents  map[string]bool // Tracks which SCA components are expanded
 
+	// Paging state for the current findings query
+	findingsQueryAppGUID  string
+	findingsQueryOpts     *findings.GetFindingsOptions
+	findingsCurrentPage   int
+	findingsTotalPages    int
+	findingsTotalElements int64
+	findingsLoadingMore   bool
+
+	// Latest scan per scan type from the scans endpoint
+	scanDetails map[string]applications.ApplicationScan
+
+	// Policy-violating findings for the compliance summary, nil until loaded
+	policyViolations []findings.Finding
+
 	// Data path navigation
-	currentStaticFlawInfo *findings.StaticFlawInfo
-	currentDataPathIndex  int
-	currentDataPathsView  *tview.TextView
+	currentStaticFlawInfo      *findings.StaticFlawInfo
+	currentDataPathIndex       int
+	annotationTimelineExpanded bool
+	currentDataPathsView       *tview.TextView
+
+	// Set while an annotation request is in flight, to ignore repeated submits
+	annotationSubmitting bool
+	clearCache           func()
+	logger               veracode.LevelLogger // Writes to the debug log, nil when there is none
+	draftStore           *config.DraftStore   // Saves unsubmitted mitigation comments, nil when unavailable
+	prefetchDetails      bool
+	prefetchCancel       context.CancelFunc
+
+	// Status bar spinner, animated while any tracked request is in flight
+	spinnerView      *tview.TextView
+	spinnerMu        sync.Mutex
+	requestsInFlight int
+	spinnerStop      chan struct{}
+	stopped          chan struct{} // Closed when the application exits
+
+	// Root context of background loads, canceled as soon as the application starts to quit
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// Notice shown while a request is being retried, and the status bar text it replaced
+	retryStatusBar      *tview.TextView
+	retryStatusMessage  string
+	retryStatusOriginal string
+
+	// Scan watch on the application detail view
+	detailStatusBar *tview.TextView
+	watchInterval   time.Duration
+	watchCancel     context.CancelFunc
+
+	// Finding counts per scan context, cached for the session and keyed by application and context GUID
+	contextCounts       map[string]int64
+	contextCountsCancel context.CancelFunc
+
+	// Applications opened this session, newest first
+	recentApplications []recentApplication
+
+	// Application last opened from the list and the row it was on, selected again on returning
+	lastSelectedAppGUID string
+	lastSelectedAppRow  int
+
+	// Application and finding to open after the first load, from the launch arguments
+	initialAppGUID string
+	initialIssueID int64
+
+	// Focus to restore when the help overlay is closed
+	helpPreviousFocus tview.Primitive
+
+	// Identity of the connected API user, populated by the startup check
+	principal *identity.Principal
+
+	// Why the API user could not be looked up, shown in the header in its place
+	identityUnavailable string
+
+	// Header warning shown when the API credentials are revoked, expired or expiring soon
+	credentialsWarning string
+
+	// Organization teams, loaded when the teams page is first opened
+	teams []identity.Team
 
 	// Views - Applications List
-	applicationsTable        *tview.Table
-	statusBar                *tview.TextView
-	searchInput              *tview.InputField
-	scanStatusFilter         *tview.DropDown
-	scanTypeFilter           *tview.DropDown
-	modifiedAfterInput       *tview.InputField
-	scanStatusFilterValue    string
-	scanTypeFilterValue      string
-	modifiedAfterFilterValue string
+	headerView                *tview.TextView
+	applicationsTable         *tview.Table
+	statusBar                 *tview.TextView
+	searchInput               *tview.InputField
+	scanStatusFilter          *tview.DropDown
+	complianceFilter          *tview.DropDown
+	scanTypeFilter            *tview.DropDown
+	modifiedAfterInput        *tview.InputField
+	modifiedBeforeInput       *tview.InputField
+	tagInput                  *tview.InputField
+	scanStatusFilterValue     string
+	complianceFilterValue     string
+	scanTypeFilterValues      []string
+	modifiedAfterFilterValue  string
+	modifiedBeforeFilterValue string
+	tagFilterValue            string
+	teamFilterValue           string
 
 	// Views - Application Detail
 	detailFlex      *tview.Flex
@@ -64,9 +170,13 @@ type UI struct {
 
 	// Views - Findings
 	findingsTable                  *tview.Table
-	findingsFilter                 *tview.DropDown
+	findingsContextDropdown        *tview.DropDown
 	findingsSeverityFilterDropdown *tview.DropDown
+	findingsSeverityContainer      *tview.Flex
 	findingsPolicyFilterDropdown   *tview.DropDown
+	findingsCWEDropdown            *tview.DropDown
+	findingsStatusDropdown         *tview.DropDown
+	findingsSearchInput            *tview.InputField
 	findingsCountsLabel            *tview.TextView
 	findingsTitleView              *tview.TextView
 	findingsFlex                   *tview.Flex
@@ -91,6 +201,7 @@ func NewUI(appService *applications.Service, findingsService *findings.Service,
 	tview.Borders.BottomLeftFocus = '╚'
 	tview.Borders.BottomRightFocus = '╝'
 
+	ctx, cancel := context.WithCancel(context.Background())
 	ui := &UI{
 		app:                    tview.NewApplication(),
 		pages:                  tview.NewPages(),
@@ -99,15 +210,24 @@ func NewUI(appService *applications.Service, findingsService *findings.Service,
 		identityService:        identityService,
 		annotationsService:     annotationsService,
 		theme:                  theme,
-		findingsScanFilter:     "STATIC",
+		findingsScanFilter:     findings.ScanFilterStatic,
 		findingsSeverityFilter: 0,
 		findingsPolicyFilter:   findings.PolicyFilterAll,
+		defaultScanFilter:      findings.ScanFilterStatic,
+		defaultPolicyFilter:    findings.PolicyFilterAll,
 		currentPage:            0,
-		pageSize:               100,
+		pageSize:               DefaultPageSize,
+		appSortColumn:          SortByModified,
 		scaExpandedComponents:  make(map[string]bool),
+		selectedIssueIDs:       make(map[int64]bool),
+		stopped:                make(chan struct{}),
+		ctx:                    ctx,
+		cancel:                 cancel,
+		watchInterval:          DefaultWatchInterval,
 	}
 
 	ui.setupApplicationsView()
+	ui.app.SetInputCapture(ui.handleGlobalInput)
 
 	return ui
 }
@@ -116,10 +236,35 @@ func (ui *UI) Run() error {
 	// Enable mouse support for scrolling and focus
 	ui.app.EnableMouse(true)
 
-	// Load initial data
-	go ui.loadApplications()
+	// Verify connectivity before loading initial data
+	go ui.runStartupCheck()
 
 	// Set root and run
 	ui.app.SetRoot(ui.pages, true)
-	return ui.app.Run()
+	err := ui.app.Run()
+	ui.cancel()
+	close(ui.stopped)
+	return err
+}
+
+// Context returns the root context of background loads, canceled as soon as the application
+// starts to quit. Giving it to the API client abandons requests still in flight on quitting.
+func (ui *UI) Context() context.Context {
+	return ui.ctx
+}
+
+// stop cancels background loads and stops the application
+func (ui *UI) stop() {
+	ui.cancel()
+	ui.app.Stop()
+}
+
+// queueUpdateDraw queues f to run on the event loop, unless the application is quitting. Once the
+// event loop has stopped nothing drains the update queue, so background loads finishing late must
+// not add to it.
+func (ui *UI) queueUpdateDraw(f func()) {
+	if ui.ctx.Err() != nil {
+		return
+	}
+	ui.app.QueueUpdateDraw(f)
 }
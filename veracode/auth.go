@@ -14,16 +14,37 @@ import (
 
 const veracodeRequestVersionString = "vcode_request_version_1"
 
+// APIKeySecretLength is the length of a Veracode API key secret in hex characters
+const APIKeySecretLength = 128
+
+// NormalizeSecret trims surrounding whitespace from an API key secret and lower-cases it,
+// returning an error if the result is not a hex string of APIKeySecretLength characters
+func NormalizeSecret(s string) (string, error) {
+	secret := strings.ToLower(strings.TrimSpace(s))
+	if _, err := hex.DecodeString(secret); err != nil {
+		return "", fmt.Errorf("API secret must be a hex string")
+	}
+	if len(secret) != APIKeySecretLength {
+		return "", fmt.Errorf("API secret must be %d hex characters, got %d", APIKeySecretLength, len(secret))
+	}
+	return secret, nil
+}
+
+// GenerateAuthHeader builds the HMAC Authorization header for a request, timestamped with the current time
 func GenerateAuthHeader(apiKeyID, apiKeySecret, httpMethod, requestURL string) (string, error) {
+	return generateAuthHeaderAt(apiKeyID, apiKeySecret, httpMethod, requestURL, time.Now())
+}
+
+// generateAuthHeaderAt builds the HMAC Authorization header timestamped with now
+func generateAuthHeaderAt(apiKeyID, apiKeySecret, httpMethod, requestURL string, now time.Time) (string, error) {
 	// Parse the URL to get the path and query
 	parsedURL, err := url.Parse(requestURL)
 	if err != nil {
 		return "", fmt.Errorf("failed to parse URL: %w", err)
 	}
 
-	// Get the current timestamp in milliseconds
-	timestamp := time.Now().UnixNano() / int64(1000000)
-	timestampStr := strconv.FormatInt(timestamp, 10)
+	// Get the timestamp in milliseconds
+	timestampStr := strconv.FormatInt(now.UnixMilli(), 10)
 
 	// Generate a random nonce (16 bytes)
 	nonce := make([]byte, 16)
@@ -0,0 +1,43 @@
+package veracode
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalizeSecret(t *testing.T) {
+	valid := strings.Repeat("0a", 64)
+
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr string
+	}{
+		{"valid", valid, valid, ""},
+		{"surrounding whitespace", "  " + valid + "\n", valid, ""},
+		{"uppercase", strings.ToUpper(valid), valid, ""},
+		{"non-hex", strings.Repeat("zz", 64), "", "must be a hex string"},
+		{"odd length", valid[:127], "", "must be a hex string"},
+		{"too short", valid[:64], "", "must be 128 hex characters"},
+		{"empty", "", "", "must be 128 hex characters"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeSecret(tt.input)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package veracode
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Authenticator adds credentials to an outgoing API request
+type Authenticator interface {
+	Authorize(req *http.Request) error
+}
+
+// hmacAuthenticator signs requests with the Veracode HMAC scheme using an API key ID and secret
+type hmacAuthenticator struct {
+	keyID       string
+	keySecret   string
+	clockOffset time.Duration // Added to the local time when timestamping requests
+}
+
+func (a *hmacAuthenticator) Authorize(req *http.Request) error {
+	authHeader, err := generateAuthHeaderAt(a.keyID, a.keySecret, req.Method, req.URL.String(), time.Now().Add(a.clockOffset))
+	if err != nil {
+		return fmt.Errorf("failed to generate auth header: %w", err)
+	}
+	req.Header.Set("Authorization", authHeader)
+	return nil
+}
+
+// bearerAuthenticator sends a pre-issued OAuth access token
+type bearerAuthenticator struct {
+	token string
+}
+
+func (a *bearerAuthenticator) Authorize(req *http.Request) error {
+	if a.token == "" {
+		return fmt.Errorf("bearer token is empty")
+	}
+	req.Header.Set("Authorization", "Bearer "+a.token)
+	return nil
+}
+
+// Region identifies the Veracode platform instance an account belongs to
+type Region string
+
+// Supported Veracode regions
+const (
+	RegionCommercial Region = "commercial"
+	RegionEuropean   Region = "eu"
+	RegionFederal    Region = "fedramp"
+)
+
+// ParseRegion converts a configured region name to a Region, defaulting to commercial
+func ParseRegion(name string) Region {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "eu", "european", "europe":
+		return RegionEuropean
+	case "fedramp", "fed", "federal", "us-fed":
+		return RegionFederal
+	default:
+		return RegionCommercial
+	}
+}
+
+// APIBaseURL returns the REST API base URL for the region
+func (r Region) APIBaseURL() string {
+	switch r {
+	case RegionEuropean:
+		return "https://api.veracode.eu"
+	case RegionFederal:
+		return "https://api.veracode.us"
+	default:
+		return BaseAPIURL
+	}
+}
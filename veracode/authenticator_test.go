@@ -0,0 +1,110 @@
+package veracode
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewClientWithToken_SendsBearerHeader(t *testing.T) {
+	var gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	client := NewClientWithToken("secret-token", RegionEuropean)
+	if client.baseURL != "https://api.veracode.eu" {
+		t.Errorf("Expected EU base URL, got %s", client.baseURL)
+	}
+
+	var logBuf bytes.Buffer
+	client.SetLogger(log.New(&logBuf, "", 0))
+	client.SetLogLevel(LogLevelDebug)
+
+	if _, err := client.doRequestWithBaseURL(context.Background(), "GET", server.URL+"/test"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if gotAuth != "Bearer secret-token" {
+		t.Errorf("Expected bearer Authorization header, got %q", gotAuth)
+	}
+	if strings.Contains(logBuf.String(), "secret-token") {
+		t.Error("Expected bearer token to be redacted from the debug log")
+	}
+}
+
+func TestNewClient_UsesHMAC(t *testing.T) {
+	var gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-id", testKeySecret)
+	if _, err := client.doRequestWithBaseURL(context.Background(), "GET", server.URL+"/test"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !strings.HasPrefix(gotAuth, "VERACODE-HMAC-SHA-256 id=test-id,") {
+		t.Errorf("Expected HMAC Authorization header, got %q", gotAuth)
+	}
+}
+
+func TestNewClientWithRegion_UsesRegionBaseURL(t *testing.T) {
+	if client := NewClientWithRegion("test-id", testKeySecret, RegionFederal); client.baseURL != "https://api.veracode.us" {
+		t.Errorf("Expected FedRAMP base URL, got %s", client.baseURL)
+	}
+	if client := NewClient("test-id", testKeySecret); client.baseURL != BaseAPIURL {
+		t.Errorf("Expected commercial base URL, got %s", client.baseURL)
+	}
+}
+
+func TestParseRegion(t *testing.T) {
+	tests := map[string]Region{
+		"":           RegionCommercial,
+		"commercial": RegionCommercial,
+		"EU":         RegionEuropean,
+		"european":   RegionEuropean,
+		"fedramp":    RegionFederal,
+		"us-fed":     RegionFederal,
+	}
+	for name, want := range tests {
+		if got := ParseRegion(name); got != want {
+			t.Errorf("ParseRegion(%q) = %s, want %s", name, got, want)
+		}
+	}
+}
+
+func TestSetClockOffset_ShiftsTimestamp(t *testing.T) {
+	var gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-id", testKeySecret)
+	client.SetClockOffset(time.Hour)
+	if _, err := client.doRequestWithBaseURL(context.Background(), "GET", server.URL+"/test"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	match := regexp.MustCompile(`ts=(\d+)`).FindStringSubmatch(gotAuth)
+	if match == nil {
+		t.Fatalf("Expected a timestamp in the Authorization header, got %q", gotAuth)
+	}
+	ts, _ := strconv.ParseInt(match[1], 10, 64)
+	if skew := time.UnixMilli(ts).Sub(time.Now()); skew < 59*time.Minute || skew > 61*time.Minute {
+		t.Errorf("Expected the timestamp to be an hour ahead, got %v", skew)
+	}
+}
@@ -0,0 +1,154 @@
+package veracode
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// applicationGUIDPattern extracts the application GUID from an API path
+var applicationGUIDPattern = regexp.MustCompile(`/applications/([^/?]+)`)
+
+// cacheEntry is a single cached response body
+type cacheEntry struct {
+	body    []byte
+	expires time.Time
+}
+
+// maxCacheEntries caps the number of cached responses. When the cache is full of unexpired
+// entries, the one closest to expiring is evicted to make room.
+const maxCacheEntries = 1000
+
+// responseCache is an in-memory TTL cache of GET response bodies, safe for concurrent use
+type responseCache struct {
+	mu      sync.RWMutex
+	ttl     time.Duration
+	entries map[string]cacheEntry
+}
+
+func newResponseCache(ttl time.Duration) *responseCache {
+	return &responseCache{
+		ttl:     ttl,
+		entries: make(map[string]cacheEntry),
+	}
+}
+
+// cacheKey builds the cache key for a request
+func cacheKey(method, fullURL string) string {
+	return method + " " + fullURL
+}
+
+// get returns the cached body for key if present and not expired
+func (c *responseCache) get(key string) ([]byte, bool) {
+	c.mu.RLock()
+	entry, ok := c.entries[key]
+	c.mu.RUnlock()
+
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expires) {
+		c.mu.Lock()
+		delete(c.entries, key)
+		c.mu.Unlock()
+		return nil, false
+	}
+	return entry.body, true
+}
+
+// set stores body under key for the cache TTL, first removing expired entries so responses that
+// are never requested again do not accumulate
+func (c *responseCache) set(key string, body []byte) {
+	now := time.Now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.sweep(now)
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= maxCacheEntries {
+		c.evictOldest()
+	}
+	c.entries[key] = cacheEntry{
+		body:    body,
+		expires: now.Add(c.ttl),
+	}
+}
+
+// sweep removes the entries that have expired by now. The caller must hold c.mu.
+func (c *responseCache) sweep(now time.Time) {
+	for key, entry := range c.entries {
+		if now.After(entry.expires) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// evictOldest removes the entry closest to expiring. The caller must hold c.mu.
+func (c *responseCache) evictOldest() {
+	var oldestKey string
+	var oldest time.Time
+	for key, entry := range c.entries {
+		if oldestKey == "" || entry.expires.Before(oldest) {
+			oldestKey, oldest = key, entry.expires
+		}
+	}
+	delete(c.entries, oldestKey)
+}
+
+// invalidateApplication removes all entries relating to the application referenced by urlPath
+func (c *responseCache) invalidateApplication(urlPath string) {
+	matches := applicationGUIDPattern.FindStringSubmatch(urlPath)
+	if len(matches) < 2 {
+		return
+	}
+	fragment := "/applications/" + matches[1]
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.entries {
+		if strings.Contains(key, fragment) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// delete removes the entry for key, if any
+func (c *responseCache) delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+}
+
+// clear removes all entries
+func (c *responseCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[string]cacheEntry)
+}
+
+// EnableCache enables in-memory caching of GET responses for the given TTL
+// A TTL of zero or less disables caching
+func (c *Client) EnableCache(ttl time.Duration) {
+	if ttl <= 0 {
+		c.cache = nil
+		return
+	}
+	c.cache = newResponseCache(ttl)
+}
+
+// EvictCached removes the cached response to a GET of urlPath without query parameters, leaving
+// the rest of the cache in place
+func (c *Client) EvictCached(urlPath string) {
+	if c.cache != nil {
+		c.cache.delete(cacheKey(http.MethodGet, c.baseURL+urlPath))
+	}
+}
+
+// ClearCache removes all cached responses
+func (c *Client) ClearCache() {
+	if c.cache != nil {
+		c.cache.clear()
+		c.logf(LogLevelInfo, "Cache cleared")
+	}
+}
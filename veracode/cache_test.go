@@ -0,0 +1,146 @@
+package veracode
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestResponseCache_GetSet(t *testing.T) {
+	cache := newResponseCache(time.Minute)
+	key := cacheKey("GET", BaseAPIURL+"/appsec/v1/applications?page=1")
+
+	if _, ok := cache.get(key); ok {
+		t.Fatal("Expected cache miss for empty cache")
+	}
+
+	cache.set(key, []byte(`{"page":1}`))
+
+	body, ok := cache.get(key)
+	if !ok {
+		t.Fatal("Expected cache hit after set")
+	}
+	if string(body) != `{"page":1}` {
+		t.Errorf("Expected cached body, got %s", string(body))
+	}
+}
+
+func TestResponseCache_Expiry(t *testing.T) {
+	cache := newResponseCache(time.Millisecond)
+	key := cacheKey("GET", BaseAPIURL+"/appsec/v1/applications")
+
+	cache.set(key, []byte("{}"))
+	time.Sleep(5 * time.Millisecond)
+
+	if _, ok := cache.get(key); ok {
+		t.Fatal("Expected expired entry to be a cache miss")
+	}
+}
+
+func TestResponseCache_InvalidateApplication(t *testing.T) {
+	cache := newResponseCache(time.Minute)
+	findingsKey := cacheKey("GET", BaseAPIURL+"/appsec/v2/applications/app-1/findings?scan_type=STATIC")
+	appKey := cacheKey("GET", BaseAPIURL+"/appsec/v1/applications/app-1")
+	otherKey := cacheKey("GET", BaseAPIURL+"/appsec/v2/applications/app-2/findings")
+
+	cache.set(findingsKey, []byte("{}"))
+	cache.set(appKey, []byte("{}"))
+	cache.set(otherKey, []byte("{}"))
+
+	cache.invalidateApplication("/appsec/v2/applications/app-1/annotations")
+
+	if _, ok := cache.get(findingsKey); ok {
+		t.Error("Expected findings entry for app-1 to be invalidated")
+	}
+	if _, ok := cache.get(appKey); ok {
+		t.Error("Expected application entry for app-1 to be invalidated")
+	}
+	if _, ok := cache.get(otherKey); !ok {
+		t.Error("Expected entry for app-2 to remain cached")
+	}
+}
+
+func TestClient_EnableAndClearCache(t *testing.T) {
+	client := NewClient("id", "secret")
+	client.EnableCache(time.Minute)
+	if client.cache == nil {
+		t.Fatal("Expected cache to be enabled")
+	}
+
+	key := cacheKey("GET", BaseAPIURL+"/appsec/v1/applications")
+	client.cache.set(key, []byte("{}"))
+	client.ClearCache()
+	if _, ok := client.cache.get(key); ok {
+		t.Error("Expected cache to be empty after ClearCache")
+	}
+
+	client.EnableCache(0)
+	if client.cache != nil {
+		t.Error("Expected zero TTL to disable the cache")
+	}
+}
+
+func TestClient_EvictCached(t *testing.T) {
+	client := NewClient("id", "secret")
+	client.EnableCache(time.Minute)
+
+	app := cacheKey("GET", BaseAPIURL+"/appsec/v1/applications/app-1")
+	other := cacheKey("GET", BaseAPIURL+"/appsec/v1/applications/app-2")
+	client.cache.set(app, []byte("{}"))
+	client.cache.set(other, []byte("{}"))
+
+	client.EvictCached("/appsec/v1/applications/app-1")
+	if _, ok := client.cache.get(app); ok {
+		t.Error("Expected the evicted response to be removed")
+	}
+	if _, ok := client.cache.get(other); !ok {
+		t.Error("Expected other responses to stay cached")
+	}
+}
+
+func TestResponseCache_ConcurrentAccess(t *testing.T) {
+	cache := newResponseCache(time.Minute)
+	var wg sync.WaitGroup
+
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			key := cacheKey("GET", BaseAPIURL+"/appsec/v2/applications/app-1/findings")
+			cache.set(key, []byte("{}"))
+			cache.get(key)
+			cache.invalidateApplication("/appsec/v2/applications/app-1/annotations")
+		}()
+	}
+	wg.Wait()
+}
+
+func TestResponseCache_SetSweepsExpiredEntries(t *testing.T) {
+	cache := newResponseCache(time.Millisecond)
+	cache.set(cacheKey("GET", BaseAPIURL+"/appsec/v1/applications?page=0"), []byte("{}"))
+	cache.set(cacheKey("GET", BaseAPIURL+"/appsec/v1/applications?page=1"), []byte("{}"))
+	time.Sleep(5 * time.Millisecond)
+
+	cache.set(cacheKey("GET", BaseAPIURL+"/appsec/v1/applications?page=2"), []byte("{}"))
+	if len(cache.entries) != 1 {
+		t.Errorf("Expected the expired entries to be removed, got %d entries", len(cache.entries))
+	}
+}
+
+func TestResponseCache_CapsEntries(t *testing.T) {
+	cache := newResponseCache(time.Hour)
+	first := cacheKey("GET", BaseAPIURL+"/appsec/v1/applications?page=0")
+	cache.set(first, []byte("{}"))
+	cache.entries[first] = cacheEntry{body: []byte("{}"), expires: time.Now().Add(time.Minute)}
+	for i := 1; i <= maxCacheEntries; i++ {
+		cache.set(cacheKey("GET", fmt.Sprintf("%s/appsec/v1/applications?page=%d", BaseAPIURL, i)), []byte("{}"))
+	}
+
+	if len(cache.entries) != maxCacheEntries {
+		t.Errorf("Expected %d entries, got %d", maxCacheEntries, len(cache.entries))
+	}
+	if _, ok := cache.get(first); ok {
+		t.Error("Expected the entry closest to expiring to be evicted")
+	}
+}
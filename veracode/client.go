@@ -2,12 +2,15 @@ package veracode
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
 	"net/url"
 	"os"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -17,61 +20,192 @@ const (
 	BaseAPIURL = "https://api.veracode.com"
 )
 
+// DefaultTimeout is the request timeout used by new clients
+const DefaultTimeout = 30 * time.Second
+
 // HTTPError represents an HTTP error response from the Veracode API
 type HTTPError struct {
-	StatusCode int    // HTTP status code (e.g., 400, 404, 500)
-	Status     string // HTTP status text (e.g., "Bad Request")
-	Body       []byte // Raw response body
+	StatusCode int           // HTTP status code (e.g., 400, 404, 500)
+	Status     string        // HTTP status text (e.g., "Bad Request")
+	Body       []byte        // Raw response body
+	RequestID  string        // Request ID the API assigned to the response, if any, for Veracode support
+	RetryAfter time.Duration // Wait the API asked for before retrying, from the Retry-After header; 0 if none
 }
 
 func (e *HTTPError) Error() string {
-	return fmt.Sprintf("HTTP %d: %s", e.StatusCode, string(e.Body))
+	return fmt.Sprintf("%s: %s", httpStatusPrefix(e.StatusCode, e.RequestID), string(e.Body))
+}
+
+// DefaultUserAgent is the User-Agent sent with requests until SetUserAgent is called
+const DefaultUserAgent = "veracode-tui"
+
+// UserAgentForVersion returns the User-Agent identifying a version of the TUI, e.g. "veracode-tui/1.2.0"
+func UserAgentForVersion(version string) string {
+	return DefaultUserAgent + "/" + version
 }
 
 // Client represents a Veracode API client
 type Client struct {
-	apiKeyID     string
-	apiKeySecret string
-	httpClient   *http.Client
-	debugLogger  *log.Logger
-	debugFile    *os.File
+	auth          Authenticator
+	baseURL       string
+	userAgent     string
+	ctx           context.Context // context of requests made without one, see SetContext
+	httpClient    *http.Client
+	transportOpts TransportOptions // pool settings of the default transport
+	logMu         sync.RWMutex     // guards the logging fields, which may change while requests are in flight
+	logger        *log.Logger
+	logLevel      LogLevel
+	debugFile     *os.File
+	debugRaw      bool // log credentials unredacted
+	cache         *responseCache
+	limiter       *rateLimiter
+
+	retryMu       sync.RWMutex // guards the retry policy, which may change while requests are in flight
+	retryAttempts int
+	retryBackoff  time.Duration
+	retryNotify   RetryNotifyFunc
 }
 
+// NewClient creates a client that signs requests with HMAC API credentials
 func NewClient(apiKeyID, apiKeySecret string) *Client {
+	return NewClientWithRegion(apiKeyID, apiKeySecret, RegionCommercial)
+}
+
+// NewClientWithRegion creates a client that signs requests with HMAC API credentials
+// against the API for the given region
+func NewClientWithRegion(apiKeyID, apiKeySecret string, region Region) *Client {
+	// Secrets that fail validation are kept as given so the error surfaces on the first request
+	if secret, err := NormalizeSecret(apiKeySecret); err == nil {
+		apiKeySecret = secret
+	}
+	return newClient(&hmacAuthenticator{keyID: apiKeyID, keySecret: apiKeySecret}, region.APIBaseURL())
+}
+
+// NewClientWithToken creates a client that authenticates with an OAuth bearer token
+// against the API for the given region
+func NewClientWithToken(token string, region Region) *Client {
+	return newClient(&bearerAuthenticator{token: token}, region.APIBaseURL())
+}
+
+func newClient(auth Authenticator, baseURL string) *Client {
+	opts := DefaultTransportOptions()
 	return &Client{
-		apiKeyID:     apiKeyID,
-		apiKeySecret: apiKeySecret,
+		auth:          auth,
+		baseURL:       baseURL,
+		userAgent:     DefaultUserAgent,
+		ctx:           context.Background(),
+		logLevel:      LogLevelWarn,
+		transportOpts: opts,
 		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
+			Timeout:   DefaultTimeout,
+			Transport: newTransport(opts),
 		},
 	}
 }
 
-// DoRequestWithQueryParams performs an authenticated HTTP request with query parameters
+// SetTimeout sets the overall time limit for each subsequent request, including reading the response body.
+// A timeout of zero means no timeout.
+func (c *Client) SetTimeout(d time.Duration) {
+	c.httpClient.Timeout = d
+}
+
+// SetContext sets the context of requests made without one, such as through DoRequestWithQueryParams.
+// Cancelling it abandons those requests, including any rate limit wait or retry backoff. A nil ctx
+// restores context.Background(). Call it before making requests.
+func (c *Client) SetContext(ctx context.Context) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	c.ctx = ctx
+}
+
+// SetUserAgent sets the User-Agent header sent with subsequent requests, so they can be told apart
+// in logs. An empty userAgent restores DefaultUserAgent. Call it before making requests.
+func (c *Client) SetUserAgent(userAgent string) {
+	if userAgent == "" {
+		userAgent = DefaultUserAgent
+	}
+	c.userAgent = userAgent
+}
+
+// SetClockOffset compensates for a known local clock skew by adding d to the time used to sign
+// requests, e.g. 90*time.Second when the local clock is 90 seconds slow. It has no effect on
+// clients using an OAuth token, which are not timestamped.
+func (c *Client) SetClockOffset(d time.Duration) {
+	if auth, ok := c.auth.(*hmacAuthenticator); ok {
+		auth.clockOffset = d
+	}
+}
+
+// SetHTTPTransport replaces the transport used for subsequent requests.
+// A custom transport overrides the default proxy handling, so it must configure its own proxy if one is needed.
+// Passing nil restores the default transport, with the pool settings last given to SetTransportOptions.
+func (c *Client) SetHTTPTransport(rt http.RoundTripper) {
+	if rt == nil {
+		rt = newTransport(c.transportOpts)
+	}
+	c.httpClient.Transport = rt
+}
+
+// DoRequestWithQueryParams performs an authenticated HTTP request with query parameters, in the
+// context set by SetContext
 // This is used by the service layer for the new REST APIs
 func (c *Client) DoRequestWithQueryParams(method, urlPath string, params url.Values) ([]byte, error) {
-	fullURL := BaseAPIURL + urlPath
+	return c.DoRequestWithQueryParamsContext(c.ctx, method, urlPath, params)
+}
+
+// DoRequestWithQueryParamsContext is DoRequestWithQueryParams with a caller-supplied context
+func (c *Client) DoRequestWithQueryParamsContext(ctx context.Context, method, urlPath string, params url.Values) ([]byte, error) {
+	fullURL := c.baseURL + urlPath
 	if len(params) > 0 {
 		fullURL += "?" + params.Encode()
 	}
 
-	body, err := c.doRequestWithBaseURL(method, fullURL)
+	// Serve GETs from the cache when enabled
+	key := cacheKey(method, fullURL)
+	if c.cache != nil && method == http.MethodGet {
+		if body, ok := c.cache.get(key); ok {
+			c.logf(LogLevelInfo, "Cache hit: %s %s", method, fullURL)
+			return body, nil
+		}
+	}
+
+	body, err := c.doWithRetries(ctx, method, fullURL, func() ([]byte, error) {
+		return c.doRequestWithBaseURL(ctx, method, fullURL)
+	})
 	if err != nil {
 		// Add URL details to error for debugging
 		return nil, fmt.Errorf("%w (URL: %s)", err, fullURL)
 	}
+
+	if c.cache != nil && method == http.MethodGet {
+		c.cache.set(key, body)
+	}
 	return body, nil
 }
 
-// DoRequestWithBody performs an authenticated HTTP request with a JSON body and query parameters
+// DoRequestWithBody performs an authenticated HTTP request with a JSON body and query parameters,
+// in the context set by SetContext
 // This is used for POST/PUT/PATCH requests that need to send data
 func (c *Client) DoRequestWithBody(method, urlPath string, body []byte, params url.Values) ([]byte, error) {
-	fullURL := BaseAPIURL + urlPath
+	return c.DoRequestWithBodyContext(c.ctx, method, urlPath, body, params)
+}
+
+// DoRequestWithBodyContext is DoRequestWithBody with a caller-supplied context
+func (c *Client) DoRequestWithBodyContext(ctx context.Context, method, urlPath string, body []byte, params url.Values) ([]byte, error) {
+	fullURL := c.baseURL + urlPath
 	if len(params) > 0 {
 		fullURL += "?" + params.Encode()
 	}
 
-	respBody, err := c.doRequestWithBodyAndBaseURL(method, fullURL, body)
+	respBody, err := c.doRequestWithBodyAndBaseURL(ctx, method, fullURL, body)
+
+	// Writes change server state, so drop cached responses for the affected application
+	if c.cache != nil {
+		c.cache.invalidateApplication(urlPath)
+		c.logf(LogLevelInfo, "Cache invalidated after %s %s", method, urlPath)
+	}
+
 	if err != nil {
 		// Add URL details to error for debugging
 		return nil, fmt.Errorf("%w (URL: %s)", err, fullURL)
@@ -80,55 +214,53 @@ func (c *Client) DoRequestWithBody(method, urlPath string, body []byte, params u
 }
 
 // doRequestWithBaseURL performs an authenticated HTTP request with a full URL
-func (c *Client) doRequestWithBaseURL(method, fullURL string) ([]byte, error) {
-	req, err := http.NewRequest(method, fullURL, nil)
+func (c *Client) doRequestWithBaseURL(ctx context.Context, method, fullURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, fullURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	// Generate authentication header
-	authHeader, err := GenerateAuthHeader(c.apiKeyID, c.apiKeySecret, method, fullURL)
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate auth header: %w", err)
+	// Wait before signing, so a long wait cannot leave the signature's timestamp stale
+	if err := c.waitForRateLimit(ctx); err != nil {
+		return nil, err
+	}
+
+	// Add authentication header
+	if err := c.auth.Authorize(req); err != nil {
+		return nil, err
 	}
 
-	req.Header.Set("Authorization", authHeader)
 	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
 
-	// Log request if debug logging is enabled
-	if c.debugLogger != nil {
-		c.debugLogger.Printf("\n>>> REQUEST: %s %s\n", method, fullURL)
-		c.debugLogger.Printf(">>> Headers: %v\n", req.Header)
-	}
+	c.logRequest(method, fullURL, req.Header, nil)
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
+		c.logf(LogLevelError, "%s %s failed: %v", method, fullURL, err)
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
 	defer func() {
-		if closeErr := resp.Body.Close(); closeErr != nil && c.debugLogger != nil {
-			c.debugLogger.Printf("Warning: failed to close response body: %v", closeErr)
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			c.logf(LogLevelWarn, "Failed to close response body: %v", closeErr)
 		}
 	}()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
+		c.logf(LogLevelError, "%s %s: failed to read response body: %v", method, fullURL, err)
 		return nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
-	// Log response if debug logging is enabled
-	if c.debugLogger != nil {
-		c.debugLogger.Printf("<<< RESPONSE: Status %d\n", resp.StatusCode)
-		c.debugLogger.Printf("<<< Headers: %v\n", resp.Header)
-		c.debugLogger.Printf("<<< Body: %s\n", string(body))
-		c.debugLogger.Println("---")
-	}
+	c.logResponse(method, fullURL, resp, body)
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		return nil, &HTTPError{
 			StatusCode: resp.StatusCode,
 			Status:     resp.Status,
 			Body:       body,
+			RequestID:  responseRequestID(resp.Header),
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
 		}
 	}
 
@@ -136,57 +268,54 @@ func (c *Client) doRequestWithBaseURL(method, fullURL string) ([]byte, error) {
 }
 
 // doRequestWithBodyAndBaseURL performs an authenticated HTTP request with a full URL and request body
-func (c *Client) doRequestWithBodyAndBaseURL(method, fullURL string, body []byte) ([]byte, error) {
-	req, err := http.NewRequest(method, fullURL, bytes.NewReader(body))
+func (c *Client) doRequestWithBodyAndBaseURL(ctx context.Context, method, fullURL string, body []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, fullURL, bytes.NewReader(body))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	// Generate authentication header
-	authHeader, err := GenerateAuthHeader(c.apiKeyID, c.apiKeySecret, method, fullURL)
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate auth header: %w", err)
+	// Wait before signing, so a long wait cannot leave the signature's timestamp stale
+	if err := c.waitForRateLimit(ctx); err != nil {
+		return nil, err
+	}
+
+	// Add authentication header
+	if err := c.auth.Authorize(req); err != nil {
+		return nil, err
 	}
 
-	req.Header.Set("Authorization", authHeader)
 	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
 	req.Header.Set("Content-Type", "application/json")
 
-	// Log request if debug logging is enabled
-	if c.debugLogger != nil {
-		c.debugLogger.Printf("\n>>> REQUEST: %s %s\n", method, fullURL)
-		c.debugLogger.Printf(">>> Headers: %v\n", req.Header)
-		c.debugLogger.Printf(">>> Body: %s\n", string(body))
-	}
+	c.logRequest(method, fullURL, req.Header, body)
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
+		c.logf(LogLevelError, "%s %s failed: %v", method, fullURL, err)
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
 	defer func() {
-		if closeErr := resp.Body.Close(); closeErr != nil && c.debugLogger != nil {
-			c.debugLogger.Printf("Warning: failed to close response body: %v", closeErr)
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			c.logf(LogLevelWarn, "Failed to close response body: %v", closeErr)
 		}
 	}()
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
+		c.logf(LogLevelError, "%s %s: failed to read response body: %v", method, fullURL, err)
 		return nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
-	// Log response if debug logging is enabled
-	if c.debugLogger != nil {
-		c.debugLogger.Printf("<<< RESPONSE: Status %d\n", resp.StatusCode)
-		c.debugLogger.Printf("<<< Headers: %v\n", resp.Header)
-		c.debugLogger.Printf("<<< Body: %s\n", string(respBody))
-		c.debugLogger.Println("---")
-	}
+	c.logResponse(method, fullURL, resp, respBody)
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		return nil, &HTTPError{
 			StatusCode: resp.StatusCode,
 			Status:     resp.Status,
 			Body:       respBody,
+			RequestID:  responseRequestID(resp.Header),
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
 		}
 	}
 
@@ -196,23 +325,66 @@ func (c *Client) doRequestWithBodyAndBaseURL(method, fullURL string, body []byte
 // HealthCheck verifies that authentication services are operational
 // Returns nil if successful (200 OK), error otherwise
 func (c *Client) HealthCheck() error {
-	fullURL := BaseAPIURL + "/healthcheck/status"
-	_, err := c.doRequestWithBaseURL("GET", fullURL)
+	fullURL := c.baseURL + "/healthcheck/status"
+	_, err := c.doRequestWithBaseURL(c.ctx, "GET", fullURL)
 	return err
 }
 
-// EnableDebugLog enables logging of all REST requests and responses to the specified file
+// logRequest writes an outgoing request to the log at debug level. body is nil for requests without one.
+func (c *Client) logRequest(method, fullURL string, headers http.Header, body []byte) {
+	logger := c.loggerFor(LogLevelDebug)
+	if logger == nil {
+		return
+	}
+	logger.Printf("[%s] >>> REQUEST: %s %s", LogLevelDebug, method, fullURL)
+	logger.Printf("[%s] >>> Headers: %v", LogLevelDebug, c.loggableHeaders(headers))
+	if body != nil {
+		logger.Printf("[%s] >>> Body: %s", LogLevelDebug, string(body))
+	}
+}
+
+// logResponse writes a response to the log: error statuses as warnings, and the full response at debug level
+func (c *Client) logResponse(method, fullURL string, resp *http.Response, body []byte) {
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		c.logf(LogLevelWarn, "%s %s: rate limited by the API (%s, Retry-After %q)", method, fullURL, resp.Status, resp.Header.Get("Retry-After"))
+	case resp.StatusCode < 200 || resp.StatusCode >= 300:
+		if id := responseRequestID(resp.Header); id != "" {
+			c.logf(LogLevelWarn, "%s %s: %s (request-id: %s)", method, fullURL, resp.Status, id)
+		} else {
+			c.logf(LogLevelWarn, "%s %s: %s", method, fullURL, resp.Status)
+		}
+	}
+
+	logger := c.loggerFor(LogLevelDebug)
+	if logger == nil {
+		return
+	}
+	logger.Printf("[%s] <<< RESPONSE: Status %d", LogLevelDebug, resp.StatusCode)
+	logger.Printf("[%s] <<< Headers: %v", LogLevelDebug, resp.Header)
+	logger.Printf("[%s] <<< Body: %s", LogLevelDebug, string(body))
+}
+
+// EnableDebugLog logs every REST request and response to the specified file. It is a convenience
+// for SetLogger with a file and SetLogLevel(LogLevelDebug); a lower level may be set afterwards.
 func (c *Client) EnableDebugLog(filename string) error {
 	f, err := os.OpenFile(filename, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
 	if err != nil {
 		return fmt.Errorf("failed to open debug log file: %w", err)
 	}
 	c.debugFile = f
-	c.debugLogger = log.New(f, "", log.LstdFlags)
-	c.debugLogger.Println("=== Debug logging started ===")
+	c.EnableDebugLogWriter(f)
 	return nil
 }
 
+// EnableDebugLogWriter logs every REST request and response to w, setting the level to LogLevelDebug
+func (c *Client) EnableDebugLogWriter(w io.Writer) {
+	logger := log.New(w, "", log.LstdFlags)
+	c.SetLogger(logger)
+	c.SetLogLevel(LogLevelDebug)
+	logger.Println("=== Debug logging started ===")
+}
+
 // Close closes the debug log file if open
 func (c *Client) Close() error {
 	if c.debugFile != nil {
@@ -220,3 +392,60 @@ func (c *Client) Close() error {
 	}
 	return nil
 }
+
+// SetDebugRedaction controls whether credentials are redacted in the debug log.
+// Redaction is on by default; disable it only when raw Authorization headers are needed.
+func (c *Client) SetDebugRedaction(enabled bool) {
+	c.logMu.Lock()
+	defer c.logMu.Unlock()
+	c.debugRaw = !enabled
+}
+
+// loggableHeaders returns the headers to write to the debug log, with credentials redacted unless disabled
+func (c *Client) loggableHeaders(headers http.Header) http.Header {
+	c.logMu.RLock()
+	raw := c.debugRaw
+	c.logMu.RUnlock()
+	if raw {
+		return headers
+	}
+	redacted := headers.Clone()
+	if auth := redacted.Get("Authorization"); auth != "" {
+		redacted.Set("Authorization", redactAuthorization(auth))
+	}
+	return redacted
+}
+
+// redactAuthorization masks the secret parts of an Authorization header value.
+// Bearer tokens are removed entirely; for HMAC headers the nonce and signature are removed
+// and the key ID is reduced to its last 4 characters.
+func redactAuthorization(value string) string {
+	if strings.HasPrefix(value, "Bearer ") {
+		return "Bearer [REDACTED]"
+	}
+
+	scheme, params, ok := strings.Cut(value, " ")
+	if !ok {
+		return "[REDACTED]"
+	}
+
+	fields := strings.Split(params, ",")
+	for i, field := range fields {
+		name, val, _ := strings.Cut(field, "=")
+		switch name {
+		case "id":
+			fields[i] = "id=" + maskKeyID(val)
+		case "nonce", "sig":
+			fields[i] = name + "=[REDACTED]"
+		}
+	}
+	return scheme + " " + strings.Join(fields, ",")
+}
+
+// maskKeyID keeps only the last 4 characters of an API key ID
+func maskKeyID(keyID string) string {
+	if len(keyID) <= 4 {
+		return strings.Repeat("*", len(keyID))
+	}
+	return strings.Repeat("*", len(keyID)-4) + keyID[len(keyID)-4:]
+}
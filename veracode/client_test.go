@@ -0,0 +1,192 @@
+package veracode
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+var sigPattern = regexp.MustCompile(`sig=([0-9A-F]+)`)
+
+func TestDebugLog_RedactsHMACHeader(t *testing.T) {
+	var gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	client := NewClient("abcdef123456", testKeySecret)
+	var logBuf bytes.Buffer
+	client.EnableDebugLogWriter(&logBuf)
+
+	if _, err := client.doRequestWithBaseURL(context.Background(), "GET", server.URL+"/test"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	match := sigPattern.FindStringSubmatch(gotAuth)
+	if match == nil {
+		t.Fatalf("Expected signature in Authorization header, got %q", gotAuth)
+	}
+
+	logged := logBuf.String()
+	if strings.Contains(logged, match[1]) {
+		t.Error("Expected signature to be redacted from the debug log")
+	}
+	if strings.Contains(logged, "abcdef123456") {
+		t.Error("Expected API key ID to be masked in the debug log")
+	}
+	if !strings.Contains(logged, "id=********3456") {
+		t.Errorf("Expected masked key ID in the debug log, got %s", logged)
+	}
+}
+
+func TestDebugLog_RawOutput(t *testing.T) {
+	var gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	client := NewClient("abcdef123456", testKeySecret)
+	var logBuf bytes.Buffer
+	client.EnableDebugLogWriter(&logBuf)
+	client.SetDebugRedaction(false)
+
+	if _, err := client.doRequestWithBaseURL(context.Background(), "GET", server.URL+"/test"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	match := sigPattern.FindStringSubmatch(gotAuth)
+	if match == nil || !strings.Contains(logBuf.String(), match[1]) {
+		t.Error("Expected signature in the debug log when redaction is disabled")
+	}
+}
+
+func TestRedactAuthorization(t *testing.T) {
+	tests := map[string]string{
+		"Bearer abc": "Bearer [REDACTED]",
+		"VERACODE-HMAC-SHA-256 id=abc,ts=1,nonce=FF,sig=AA": "VERACODE-HMAC-SHA-256 id=***,ts=1,nonce=[REDACTED],sig=[REDACTED]",
+		"garbage": "[REDACTED]",
+	}
+	for in, want := range tests {
+		if got := redactAuthorization(in); got != want {
+			t.Errorf("redactAuthorization(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+// roundTripFunc adapts a function to http.RoundTripper
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestSetHTTPTransport_UsesCustomRoundTripper(t *testing.T) {
+	var gotURL string
+	client := NewClient("test-id", testKeySecret)
+	client.SetHTTPTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		gotURL = req.URL.String()
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"ok":true}`)),
+			Header:     make(http.Header),
+			Request:    req,
+		}, nil
+	}))
+
+	body, err := client.DoRequestWithQueryParams("GET", "/test", nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if string(body) != `{"ok":true}` {
+		t.Errorf("Expected body from fake transport, got %s", body)
+	}
+	if gotURL != BaseAPIURL+"/test" {
+		t.Errorf("Expected request to %s/test, got %s", BaseAPIURL, gotURL)
+	}
+}
+
+func TestUserAgent(t *testing.T) {
+	var got []string
+	client := NewClient("test-id", testKeySecret)
+	client.SetHTTPTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		got = append(got, req.Header.Get("User-Agent"))
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader("{}")),
+			Header:     make(http.Header),
+			Request:    req,
+		}, nil
+	}))
+
+	if _, err := client.DoRequestWithQueryParams("GET", "/default", nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	client.SetUserAgent(UserAgentForVersion("1.2.3"))
+	if _, err := client.DoRequestWithQueryParams("GET", "/get", nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := client.DoRequestWithBody("POST", "/post", []byte("{}"), nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	client.SetUserAgent("")
+	if _, err := client.DoRequestWithQueryParams("GET", "/restored", nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	want := []string{DefaultUserAgent, "veracode-tui/1.2.3", "veracode-tui/1.2.3", DefaultUserAgent}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("Expected User-Agents %q, got %q", want, got)
+	}
+}
+
+func TestSetTimeout_AppliesToSubsequentRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-id", testKeySecret)
+	if _, err := client.doRequestWithBaseURL(context.Background(), "GET", server.URL+"/test"); err != nil {
+		t.Fatalf("Expected no error with default timeout, got %v", err)
+	}
+
+	client.SetTimeout(10 * time.Millisecond)
+	if _, err := client.doRequestWithBaseURL(context.Background(), "GET", server.URL+"/test"); err == nil {
+		t.Error("Expected timeout error after lowering the timeout")
+	}
+}
+
+func TestSetContext_CancelsInFlightRequests(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	client := NewClient("test-id", testKeySecret)
+	client.baseURL = server.URL
+	client.SetContext(ctx)
+
+	time.AfterFunc(20*time.Millisecond, cancel)
+	start := time.Now()
+	if _, err := client.DoRequestWithQueryParams("GET", "/slow", nil); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected the request to be canceled, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected the canceled request to return promptly, took %v", elapsed)
+	}
+}
@@ -0,0 +1,41 @@
+package veracode
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// DecodeJSON decodes a response body into v. The API answers some requests with 204 No Content
+// or an empty 200, so an empty or all-whitespace body leaves v unchanged rather than failing.
+func DecodeJSON(body []byte, v any) error {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+	return json.Unmarshal(body, v)
+}
+
+// DecodeElements decodes each element of a JSON array on its own, so one malformed element does
+// not lose the rest. It returns the elements that decoded and how many were skipped. A missing
+// array (empty raw) decodes to no elements.
+func DecodeElements[T any](raw json.RawMessage) ([]T, int, error) {
+	if len(raw) == 0 {
+		return nil, 0, nil
+	}
+
+	var elements []json.RawMessage
+	if err := json.Unmarshal(raw, &elements); err != nil {
+		return nil, 0, err
+	}
+
+	items := make([]T, 0, len(elements))
+	skipped := 0
+	for _, element := range elements {
+		var item T
+		if err := json.Unmarshal(element, &item); err != nil {
+			skipped++
+			continue
+		}
+		items = append(items, item)
+	}
+	return items, skipped, nil
+}
@@ -0,0 +1,74 @@
+package veracode
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"strings"
+	"testing"
+)
+
+type decodeItem struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func TestDecodeElements_SkipsMalformed(t *testing.T) {
+	raw := json.RawMessage(`[{"id":1,"name":"one"},{"id":"two"},{"id":3,"name":["three"]},{"id":4}]`)
+
+	items, skipped, err := DecodeElements[decodeItem](raw)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if skipped != 2 {
+		t.Errorf("Expected 2 skipped elements, got %d", skipped)
+	}
+	if len(items) != 2 || items[0].ID != 1 || items[1].ID != 4 {
+		t.Errorf("Expected elements 1 and 4, got %+v", items)
+	}
+}
+
+func TestDecodeElements_MissingOrInvalidArray(t *testing.T) {
+	items, skipped, err := DecodeElements[decodeItem](nil)
+	if err != nil || items != nil || skipped != 0 {
+		t.Errorf("Expected no elements for a missing array, got %v, %d, %v", items, skipped, err)
+	}
+
+	if _, _, err := DecodeElements[decodeItem](json.RawMessage(`{"id":1}`)); err == nil {
+		t.Error("Expected an error when the value is not an array")
+	}
+}
+
+func TestWarnf_LogsThroughClient(t *testing.T) {
+	client := NewClient("id", "secret")
+	var logBuf bytes.Buffer
+	client.SetLogger(log.New(&logBuf, "", 0))
+
+	Warnf(client, "skipped %d", 2)
+	if got := logBuf.String(); !strings.Contains(got, "[WARN] skipped 2") {
+		t.Errorf("Expected a warning in the log, got %q", got)
+	}
+
+	// Clients that cannot log are ignored
+	Warnf(struct{}{}, "skipped %d", 2)
+}
+
+func TestDecodeJSON_EmptyBody(t *testing.T) {
+	for _, body := range []string{"", "  \r\n"} {
+		result := struct{ Name string }{Name: "unchanged"}
+		if err := DecodeJSON([]byte(body), &result); err != nil {
+			t.Errorf("Expected no error for body %q, got %v", body, err)
+		}
+		if result.Name != "unchanged" {
+			t.Errorf("Expected the result to be left as it was for body %q, got %q", body, result.Name)
+		}
+	}
+
+	var result struct{ Name string }
+	if err := DecodeJSON([]byte(`{"Name":"app"}`), &result); err != nil || result.Name != "app" {
+		t.Errorf("Expected the body to decode, got %q and %v", result.Name, err)
+	}
+	if err := DecodeJSON([]byte("not json"), &result); err == nil {
+		t.Error("Expected an error for a malformed body, got nil")
+	}
+}
@@ -0,0 +1,179 @@
+package veracode
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// APIError is a Veracode API failure decoded from the error envelope of an HTTPError
+type APIError struct {
+	StatusCode int    // HTTP status code
+	Code       string // Veracode error code, e.g. "NOT_FOUND"
+	Title      string // Short error title
+	Detail     string // Human readable explanation
+	RequestID  string // Request ID of the failed response, if the API sent one
+}
+
+func (e *APIError) Error() string {
+	prefix := httpStatusPrefix(e.StatusCode, e.RequestID)
+	switch {
+	case e.Title != "" && e.Detail != "":
+		return fmt.Sprintf("%s: %s: %s", prefix, e.Title, e.Detail)
+	case e.Detail != "":
+		return fmt.Sprintf("%s: %s", prefix, e.Detail)
+	case e.Title != "":
+		return fmt.Sprintf("%s: %s", prefix, e.Title)
+	default:
+		return prefix
+	}
+}
+
+// requestIDHeaders are the response headers checked, in order, for the ID the API assigned to a request
+var requestIDHeaders = []string{"X-Request-Id", "X-Veracode-Request-Id", "Request-Id", "X-Correlation-Id"}
+
+// responseRequestID returns the request ID from the response headers, or "" if there is none
+func responseRequestID(header http.Header) string {
+	for _, name := range requestIDHeaders {
+		if id := strings.TrimSpace(header.Get(name)); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// httpStatusPrefix formats a status code for error messages, e.g. "HTTP 500 (request-id: abc123)"
+func httpStatusPrefix(statusCode int, requestID string) string {
+	if requestID == "" {
+		return fmt.Sprintf("HTTP %d", statusCode)
+	}
+	return fmt.Sprintf("HTTP %d (request-id: %s)", statusCode, requestID)
+}
+
+// apiErrorEnvelope is the standard Veracode error response body
+type apiErrorEnvelope struct {
+	Embedded struct {
+		APIErrors []struct {
+			Code   string `json:"code,omitempty"`
+			Title  string `json:"title,omitempty"`
+			Detail string `json:"detail,omitempty"`
+		} `json:"api_errors,omitempty"`
+	} `json:"_embedded,omitempty"`
+}
+
+// AsAPIError extracts the first Veracode API error from err.
+// Returns false if err does not wrap an HTTPError. When the body has no error envelope,
+// the HTTP status text is used as the title.
+func AsAPIError(err error) (*APIError, bool) {
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) {
+		return nil, false
+	}
+
+	apiErr := &APIError{StatusCode: httpErr.StatusCode, RequestID: httpErr.RequestID}
+
+	var envelope apiErrorEnvelope
+	if json.Unmarshal(httpErr.Body, &envelope) == nil && len(envelope.Embedded.APIErrors) > 0 {
+		first := envelope.Embedded.APIErrors[0]
+		apiErr.Code = first.Code
+		apiErr.Title = first.Title
+		apiErr.Detail = first.Detail
+		return apiErr, true
+	}
+
+	apiErr.Title = strings.TrimSpace(strings.TrimPrefix(httpErr.Status, fmt.Sprintf("%d", httpErr.StatusCode)))
+	return apiErr, true
+}
+
+// statusCode returns the HTTP status code wrapped in err, or 0 if err is not an HTTPError
+func statusCode(err error) int {
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode
+	}
+	return 0
+}
+
+// IsNotFound reports whether err is a 404 Not Found response
+func IsNotFound(err error) bool {
+	return statusCode(err) == http.StatusNotFound
+}
+
+// IsUnauthorized reports whether err is a 401 Unauthorized response, usually due to invalid credentials
+func IsUnauthorized(err error) bool {
+	return statusCode(err) == http.StatusUnauthorized
+}
+
+// clockSkewMarkers are phrases in a 401 response body showing the request timestamp was rejected
+var clockSkewMarkers = []string{"timestamp", "clock", "time skew", "request has expired"}
+
+// IsClockSkew reports whether err is a 401 Unauthorized response rejecting the request's HMAC
+// timestamp, which happens when the local clock is too far from the API's
+func IsClockSkew(err error) bool {
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusUnauthorized {
+		return false
+	}
+	body := strings.ToLower(string(httpErr.Body))
+	for _, marker := range clockSkewMarkers {
+		if strings.Contains(body, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsForbidden reports whether err is a 403 Forbidden response, usually due to missing roles or permissions
+func IsForbidden(err error) bool {
+	return statusCode(err) == http.StatusForbidden
+}
+
+// IsRateLimited reports whether err is a 429 Too Many Requests response
+func IsRateLimited(err error) bool {
+	return statusCode(err) == http.StatusTooManyRequests
+}
+
+// UserMessage returns a short, friendly description of err suitable for display.
+// resource names what was being fetched, e.g. "Application", and is used for not-found errors.
+// API failures include the request ID when the response had one, to quote to Veracode support.
+func UserMessage(err error, resource string) string {
+	if err == nil {
+		return ""
+	}
+
+	if message := friendlyMessage(err, resource); message != "" {
+		var httpErr *HTTPError
+		if errors.As(err, &httpErr) && httpErr.RequestID != "" {
+			message += fmt.Sprintf(" (request-id: %s)", httpErr.RequestID)
+		}
+		return message
+	}
+
+	if apiErr, ok := AsAPIError(err); ok {
+		return apiErr.Error()
+	}
+	return err.Error()
+}
+
+// friendlyMessage describes the common API failures in plain words, or returns "" for others
+func friendlyMessage(err error, resource string) string {
+	switch {
+	case IsNotFound(err):
+		if resource == "" {
+			resource = "Resource"
+		}
+		return resource + " not found"
+	case IsClockSkew(err):
+		return "Authentication failed - check your system clock"
+	case IsUnauthorized(err):
+		return "Authentication failed - check your API credentials"
+	case IsForbidden(err):
+		return "Access denied - your API credentials lack the required permissions"
+	case IsRateLimited(err):
+		return "Rate limited by the Veracode API - wait a moment and try again"
+	default:
+		return ""
+	}
+}
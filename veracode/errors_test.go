@@ -0,0 +1,209 @@
+package veracode
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestErrorClassification(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		notFound     bool
+		unauthorized bool
+		forbidden    bool
+		rateLimited  bool
+	}{
+		{
+			name:     "not found",
+			err:      &HTTPError{StatusCode: 404, Status: "404 Not Found"},
+			notFound: true,
+		},
+		{
+			name:         "unauthorized",
+			err:          &HTTPError{StatusCode: 401, Status: "401 Unauthorized"},
+			unauthorized: true,
+		},
+		{
+			name:      "forbidden",
+			err:       &HTTPError{StatusCode: 403, Status: "403 Forbidden"},
+			forbidden: true,
+		},
+		{
+			name:        "rate limited",
+			err:         &HTTPError{StatusCode: 429, Status: "429 Too Many Requests"},
+			rateLimited: true,
+		},
+		{
+			name:     "wrapped not found",
+			err:      fmt.Errorf("%w (URL: https://api.veracode.com/x)", &HTTPError{StatusCode: 404}),
+			notFound: true,
+		},
+		{
+			name: "non-HTTP error",
+			err:  errors.New("connection refused"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNotFound(tt.err); got != tt.notFound {
+				t.Errorf("IsNotFound() = %v, want %v", got, tt.notFound)
+			}
+			if got := IsUnauthorized(tt.err); got != tt.unauthorized {
+				t.Errorf("IsUnauthorized() = %v, want %v", got, tt.unauthorized)
+			}
+			if got := IsForbidden(tt.err); got != tt.forbidden {
+				t.Errorf("IsForbidden() = %v, want %v", got, tt.forbidden)
+			}
+			if got := IsRateLimited(tt.err); got != tt.rateLimited {
+				t.Errorf("IsRateLimited() = %v, want %v", got, tt.rateLimited)
+			}
+		})
+	}
+}
+
+func TestAsAPIError_ParsesEnvelope(t *testing.T) {
+	body := `{
+		"_embedded": {
+			"api_errors": [
+				{
+					"id": "abc-123",
+					"code": "BAD_REQUEST",
+					"title": "Bad Request",
+					"detail": "Comment must not be empty",
+					"status": "400"
+				}
+			]
+		}
+	}`
+	err := fmt.Errorf("%w (URL: x)", &HTTPError{StatusCode: 400, Status: "400 Bad Request", Body: []byte(body)})
+
+	apiErr, ok := AsAPIError(err)
+	if !ok {
+		t.Fatal("Expected AsAPIError to find an HTTPError")
+	}
+	if apiErr.StatusCode != 400 || apiErr.Code != "BAD_REQUEST" {
+		t.Errorf("Unexpected status/code: %+v", apiErr)
+	}
+	if apiErr.Detail != "Comment must not be empty" {
+		t.Errorf("Expected detail from envelope, got %q", apiErr.Detail)
+	}
+	if apiErr.Error() != "HTTP 400: Bad Request: Comment must not be empty" {
+		t.Errorf("Unexpected message: %s", apiErr.Error())
+	}
+}
+
+func TestAsAPIError_FallsBackToStatus(t *testing.T) {
+	err := &HTTPError{StatusCode: 502, Status: "502 Bad Gateway", Body: []byte("<html>gateway</html>")}
+
+	apiErr, ok := AsAPIError(err)
+	if !ok {
+		t.Fatal("Expected AsAPIError to find an HTTPError")
+	}
+	if apiErr.Title != "Bad Gateway" {
+		t.Errorf("Expected status text as title, got %q", apiErr.Title)
+	}
+
+	if _, ok := AsAPIError(errors.New("timeout")); ok {
+		t.Error("Expected non-HTTP error not to convert")
+	}
+}
+
+func TestUserMessage(t *testing.T) {
+	tests := []struct {
+		err      error
+		resource string
+		want     string
+	}{
+		{&HTTPError{StatusCode: 404}, "Application", "Application not found"},
+		{&HTTPError{StatusCode: 404}, "", "Resource not found"},
+		{&HTTPError{StatusCode: 401}, "Application", "Authentication failed - check your API credentials"},
+		{&HTTPError{StatusCode: 401, Body: []byte(clockSkewBody)}, "Application", "Authentication failed - check your system clock"},
+		{
+			&HTTPError{StatusCode: 400, Body: []byte(`{"_embedded":{"api_errors":[{"detail":"Invalid size"}]}}`)},
+			"Findings",
+			"HTTP 400: Invalid size",
+		},
+		{errors.New("dial tcp: timeout"), "Application", "dial tcp: timeout"},
+		{nil, "Application", ""},
+	}
+
+	for _, tt := range tests {
+		if got := UserMessage(tt.err, tt.resource); got != tt.want {
+			t.Errorf("UserMessage(%v, %q) = %q, want %q", tt.err, tt.resource, got, tt.want)
+		}
+	}
+}
+
+// clockSkewBody is a 401 response rejecting a request timestamp outside the accepted window
+const clockSkewBody = `{"_embedded":{"api_errors":[{"code":"UNAUTHORIZED","title":"Unauthorized","detail":"Request timestamp is outside the accepted range"}]}}`
+
+func TestIsClockSkew(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"timestamp rejected", &HTTPError{StatusCode: 401, Body: []byte(clockSkewBody)}, true},
+		{"wrapped", fmt.Errorf("request failed: %w", &HTTPError{StatusCode: 401, Body: []byte("Clock skew too large")}), true},
+		{"bad credentials", &HTTPError{StatusCode: 401, Body: []byte(`{"detail":"Invalid credentials"}`)}, false},
+		{"not unauthorized", &HTTPError{StatusCode: 400, Body: []byte("invalid timestamp filter")}, false},
+		{"not an HTTP error", errors.New("timestamp"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsClockSkew(tt.err); got != tt.want {
+				t.Errorf("IsClockSkew() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHTTPError_RequestIDPropagates(t *testing.T) {
+	client := NewClient("test-id", testKeySecret)
+	client.SetHTTPTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		header := make(http.Header)
+		header.Set("X-Request-Id", "abc123")
+		return &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Status:     "500 Internal Server Error",
+			Body:       io.NopCloser(strings.NewReader(`{}`)),
+			Header:     header,
+			Request:    req,
+		}, nil
+	}))
+
+	_, err := client.DoRequestWithQueryParams("GET", "/test", nil)
+
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) {
+		t.Fatalf("Expected an HTTPError, got %v", err)
+	}
+	if httpErr.RequestID != "abc123" {
+		t.Errorf("Expected request ID abc123, got %q", httpErr.RequestID)
+	}
+	if got := err.Error(); !strings.HasPrefix(got, "HTTP 500 (request-id: abc123)") {
+		t.Errorf("Expected the request ID in the error, got %q", got)
+	}
+	if got := UserMessage(err, "Application"); got != "HTTP 500 (request-id: abc123): Internal Server Error" {
+		t.Errorf("Expected the request ID in the user message, got %q", got)
+	}
+}
+
+func TestUserMessage_RequestID(t *testing.T) {
+	err := &HTTPError{StatusCode: 403, RequestID: "req-9"}
+	want := "Access denied - your API credentials lack the required permissions (request-id: req-9)"
+	if got := UserMessage(err, "Application"); got != want {
+		t.Errorf("UserMessage() = %q, want %q", got, want)
+	}
+
+	if got := UserMessage(&HTTPError{StatusCode: 500}, ""); got != "HTTP 500" {
+		t.Errorf("Expected no request ID when the response had none, got %q", got)
+	}
+}
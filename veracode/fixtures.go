@@ -0,0 +1,165 @@
+package veracode
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// fixtureExt is the extension of fixture files
+const fixtureExt = ".json"
+
+// fixtureQuerySeparator separates the method from the query parameters in a fixture file name
+const fixtureQuerySeparator = "@"
+
+// FixtureClient serves saved JSON responses from a directory instead of calling the API, so the
+// TUI can run offline against recorded data. It satisfies the HTTPClient interfaces of the services.
+//
+// A response for METHOD /a/b?x=1 is read from dir/a/b/METHOD@x=1.json, where the part after the @
+// is the URL-encoded query. A file's query parameters need only be a subset of the request's, so
+// dir/a/b/GET.json answers every GET of /a/b and the fixture naming the most parameters wins.
+type FixtureClient struct {
+	dir string
+}
+
+// NewFixtureClient creates a client that replays the fixtures saved under dir
+func NewFixtureClient(dir string) *FixtureClient {
+	return &FixtureClient{dir: dir}
+}
+
+// DoRequestWithQueryParams returns the fixture matching the request, or a 404 HTTPError when
+// there is none
+func (c *FixtureClient) DoRequestWithQueryParams(method, urlPath string, params url.Values) ([]byte, error) {
+	return c.load(method, urlPath, params)
+}
+
+// DoRequestWithBody returns the fixture matching the request, ignoring the body, or a 404
+// HTTPError when there is none
+func (c *FixtureClient) DoRequestWithBody(method, urlPath string, _ []byte, params url.Values) ([]byte, error) {
+	return c.load(method, urlPath, params)
+}
+
+// HealthCheck reports whether the fixtures directory can be read
+func (c *FixtureClient) HealthCheck() error {
+	info, err := os.Stat(c.dir)
+	if err != nil {
+		return fmt.Errorf("fixtures directory: %w", err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("fixtures directory: %s is not a directory", c.dir)
+	}
+	return nil
+}
+
+// ClearCache does nothing, as fixtures are read from disk on every request
+func (c *FixtureClient) ClearCache() {}
+
+// load reads the best fixture for a request
+func (c *FixtureClient) load(method, urlPath string, params url.Values) ([]byte, error) {
+	file, ok := findFixture(fixtureDir(c.dir, urlPath), method, params)
+	if !ok {
+		return nil, &HTTPError{
+			StatusCode: http.StatusNotFound,
+			Status:     "404 Not Found",
+			Body:       []byte(fmt.Sprintf("no fixture for %s %s in %s", method, urlPath, c.dir)),
+		}
+	}
+	body, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fixture: %w", err)
+	}
+	return body, nil
+}
+
+// fixtureDir maps a URL path to the directory holding its fixtures. The path is cleaned first, so
+// it cannot climb out of dir.
+func fixtureDir(dir, urlPath string) string {
+	return filepath.Join(dir, filepath.FromSlash(strings.TrimPrefix(path.Clean("/"+urlPath), "/")))
+}
+
+// FixtureFileName is the name of the fixture file answering method with exactly params
+func FixtureFileName(method string, params url.Values) string {
+	name := strings.ToUpper(method)
+	if query := params.Encode(); query != "" {
+		name += fixtureQuerySeparator + query
+	}
+	return name + fixtureExt
+}
+
+// FixturePath is the path of the fixture file answering method on urlPath with exactly params
+func FixturePath(dir, method, urlPath string, params url.Values) string {
+	return filepath.Join(fixtureDir(dir, urlPath), FixtureFileName(method, params))
+}
+
+// findFixture returns the fixture in dir for method whose query parameters all appear in params,
+// preferring the one with the most parameters and then the first by name
+func findFixture(dir, method string, params url.Values) (string, bool) {
+	// ReadDir sorts the entries by name
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", false
+	}
+
+	best, bestCount := "", -1
+	for _, entry := range entries {
+		fixtureParams, ok := parseFixtureName(entry.Name(), method)
+		if entry.IsDir() || !ok || !containsParams(params, fixtureParams) {
+			continue
+		}
+		if count := paramCount(fixtureParams); count > bestCount {
+			best, bestCount = entry.Name(), count
+		}
+	}
+	if bestCount < 0 {
+		return "", false
+	}
+	return filepath.Join(dir, best), true
+}
+
+// parseFixtureName returns the query parameters named by a fixture file for method, or false
+// when the file is not a fixture for method
+func parseFixtureName(name, method string) (url.Values, bool) {
+	base, ok := strings.CutSuffix(name, fixtureExt)
+	if !ok {
+		return nil, false
+	}
+	prefix := strings.ToUpper(method)
+	if base == prefix {
+		return url.Values{}, true
+	}
+	query, ok := strings.CutPrefix(base, prefix+fixtureQuerySeparator)
+	if !ok {
+		return nil, false
+	}
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return nil, false
+	}
+	return values, true
+}
+
+// containsParams reports whether every value in want also appears in have
+func containsParams(have, want url.Values) bool {
+	for key, values := range want {
+		for _, value := range values {
+			if !slices.Contains(have[key], value) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// paramCount counts the values in params
+func paramCount(params url.Values) int {
+	count := 0
+	for _, values := range params {
+		count += len(values)
+	}
+	return count
+}
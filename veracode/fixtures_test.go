@@ -0,0 +1,133 @@
+package veracode
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFixturePath(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		urlPath string
+		params  url.Values
+		want    string
+	}{
+		{"no query", "GET", "/appsec/v1/applications", nil, "appsec/v1/applications/GET.json"},
+		{"lower case method", "get", "/appsec/v1/applications/guid", url.Values{}, "appsec/v1/applications/guid/GET.json"},
+		{"query sorted by key", "GET", "/appsec/v2/applications/guid/findings",
+			url.Values{"scan_type": {"STATIC"}, "context": {"sb"}}, "appsec/v2/applications/guid/findings/GET@context=sb&scan_type=STATIC.json"},
+		{"post", "POST", "/appsec/v2/applications/guid/annotations", nil, "appsec/v2/applications/guid/annotations/POST.json"},
+		{"cannot escape dir", "GET", "/../../etc/passwd", nil, "etc/passwd/GET.json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FixturePath("fixtures", tt.method, tt.urlPath, tt.params)
+			want := filepath.Join("fixtures", filepath.FromSlash(tt.want))
+			if got != want {
+				t.Errorf("FixturePath() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+// writeFixture saves body as the fixture answering method on urlPath with exactly params
+func writeFixture(t *testing.T, dir, method, urlPath string, params url.Values, body string) {
+	t.Helper()
+	file := FixturePath(dir, method, urlPath, params)
+	if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(file, []byte(body), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFixtureClient_PrefersMostSpecificFixture(t *testing.T) {
+	dir := t.TempDir()
+	const findingsPath = "/appsec/v2/applications/guid/findings"
+	writeFixture(t, dir, "GET", findingsPath, nil, `"any"`)
+	writeFixture(t, dir, "GET", findingsPath, url.Values{"scan_type": {"SCA"}}, `"sca"`)
+	writeFixture(t, dir, "GET", findingsPath, url.Values{"scan_type": {"SCA"}, "context": {"sb"}}, `"sandbox sca"`)
+	client := NewFixtureClient(dir)
+
+	tests := []struct {
+		params url.Values
+		want   string
+	}{
+		{url.Values{"scan_type": {"STATIC"}, "size": {"500"}}, `"any"`},
+		{url.Values{"scan_type": {"SCA"}, "size": {"500"}}, `"sca"`},
+		{url.Values{"scan_type": {"SCA"}, "context": {"sb"}, "size": {"500"}}, `"sandbox sca"`},
+	}
+	for _, tt := range tests {
+		body, err := client.DoRequestWithQueryParams("GET", findingsPath, tt.params)
+		if err != nil {
+			t.Fatalf("Expected a fixture for %v, got %v", tt.params, err)
+		}
+		if string(body) != tt.want {
+			t.Errorf("For %v expected %s, got %s", tt.params, tt.want, body)
+		}
+	}
+}
+
+func TestFixtureClient_MissingFixtureIs404(t *testing.T) {
+	dir := t.TempDir()
+	writeFixture(t, dir, "GET", "/appsec/v1/applications", url.Values{"name": {"shop"}}, `{}`)
+	client := NewFixtureClient(dir)
+
+	_, err := client.DoRequestWithQueryParams("GET", "/appsec/v1/applications", url.Values{"name": {"other"}})
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusNotFound {
+		t.Fatalf("Expected a 404 HTTPError, got %v", err)
+	}
+
+	if _, err := client.DoRequestWithBody("POST", "/appsec/v1/applications", []byte(`{}`), nil); err == nil {
+		t.Error("Expected no POST fixture to be found, got nil")
+	}
+}
+
+func TestFixtureClient_HealthCheck(t *testing.T) {
+	if err := NewFixtureClient(t.TempDir()).HealthCheck(); err != nil {
+		t.Errorf("Expected an existing directory to pass, got %v", err)
+	}
+	if err := NewFixtureClient(filepath.Join(t.TempDir(), "missing")).HealthCheck(); err == nil {
+		t.Error("Expected a missing directory to fail, got nil")
+	}
+}
+
+func TestDemoFixturesAreValidJSON(t *testing.T) {
+	dir := filepath.Join("..", "fixtures", "demo")
+	count := 0
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		body, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if !json.Valid(body) {
+			t.Errorf("%s is not valid JSON", path)
+		}
+		count++
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count == 0 {
+		t.Error("Expected demo fixtures to be present")
+	}
+
+	body, err := NewFixtureClient(dir).DoRequestWithQueryParams("GET", "/appsec/v1/applications", url.Values{"size": {"100"}})
+	if err != nil || len(body) == 0 {
+		t.Errorf("Expected the demo applications list to be served, got %v", err)
+	}
+}
@@ -0,0 +1,104 @@
+package veracode
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+// LogLevel controls which client events are written to the log. Each level includes the levels
+// before it, so LogLevelWarn also logs errors.
+type LogLevel int
+
+// Log levels, from least to most verbose
+const (
+	LogLevelError LogLevel = iota // Failed requests
+	LogLevelWarn                  // Error responses from the API, e.g. rate limiting by the server
+	LogLevelInfo                  // Client-side rate limit waits and cache events
+	LogLevelDebug                 // Full request and response dumps
+)
+
+// String returns the level name used in log lines and by ParseLogLevel
+func (l LogLevel) String() string {
+	switch l {
+	case LogLevelError:
+		return "ERROR"
+	case LogLevelWarn:
+		return "WARN"
+	case LogLevelInfo:
+		return "INFO"
+	case LogLevelDebug:
+		return "DEBUG"
+	default:
+		return fmt.Sprintf("LogLevel(%d)", int(l))
+	}
+}
+
+// ParseLogLevel parses a level name such as "warn" or "DEBUG"
+func ParseLogLevel(name string) (LogLevel, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "error":
+		return LogLevelError, nil
+	case "warn", "warning":
+		return LogLevelWarn, nil
+	case "info":
+		return LogLevelInfo, nil
+	case "debug":
+		return LogLevelDebug, nil
+	default:
+		return LogLevelError, fmt.Errorf("unknown log level %q (expected error, warn, info or debug)", name)
+	}
+}
+
+// SetLogger sets the logger client events are written to, at the level set by SetLogLevel
+// (LogLevelWarn by default). Passing nil disables logging. It is safe to call while requests
+// are in flight.
+func (c *Client) SetLogger(logger *log.Logger) {
+	c.logMu.Lock()
+	defer c.logMu.Unlock()
+	c.logger = logger
+}
+
+// SetLogLevel sets the most verbose level written to the logger. It is safe to call while
+// requests are in flight.
+func (c *Client) SetLogLevel(level LogLevel) {
+	c.logMu.Lock()
+	defer c.logMu.Unlock()
+	c.logLevel = level
+}
+
+// loggerFor returns the logger when events at level are being logged, or nil
+func (c *Client) loggerFor(level LogLevel) *log.Logger {
+	c.logMu.RLock()
+	defer c.logMu.RUnlock()
+	if c.logger == nil || level > c.logLevel {
+		return nil
+	}
+	return c.logger
+}
+
+// logf writes a line tagged with level when events at that level are being logged
+func (c *Client) logf(level LogLevel, format string, args ...interface{}) {
+	if logger := c.loggerFor(level); logger != nil {
+		logger.Printf("[%s] %s", level, fmt.Sprintf(format, args...))
+	}
+}
+
+// Logf writes a line tagged with level to the client's logger, when events at that level are
+// being logged
+func (c *Client) Logf(level LogLevel, format string, args ...interface{}) {
+	c.logf(level, format, args...)
+}
+
+// LevelLogger is implemented by clients that can log, such as *Client
+type LevelLogger interface {
+	Logf(level LogLevel, format string, args ...interface{})
+}
+
+// Warnf logs at LogLevelWarn through client when it implements LevelLogger. Services call it with
+// their HTTPClient, so test doubles need not log.
+func Warnf(client interface{}, format string, args ...interface{}) {
+	if logger, ok := client.(LevelLogger); ok {
+		logger.Logf(LogLevelWarn, format, args...)
+	}
+}
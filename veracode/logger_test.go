@@ -0,0 +1,146 @@
+package veracode
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestParseLogLevel(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    LogLevel
+		wantErr bool
+	}{
+		{"error", LogLevelError, false},
+		{"WARN", LogLevelWarn, false},
+		{"warning", LogLevelWarn, false},
+		{" info ", LogLevelInfo, false},
+		{"Debug", LogLevelDebug, false},
+		{"verbose", LogLevelError, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseLogLevel(tt.name)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseLogLevel(%q) error = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+		if got != tt.want {
+			t.Errorf("ParseLogLevel(%q) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestLogLevel_WarnOmitsBodies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+		_, _ = w.Write([]byte(`{"secret":"body"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("abcdef123456", testKeySecret)
+	var logBuf bytes.Buffer
+	client.SetLogger(log.New(&logBuf, "", 0))
+
+	if _, err := client.doRequestWithBaseURL(context.Background(), "GET", server.URL+"/ok"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := client.doRequestWithBaseURL(context.Background(), "GET", server.URL+"/missing"); err == nil {
+		t.Fatal("Expected an error for the 404 response")
+	}
+
+	logged := logBuf.String()
+	if strings.Contains(logged, `"secret"`) || strings.Contains(logged, "REQUEST") {
+		t.Errorf("Expected no request or response dumps at the default warn level, got %s", logged)
+	}
+	if !strings.Contains(logged, "[WARN]") || !strings.Contains(logged, "/missing: 404") {
+		t.Errorf("Expected a warning for the 404 response, got %s", logged)
+	}
+	if strings.Contains(logged, "/ok") {
+		t.Errorf("Expected successful requests to be omitted at warn level, got %s", logged)
+	}
+}
+
+func TestLogLevel_InfoLogsCacheAndRateLimitEvents(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	client := NewClient("abcdef123456", testKeySecret)
+	client.baseURL = server.URL
+	client.EnableCache(time.Minute)
+	// A burst of 1 at 2 requests per second makes the second uncached request wait about 500ms,
+	// well above the 50ms logging threshold even when the test runs slowly
+	client.SetRateLimit(2, 1)
+	var logBuf bytes.Buffer
+	client.SetLogger(log.New(&logBuf, "", 0))
+	client.SetLogLevel(LogLevelInfo)
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.DoRequestWithQueryParams("GET", "/applications/app-guid", nil); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	if _, err := client.DoRequestWithQueryParams("GET", "/applications/other-guid", nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	logged := logBuf.String()
+	if !strings.Contains(logged, "[INFO] Cache hit: GET") {
+		t.Errorf("Expected the cache hit to be logged, got %s", logged)
+	}
+	if !strings.Contains(logged, "[INFO] Rate limit: waited") {
+		t.Errorf("Expected the rate limit wait to be logged, got %s", logged)
+	}
+	if strings.Contains(logged, "[DEBUG]") {
+		t.Errorf("Expected no debug output at info level, got %s", logged)
+	}
+}
+
+func TestSetLogLevel_SafeDuringRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	client := NewClient("abcdef123456", testKeySecret)
+	var logBuf syncBuffer
+	client.SetLogger(log.New(&logBuf, "", 0))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 5; j++ {
+				_, _ = client.doRequestWithBaseURL(context.Background(), "GET", server.URL+"/test")
+			}
+		}()
+	}
+	for _, level := range []LogLevel{LogLevelDebug, LogLevelInfo, LogLevelError, LogLevelDebug} {
+		client.SetLogLevel(level)
+		client.SetDebugRedaction(level != LogLevelDebug)
+	}
+	wg.Wait()
+}
+
+// syncBuffer is a bytes.Buffer safe for concurrent writes
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
@@ -0,0 +1,107 @@
+package veracode
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token-bucket limiter, safe for concurrent use
+type rateLimiter struct {
+	mu       sync.Mutex
+	rate     float64 // tokens added per second
+	burst    float64 // maximum tokens held
+	tokens   float64
+	lastFill time.Time
+}
+
+func newRateLimiter(requestsPerSecond, burst int) *rateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		rate:     float64(requestsPerSecond),
+		burst:    float64(burst),
+		tokens:   float64(burst),
+		lastFill: time.Now(),
+	}
+}
+
+// reserve takes a token and returns how long the caller must wait before using it
+func (r *rateLimiter) reserve() time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	r.tokens += now.Sub(r.lastFill).Seconds() * r.rate
+	if r.tokens > r.burst {
+		r.tokens = r.burst
+	}
+	r.lastFill = now
+
+	// Tokens may go negative; the deficit is the queue of callers already waiting
+	r.tokens--
+	if r.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-r.tokens / r.rate * float64(time.Second))
+}
+
+// cancel returns a token reserved by a caller that gave up waiting
+func (r *rateLimiter) cancel() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tokens++
+	if r.tokens > r.burst {
+		r.tokens = r.burst
+	}
+}
+
+// wait blocks until a request may proceed or ctx is done
+func (r *rateLimiter) wait(ctx context.Context) error {
+	delay := r.reserve()
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		r.cancel()
+		return ctx.Err()
+	}
+}
+
+// rateLimitLogThreshold is the shortest rate limit wait worth logging
+const rateLimitLogThreshold = 50 * time.Millisecond
+
+// SetRateLimit limits outgoing requests to requestsPerSecond, allowing bursts of up to burst requests.
+// A requestsPerSecond of zero or less removes the limit.
+func (c *Client) SetRateLimit(requestsPerSecond int, burst int) {
+	if requestsPerSecond <= 0 {
+		c.limiter = nil
+		return
+	}
+	c.limiter = newRateLimiter(requestsPerSecond, burst)
+}
+
+// waitForRateLimit blocks until the rate limiter allows the request to be sent
+func (c *Client) waitForRateLimit(ctx context.Context) error {
+	if c.limiter == nil {
+		return nil
+	}
+	start := time.Now()
+	if err := c.limiter.wait(ctx); err != nil {
+		c.logf(LogLevelWarn, "Rate limit wait cancelled after %s: %v", time.Since(start).Round(time.Millisecond), err)
+		return fmt.Errorf("rate limit wait cancelled: %w", err)
+	}
+	if waited := time.Since(start); waited >= rateLimitLogThreshold {
+		c.logf(LogLevelInfo, "Rate limit: waited %s before sending request", waited.Round(time.Millisecond))
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package veracode
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// testKeySecret is a hex-encoded secret accepted by GenerateAuthHeader
+const testKeySecret = "0123456789abcdef0123456789abcdef"
+
+func TestClient_SetRateLimit_ThrottlesRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-id", testKeySecret)
+	client.SetRateLimit(20, 1)
+
+	// With a burst of 1, each request after the first waits 1/20s
+	const requests = 5
+	minimum := time.Duration(requests-1) * time.Second / 20
+
+	start := time.Now()
+	for i := 0; i < requests; i++ {
+		if _, err := client.doRequestWithBaseURL(context.Background(), "GET", server.URL+"/test"); err != nil {
+			t.Fatalf("Request %d failed: %v", i, err)
+		}
+	}
+	elapsed := time.Since(start)
+
+	if elapsed < minimum {
+		t.Errorf("Expected %d requests to take at least %v, took %v", requests, minimum, elapsed)
+	}
+}
+
+func TestClient_SetRateLimit_DisabledByDefault(t *testing.T) {
+	client := NewClient("test-id", testKeySecret)
+	if client.limiter != nil {
+		t.Error("Expected no rate limiter by default")
+	}
+
+	client.SetRateLimit(10, 5)
+	if client.limiter == nil {
+		t.Fatal("Expected rate limiter after SetRateLimit")
+	}
+
+	client.SetRateLimit(0, 0)
+	if client.limiter != nil {
+		t.Error("Expected rate limiter to be removed with zero rate")
+	}
+}
+
+func TestRateLimiter_WaitHonorsContext(t *testing.T) {
+	limiter := newRateLimiter(1, 1)
+
+	if err := limiter.wait(context.Background()); err != nil {
+		t.Fatalf("Expected first wait to succeed immediately, got %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if err := limiter.wait(ctx); err == nil {
+		t.Fatal("Expected wait to fail when context is cancelled")
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Expected cancelled wait to return promptly, took %v", elapsed)
+	}
+}
+
+func TestClient_RateLimitWaitHonorsContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-id", testKeySecret)
+	client.baseURL = server.URL
+	client.SetRateLimit(1, 1)
+	if _, err := client.DoRequestWithQueryParams("GET", "/first", nil); err != nil {
+		t.Fatalf("Expected the first request to succeed, got %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	client.SetContext(ctx)
+
+	start := time.Now()
+	if _, err := client.DoRequestWithQueryParams("GET", "/second", nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected the rate limit wait to end with the context, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Expected the cancelled wait to return promptly, took %v", elapsed)
+	}
+}
+
+// timingAuthenticator records when requests are signed
+type timingAuthenticator struct {
+	signed []time.Time
+}
+
+func (a *timingAuthenticator) Authorize(req *http.Request) error {
+	a.signed = append(a.signed, time.Now())
+	return nil
+}
+
+func TestClient_SignsAfterRateLimitWait(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	auth := &timingAuthenticator{}
+	client := NewClient("test-id", testKeySecret)
+	client.auth = auth
+	client.SetRateLimit(5, 1)
+
+	start := time.Now()
+	for i := 0; i < 2; i++ {
+		if _, err := client.doRequestWithBaseURL(context.Background(), "GET", server.URL+"/test"); err != nil {
+			t.Fatalf("Request %d failed: %v", i, err)
+		}
+	}
+
+	// The second request waits 1/5s for a token, and must be signed after that wait
+	if len(auth.signed) != 2 {
+		t.Fatalf("Expected 2 signed requests, got %d", len(auth.signed))
+	}
+	if waited := auth.signed[1].Sub(start); waited < 150*time.Millisecond {
+		t.Errorf("Expected the second request to be signed after the rate limit wait, signed after %v", waited)
+	}
+}
@@ -0,0 +1,112 @@
+package veracode
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// redactedFixtureValue replaces sensitive values in recorded fixtures
+const redactedFixtureValue = "[REDACTED]"
+
+// secretFixtureFields are the response fields whose values are never written to a fixture
+var secretFixtureFields = map[string]bool{
+	"api_secret":    true,
+	"api_key":       true,
+	"token":         true,
+	"access_token":  true,
+	"refresh_token": true,
+	"password":      true,
+}
+
+// RecordingClient wraps a Client, saving each successful response as a fixture under a directory
+// so a session can be replayed later with a FixtureClient or attached to a bug report. Failures
+// to save are logged as warnings and do not affect the request.
+type RecordingClient struct {
+	*Client
+	dir string
+}
+
+// NewRecordingClient creates a client that makes requests through inner and records the responses
+// under dir, at the paths FixturePath gives them
+func NewRecordingClient(inner *Client, dir string) *RecordingClient {
+	return &RecordingClient{Client: inner, dir: dir}
+}
+
+// DoRequestWithQueryParams performs the request through the wrapped client and records a
+// successful response
+func (c *RecordingClient) DoRequestWithQueryParams(method, urlPath string, params url.Values) ([]byte, error) {
+	body, err := c.Client.DoRequestWithQueryParams(method, urlPath, params)
+	if err == nil {
+		c.record(method, urlPath, params, body)
+	}
+	return body, err
+}
+
+// DoRequestWithBody performs the request through the wrapped client and records a successful
+// response. The request body is not recorded.
+func (c *RecordingClient) DoRequestWithBody(method, urlPath string, body []byte, params url.Values) ([]byte, error) {
+	respBody, err := c.Client.DoRequestWithBody(method, urlPath, body, params)
+	if err == nil {
+		c.record(method, urlPath, params, respBody)
+	}
+	return respBody, err
+}
+
+// record writes a redacted copy of body to the fixture for the request
+func (c *RecordingClient) record(method, urlPath string, params url.Values, body []byte) {
+	file := FixturePath(c.dir, method, urlPath, params)
+	if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+		c.logf(LogLevelWarn, "Could not record %s %s: %v", method, urlPath, err)
+		return
+	}
+	if err := os.WriteFile(file, redactFixture(body), 0o600); err != nil {
+		c.logf(LogLevelWarn, "Could not record %s %s: %v", method, urlPath, err)
+		return
+	}
+	c.logf(LogLevelInfo, "Recorded %s %s to %s", method, urlPath, file)
+}
+
+// redactFixture removes secrets from a JSON response body and masks API key IDs, returning bodies
+// that are not JSON unchanged
+func redactFixture(body []byte) []byte {
+	var value any
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return body
+	}
+
+	redacted, err := json.MarshalIndent(redactFixtureValue(value), "", "  ")
+	if err != nil {
+		return body
+	}
+	return append(redacted, '\n')
+}
+
+// redactFixtureValue redacts the secret fields of a decoded JSON value, at any depth
+func redactFixtureValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			switch {
+			case secretFixtureFields[strings.ToLower(key)]:
+				v[key] = redactedFixtureValue
+			case strings.EqualFold(key, "api_id"):
+				if id, ok := field.(string); ok {
+					v[key] = maskKeyID(id)
+				}
+			default:
+				v[key] = redactFixtureValue(field)
+			}
+		}
+	case []any:
+		for i := range v {
+			v[i] = redactFixtureValue(v[i])
+		}
+	}
+	return value
+}
@@ -0,0 +1,92 @@
+package veracode
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// newFakeResponseClient returns a client whose requests are answered with body, or status when it
+// is not 200
+func newFakeResponseClient(status int, body string) *Client {
+	client := NewClient("test-id", testKeySecret)
+	client.SetHTTPTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: status,
+			Status:     http.StatusText(status),
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     make(http.Header),
+			Request:    req,
+		}, nil
+	}))
+	return client
+}
+
+func TestRecordingClient_WritesDeterministicFixtures(t *testing.T) {
+	dir := t.TempDir()
+	client := NewRecordingClient(newFakeResponseClient(http.StatusOK, `{"guid":"app-guid"}`), dir)
+
+	params := url.Values{"size": {"500"}, "scan_type": {"STATIC"}}
+	if _, err := client.DoRequestWithQueryParams("GET", "/appsec/v2/applications/app-guid/findings", params); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	want := filepath.Join(dir, "appsec", "v2", "applications", "app-guid", "findings", "GET@scan_type=STATIC&size=500.json")
+	body, err := os.ReadFile(want)
+	if err != nil {
+		t.Fatalf("Expected fixture at %s: %v", want, err)
+	}
+	if !strings.Contains(string(body), `"guid": "app-guid"`) {
+		t.Errorf("Expected the response in the fixture, got %s", body)
+	}
+
+	// The recorded fixture replays the same request
+	replayed, err := NewFixtureClient(dir).DoRequestWithQueryParams("GET", "/appsec/v2/applications/app-guid/findings", params)
+	if err != nil || string(replayed) != string(body) {
+		t.Errorf("Expected the fixture to replay, got %s, %v", replayed, err)
+	}
+}
+
+func TestRecordingClient_RedactsSecrets(t *testing.T) {
+	dir := t.TempDir()
+	client := NewRecordingClient(newFakeResponseClient(http.StatusOK,
+		`{"api_id":"abcdef123456","api_secret":"very-secret","nested":[{"token":"bearer-token"}]}`), dir)
+
+	if _, err := client.DoRequestWithQueryParams("GET", "/api/authn/v2/api_credentials", nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	body, err := os.ReadFile(FixturePath(dir, "GET", "/api/authn/v2/api_credentials", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorded := string(body)
+	for _, secret := range []string{"very-secret", "bearer-token", "abcdef123456"} {
+		if strings.Contains(recorded, secret) {
+			t.Errorf("Expected %q to be redacted, got %s", secret, recorded)
+		}
+	}
+	if !strings.Contains(recorded, "********3456") {
+		t.Errorf("Expected the masked key ID, got %s", recorded)
+	}
+}
+
+func TestRecordingClient_SkipsFailedResponses(t *testing.T) {
+	dir := t.TempDir()
+	client := NewRecordingClient(newFakeResponseClient(http.StatusNotFound, `not found`), dir)
+
+	if _, err := client.DoRequestWithQueryParams("GET", "/appsec/v1/applications/missing", nil); err == nil {
+		t.Fatal("Expected an error, got nil")
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("Expected nothing to be recorded, got %d entries", len(entries))
+	}
+}
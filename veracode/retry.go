@@ -0,0 +1,138 @@
+package veracode
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Suggested retry policy for SetRetries. Clients do not retry until SetRetries is called.
+const (
+	DefaultRetryAttempts = 3           // Attempts per GET request, counting the first
+	DefaultRetryBackoff  = time.Second // Wait before the first retry; each later retry waits one step longer
+)
+
+// maxRetryAfter is the longest Retry-After that is waited for; the error is returned instead of
+// waiting any longer
+const maxRetryAfter = time.Minute
+
+// RetryNotifyFunc is called before a request is retried, with the attempt about to be made
+// (2 for the first retry), the total number of attempts allowed and the error that caused the retry
+type RetryNotifyFunc func(attempt, max int, err error)
+
+// retryableStatus reports whether a response status is likely to succeed if the request is repeated
+func retryableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// isRetryable reports whether a failed request should be tried again: network failures such as
+// timeouts, resets and truncated responses, and statuses signalling the API is temporarily
+// unavailable. Failures that would recur, such as signing errors, and canceled requests are not.
+// A request timing out is retried; doWithRetries stops once the caller's context is done.
+func isRetryable(err error) bool {
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return retryableStatus(httpErr.StatusCode)
+	}
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// parseRetryAfter returns the wait requested by a Retry-After header, given in seconds or as an
+// HTTP date, or 0 if the header is missing or invalid
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0)
+	}
+	if when, err := http.ParseTime(value); err == nil {
+		return max(when.Sub(now), 0)
+	}
+	return 0
+}
+
+// retryDelay returns how long to wait before the retry following attempt: the backoff for the
+// attempt, or longer if the API asked for it with Retry-After. ok is false if the API asked for
+// more than maxRetryAfter.
+func retryDelay(err error, attempt int, backoff time.Duration) (delay time.Duration, ok bool) {
+	delay = backoff * time.Duration(attempt)
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) && httpErr.RetryAfter > delay {
+		if httpErr.RetryAfter > maxRetryAfter {
+			return 0, false
+		}
+		delay = httpErr.RetryAfter
+	}
+	return delay, true
+}
+
+// SetRetries sets how many times a GET request is tried before its error is returned, counting the
+// first attempt, and the wait before the first retry. Later retries wait backoff times the attempts
+// made so far, or as long as a response's Retry-After asks, up to a minute. Other methods are
+// never retried, as they may not be safe to repeat. An attempts of 1 or less disables retries.
+func (c *Client) SetRetries(attempts int, backoff time.Duration) {
+	c.retryMu.Lock()
+	defer c.retryMu.Unlock()
+	c.retryAttempts = attempts
+	c.retryBackoff = backoff
+}
+
+// SetRetryNotify sets fn to be called before each retry. It is called on the goroutine making the
+// request, so it must not block. Each request counts its attempts afresh. Passing nil removes it.
+func (c *Client) SetRetryNotify(fn RetryNotifyFunc) {
+	c.retryMu.Lock()
+	defer c.retryMu.Unlock()
+	c.retryNotify = fn
+}
+
+// retrySettings returns the retry policy for a request with the given method
+func (c *Client) retrySettings(method string) (attempts int, backoff time.Duration, notify RetryNotifyFunc) {
+	c.retryMu.RLock()
+	defer c.retryMu.RUnlock()
+	if method != http.MethodGet || c.retryAttempts < 1 {
+		return 1, 0, nil
+	}
+	return c.retryAttempts, c.retryBackoff, c.retryNotify
+}
+
+// doWithRetries calls do until it succeeds, fails with an error that is not retryable or runs out
+// of attempts, returning the last result. Waiting between attempts ends early if ctx is done.
+func (c *Client) doWithRetries(ctx context.Context, method, fullURL string, do func() ([]byte, error)) ([]byte, error) {
+	attempts, backoff, notify := c.retrySettings(method)
+	for attempt := 1; ; attempt++ {
+		body, err := do()
+		if err == nil || attempt >= attempts || !isRetryable(err) || ctx.Err() != nil {
+			return body, err
+		}
+		delay, ok := retryDelay(err, attempt, backoff)
+		if !ok {
+			return body, err
+		}
+
+		c.logf(LogLevelWarn, "%s %s: retrying (attempt %d/%d) in %s after: %v", method, fullURL, attempt+1, attempts, delay, err)
+		if notify != nil {
+			notify(attempt+1, attempts, err)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+	}
+}
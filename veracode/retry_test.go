@@ -0,0 +1,322 @@
+package veracode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// failingServer fails the first failures requests with status, then succeeds
+func failingServer(t *testing.T, failures int32, status int) (*httptest.Server, *int32) {
+	t.Helper()
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) <= failures {
+			w.WriteHeader(status)
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+type retryCall struct {
+	attempt, max int
+}
+
+func TestRetries_NotifiesPerRetry(t *testing.T) {
+	server, requests := failingServer(t, 2, http.StatusServiceUnavailable)
+	client := NewClient("test-id", testKeySecret)
+	client.baseURL = server.URL
+	client.SetRetries(3, 0)
+
+	var calls []retryCall
+	client.SetRetryNotify(func(attempt, max int, err error) {
+		var httpErr *HTTPError
+		if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusServiceUnavailable {
+			t.Errorf("Expected the 503 that caused the retry, got %v", err)
+		}
+		calls = append(calls, retryCall{attempt, max})
+	})
+
+	body, err := client.DoRequestWithQueryParams(http.MethodGet, "/test", nil)
+	if err != nil {
+		t.Fatalf("Expected the third attempt to succeed, got %v", err)
+	}
+	if string(body) != `{"ok":true}` {
+		t.Errorf("Expected the successful body, got %s", body)
+	}
+	if *requests != 3 {
+		t.Errorf("Expected 3 requests, got %d", *requests)
+	}
+	want := []retryCall{{2, 3}, {3, 3}}
+	if len(calls) != len(want) || calls[0] != want[0] || calls[1] != want[1] {
+		t.Errorf("Expected notifications %v, got %v", want, calls)
+	}
+
+	// The next request counts its attempts afresh and, succeeding first time, is not reported
+	calls = nil
+	if _, err := client.DoRequestWithQueryParams(http.MethodGet, "/again", nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(calls) != 0 {
+		t.Errorf("Expected no notifications for a request that succeeded, got %v", calls)
+	}
+}
+
+func TestRetries_GivesUpAfterMaxAttempts(t *testing.T) {
+	server, requests := failingServer(t, 5, http.StatusBadGateway)
+	client := NewClient("test-id", testKeySecret)
+	client.baseURL = server.URL
+	client.SetRetries(2, 0)
+
+	var notified int
+	client.SetRetryNotify(func(attempt, max int, err error) { notified++ })
+
+	var httpErr *HTTPError
+	if _, err := client.DoRequestWithQueryParams(http.MethodGet, "/test", nil); !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusBadGateway {
+		t.Fatalf("Expected the last 502 to be returned, got %v", err)
+	}
+	if *requests != 2 || notified != 1 {
+		t.Errorf("Expected 2 requests and 1 notification, got %d and %d", *requests, notified)
+	}
+}
+
+func TestRetries_SkipsPermanentErrorsAndOtherMethods(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		status int
+	}{
+		{"not found", http.MethodGet, http.StatusNotFound},
+		{"unauthorized", http.MethodGet, http.StatusUnauthorized},
+		{"delete", http.MethodDelete, http.StatusServiceUnavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, requests := failingServer(t, 1, tt.status)
+			client := NewClient("test-id", testKeySecret)
+			client.baseURL = server.URL
+			client.SetRetries(3, 0)
+			client.SetRetryNotify(func(attempt, max int, err error) {
+				t.Errorf("Expected no retry, got attempt %d/%d", attempt, max)
+			})
+
+			if _, err := client.DoRequestWithQueryParams(tt.method, "/test", nil); err == nil {
+				t.Fatal("Expected the error to be returned")
+			}
+			if *requests != 1 {
+				t.Errorf("Expected 1 request, got %d", *requests)
+			}
+		})
+	}
+}
+
+func TestRetries_DisabledByDefault(t *testing.T) {
+	server, requests := failingServer(t, 1, http.StatusServiceUnavailable)
+	client := NewClient("test-id", testKeySecret)
+	client.baseURL = server.URL
+
+	if _, err := client.DoRequestWithQueryParams(http.MethodGet, "/test", nil); err == nil {
+		t.Fatal("Expected the 503 to be returned")
+	}
+	if *requests != 1 {
+		t.Errorf("Expected 1 request, got %d", *requests)
+	}
+}
+
+// failingAuthenticator fails to sign every request
+type failingAuthenticator struct{}
+
+func (failingAuthenticator) Authorize(req *http.Request) error {
+	return errors.New("no credentials")
+}
+
+func TestRetries_SkipsErrorsThatWouldRecur(t *testing.T) {
+	server, requests := failingServer(t, 0, http.StatusOK)
+	client := NewClient("test-id", testKeySecret)
+	client.auth = failingAuthenticator{}
+	client.baseURL = server.URL
+	client.SetRetries(3, 0)
+	client.SetRetryNotify(func(attempt, max int, err error) {
+		t.Errorf("Expected no retry of a signing error, got attempt %d/%d", attempt, max)
+	})
+
+	if _, err := client.DoRequestWithQueryParams(http.MethodGet, "/test", nil); err == nil {
+		t.Fatal("Expected the signing error to be returned")
+	}
+	if *requests != 0 {
+		t.Errorf("Expected no requests to be sent, got %d", *requests)
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"service unavailable", &HTTPError{StatusCode: http.StatusServiceUnavailable}, true},
+		{"too many requests", &HTTPError{StatusCode: http.StatusTooManyRequests}, true},
+		{"bad request", &HTTPError{StatusCode: http.StatusBadRequest}, false},
+		{"truncated response", fmt.Errorf("failed to read response body: %w", io.ErrUnexpectedEOF), true},
+		{"canceled", fmt.Errorf("request failed: %w", context.Canceled), false},
+		{"timed out", fmt.Errorf("request failed: %w", context.DeadlineExceeded), true},
+		{"other", errors.New("failed to create request"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetryable(tt.err); got != tt.want {
+				t.Errorf("isRetryable(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetries_RetriesNetworkErrors(t *testing.T) {
+	server, _ := failingServer(t, 0, http.StatusOK)
+	url := server.URL
+	server.Close()
+
+	client := NewClient("test-id", testKeySecret)
+	client.baseURL = url
+	client.SetRetries(2, 0)
+	var notified int
+	client.SetRetryNotify(func(attempt, max int, err error) { notified++ })
+
+	if _, err := client.DoRequestWithQueryParams(http.MethodGet, "/test", nil); err == nil {
+		t.Fatal("Expected the connection error to be returned")
+	}
+	if notified != 1 {
+		t.Errorf("Expected the refused connection to be retried once, got %d notifications", notified)
+	}
+}
+
+func TestRetries_RetriesTimeouts(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			time.Sleep(500 * time.Millisecond)
+		}
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-id", testKeySecret)
+	client.baseURL = server.URL
+	client.SetTimeout(100 * time.Millisecond)
+	client.SetRetries(2, 0)
+	var notified int
+	client.SetRetryNotify(func(attempt, max int, err error) { notified++ })
+
+	body, err := client.DoRequestWithQueryParams(http.MethodGet, "/test", nil)
+	if err != nil {
+		t.Fatalf("Expected the retry after the timeout to succeed, got %v", err)
+	}
+	if string(body) != `{"ok":true}` {
+		t.Errorf("Expected the successful body, got %s", body)
+	}
+	if got := atomic.LoadInt32(&requests); notified != 1 || got != 2 {
+		t.Errorf("Expected the timed out request to be retried once, got %d notifications and %d requests", notified, got)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{"", 0},
+		{"2", 2 * time.Second},
+		{"-1", 0},
+		{now.Add(30 * time.Second).Format(http.TimeFormat), 30 * time.Second},
+		{now.Add(-time.Minute).Format(http.TimeFormat), 0},
+		{"soon", 0},
+	}
+	for _, tt := range tests {
+		if got := parseRetryAfter(tt.value, now); got != tt.want {
+			t.Errorf("parseRetryAfter(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestRetries_HonorsRetryAfter(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-id", testKeySecret)
+	client.baseURL = server.URL
+	client.SetRetries(2, 0)
+
+	start := time.Now()
+	if _, err := client.DoRequestWithQueryParams(http.MethodGet, "/test", nil); err != nil {
+		t.Fatalf("Expected the retry to succeed, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("Expected the retry to wait the 1s Retry-After, waited %v", elapsed)
+	}
+}
+
+func TestRetries_GivesUpOnLongRetryAfter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-id", testKeySecret)
+	client.baseURL = server.URL
+	client.SetRetries(3, 0)
+	client.SetRetryNotify(func(attempt, max int, err error) {
+		t.Errorf("Expected no retry after an hour-long Retry-After, got attempt %d/%d", attempt, max)
+	})
+
+	var httpErr *HTTPError
+	if _, err := client.DoRequestWithQueryParams(http.MethodGet, "/test", nil); !errors.As(err, &httpErr) || httpErr.RetryAfter != time.Hour {
+		t.Fatalf("Expected the 429 with its Retry-After to be returned, got %v", err)
+	}
+}
+
+func TestRetries_BackoffEndsWhenContextIsDone(t *testing.T) {
+	server, requests := failingServer(t, 5, http.StatusServiceUnavailable)
+	client := NewClient("test-id", testKeySecret)
+	client.baseURL = server.URL
+	client.SetRetries(3, time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	client.SetRetryNotify(func(attempt, max int, err error) { cancel() })
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := client.DoRequestWithQueryParamsContext(ctx, http.MethodGet, "/test", nil)
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected the backoff to end when the context was canceled")
+	}
+	if *requests != 1 {
+		t.Errorf("Expected 1 request, got %d", *requests)
+	}
+}
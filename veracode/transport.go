@@ -0,0 +1,63 @@
+package veracode
+
+import (
+	"net/http"
+	"time"
+)
+
+// TransportOptions tunes the connection pool of a client's default transport. Every service shares
+// one client, so background prefetching, context counts and scan watches all draw on this pool.
+//
+// The pool and the rate limiter (SetRateLimit) are independent: the limiter decides when a request
+// may start and the pool decides whether it gets a connection straight away. Requests admitted by
+// the limiter beyond MaxConnsPerHost wait for a free connection, having already used their token,
+// so keep MaxConnsPerHost at or above the burst allowed by the limiter.
+type TransportOptions struct {
+	MaxIdleConns        int           // Idle connections kept across all hosts; 0 means no limit
+	MaxIdleConnsPerHost int           // Idle connections kept per host, ready for the next request
+	MaxConnsPerHost     int           // Connections per host, in use or idle; 0 means no limit
+	IdleConnTimeout     time.Duration // How long an unused connection is kept open; 0 means forever
+}
+
+// DefaultTransportOptions returns the pool settings clients start with. The standard library keeps
+// only 2 idle connections per host, so concurrent workers would keep opening new TLS connections
+// to the API; these keep enough warm for the background workers, which run 4 at a time.
+func DefaultTransportOptions() TransportOptions {
+	return TransportOptions{
+		MaxIdleConns:        32,
+		MaxIdleConnsPerHost: 16,
+		MaxConnsPerHost:     16,
+		IdleConnTimeout:     90 * time.Second,
+	}
+}
+
+// newTransport returns a transport with the given pool settings that honours the HTTP_PROXY,
+// HTTPS_PROXY and NO_PROXY environment variables
+func newTransport(opts TransportOptions) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyFromEnvironment
+	transport.MaxIdleConns = opts.MaxIdleConns
+	transport.MaxIdleConnsPerHost = opts.MaxIdleConnsPerHost
+	transport.MaxConnsPerHost = opts.MaxConnsPerHost
+	transport.IdleConnTimeout = opts.IdleConnTimeout
+	return transport
+}
+
+// SetTransportOptions replaces the transport with a default transport using the given pool
+// settings, including one set with SetHTTPTransport. Connections pooled by the previous transport
+// are not reused.
+func (c *Client) SetTransportOptions(opts TransportOptions) {
+	c.transportOpts = opts
+	c.httpClient.Transport = newTransport(opts)
+}
+
+// SetMaxConnsPerHost limits the connections open to the API at once, in use or idle, keeping the
+// other pool settings. Zero removes the limit. Idle connections kept per host are capped to match.
+func (c *Client) SetMaxConnsPerHost(n int) {
+	opts := c.transportOpts
+	opts.MaxConnsPerHost = n
+	if n > 0 && opts.MaxIdleConnsPerHost > n {
+		opts.MaxIdleConnsPerHost = n
+	}
+	c.SetTransportOptions(opts)
+}
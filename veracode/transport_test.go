@@ -0,0 +1,73 @@
+package veracode
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+// clientTransport returns the client's transport, failing if it is not a default transport
+func clientTransport(t *testing.T, client *Client) *http.Transport {
+	t.Helper()
+	transport, ok := client.httpClient.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("Expected an *http.Transport, got %T", client.httpClient.Transport)
+	}
+	return transport
+}
+
+func TestNewClient_TunesConnectionPool(t *testing.T) {
+	transport := clientTransport(t, NewClient("test-id", testKeySecret))
+	want := DefaultTransportOptions()
+
+	if transport.MaxIdleConns != want.MaxIdleConns || transport.MaxIdleConnsPerHost != want.MaxIdleConnsPerHost ||
+		transport.MaxConnsPerHost != want.MaxConnsPerHost || transport.IdleConnTimeout != want.IdleConnTimeout {
+		t.Errorf("Expected pool settings %+v, got idle=%d idle/host=%d conns/host=%d timeout=%s", want,
+			transport.MaxIdleConns, transport.MaxIdleConnsPerHost, transport.MaxConnsPerHost, transport.IdleConnTimeout)
+	}
+	if transport.MaxIdleConnsPerHost <= http.DefaultMaxIdleConnsPerHost {
+		t.Errorf("Expected more idle connections per host than the standard library's %d, got %d",
+			http.DefaultMaxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+	}
+	if transport.Proxy == nil {
+		t.Error("Expected the transport to use the proxy environment variables")
+	}
+}
+
+func TestSetTransportOptions(t *testing.T) {
+	client := NewClient("test-id", testKeySecret)
+	opts := TransportOptions{MaxIdleConns: 8, MaxIdleConnsPerHost: 4, MaxConnsPerHost: 6, IdleConnTimeout: time.Minute}
+	client.SetTransportOptions(opts)
+
+	transport := clientTransport(t, client)
+	if transport.MaxIdleConns != 8 || transport.MaxIdleConnsPerHost != 4 || transport.MaxConnsPerHost != 6 || transport.IdleConnTimeout != time.Minute {
+		t.Errorf("Expected pool settings %+v, got idle=%d idle/host=%d conns/host=%d timeout=%s", opts,
+			transport.MaxIdleConns, transport.MaxIdleConnsPerHost, transport.MaxConnsPerHost, transport.IdleConnTimeout)
+	}
+
+	// Restoring the default transport keeps the configured pool
+	client.SetHTTPTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) { return nil, nil }))
+	client.SetHTTPTransport(nil)
+	if transport := clientTransport(t, client); transport.MaxConnsPerHost != 6 {
+		t.Errorf("Expected the restored transport to keep MaxConnsPerHost 6, got %d", transport.MaxConnsPerHost)
+	}
+}
+
+func TestSetMaxConnsPerHost(t *testing.T) {
+	client := NewClient("test-id", testKeySecret)
+
+	client.SetMaxConnsPerHost(4)
+	transport := clientTransport(t, client)
+	if transport.MaxConnsPerHost != 4 || transport.MaxIdleConnsPerHost != 4 {
+		t.Errorf("Expected 4 connections and 4 idle connections per host, got %d and %d",
+			transport.MaxConnsPerHost, transport.MaxIdleConnsPerHost)
+	}
+	if transport.IdleConnTimeout != DefaultTransportOptions().IdleConnTimeout {
+		t.Errorf("Expected the other settings to be kept, got idle timeout %s", transport.IdleConnTimeout)
+	}
+
+	client.SetMaxConnsPerHost(0)
+	if transport := clientTransport(t, client); transport.MaxConnsPerHost != 0 {
+		t.Errorf("Expected no connection limit, got %d", transport.MaxConnsPerHost)
+	}
+}